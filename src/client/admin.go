@@ -1,9 +1,13 @@
 package client
 
 import (
+	"io"
+
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
 )
 
 // InspectCluster retrieves cluster state
@@ -14,3 +18,90 @@ func (c APIClient) InspectCluster() (*admin.ClusterInfo, error) {
 	}
 	return clusterInfo, nil
 }
+
+// Extract extracts the cluster and calls f with each op. If url is set, the
+// chunk objects are also copied to the object storage at url. If noAuth is
+// set, the identity and auth state is not extracted.
+func (c APIClient) Extract(url string, noAuth bool, f func(op *admin.Op) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	extractClient, err := c.AdminAPIClient.Extract(c.Ctx(), &admin.ExtractRequest{URL: url, NoAuth: noAuth})
+	if err != nil {
+		return err
+	}
+	for {
+		op, err := extractClient.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := f(op); err != nil {
+			return err
+		}
+	}
+}
+
+// ExtractWriter extracts the cluster and writes the ops to w.
+func (c APIClient) ExtractWriter(url string, noAuth bool, w io.Writer) error {
+	writer := pbutil.NewWriter(w)
+	return c.Extract(url, noAuth, func(op *admin.Op) error {
+		_, err := writer.Write(op)
+		return err
+	})
+}
+
+// Restore restores the cluster from ops, which are applied in order.
+func (c APIClient) Restore(ops []*admin.Op) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	restoreClient, err := c.AdminAPIClient.Restore(c.Ctx())
+	if err != nil {
+		return err
+	}
+	for _, op := range ops {
+		if err := restoreClient.Send(&admin.RestoreRequest{Op: op}); err != nil {
+			// The stream was closed by the server, its error is returned by
+			// CloseAndRecv.
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+	}
+	_, err = restoreClient.CloseAndRecv()
+	return err
+}
+
+// RestoreReader restores the cluster from the ops written to r by
+// ExtractWriter.
+func (c APIClient) RestoreReader(r io.Reader) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	restoreClient, err := c.AdminAPIClient.Restore(c.Ctx())
+	if err != nil {
+		return err
+	}
+	reader := pbutil.NewReader(r)
+	for {
+		op := &admin.Op{}
+		if err := reader.Read(op); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		if err := restoreClient.Send(&admin.RestoreRequest{Op: op}); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+	}
+	_, err = restoreClient.CloseAndRecv()
+	return err
+}
@@ -9,6 +9,10 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
+	auth "github.com/pachyderm/pachyderm/src/client/auth"
+	identity "github.com/pachyderm/pachyderm/src/client/identity"
+	pfs "github.com/pachyderm/pachyderm/src/client/pfs"
+	pps "github.com/pachyderm/pachyderm/src/client/pps"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -83,30 +87,310 @@ func (m *ClusterInfo) GetDeploymentID() string {
 	return ""
 }
 
+// Op is a single operation in an extracted cluster. Restoring the ops in the
+// order that they were extracted recreates the cluster. Exactly one field is
+// set in each op.
+type Op struct {
+	IdentityConfig *identity.SetIdentityServerConfigRequest `protobuf:"bytes,1,opt,name=identity_config,json=identityConfig,proto3" json:"identity_config,omitempty"`
+	IdpConnector   *identity.CreateIDPConnectorRequest      `protobuf:"bytes,2,opt,name=idp_connector,json=idpConnector,proto3" json:"idp_connector,omitempty"`
+	OidcClient     *identity.CreateOIDCClientRequest        `protobuf:"bytes,3,opt,name=oidc_client,json=oidcClient,proto3" json:"oidc_client,omitempty"`
+	Repo           *pfs.CreateRepoRequest                   `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	// modify_file is a change to the files of the commit in the next commit op,
+	// relative to its parent commit.
+	ModifyFile           *pfs.ModifyFileRequest                `protobuf:"bytes,5,opt,name=modify_file,json=modifyFile,proto3" json:"modify_file,omitempty"`
+	Commit               *pfs.BuildCommitRequest               `protobuf:"bytes,6,opt,name=commit,proto3" json:"commit,omitempty"`
+	Branch               *pfs.CreateBranchRequest              `protobuf:"bytes,7,opt,name=branch,proto3" json:"branch,omitempty"`
+	Pipeline             *pps.CreatePipelineRequest            `protobuf:"bytes,8,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	AuthConfig           *auth.SetConfigurationRequest         `protobuf:"bytes,9,opt,name=auth_config,json=authConfig,proto3" json:"auth_config,omitempty"`
+	ClusterRoleBinding   *auth.ModifyClusterRoleBindingRequest `protobuf:"bytes,10,opt,name=cluster_role_binding,json=clusterRoleBinding,proto3" json:"cluster_role_binding,omitempty"`
+	Acl                  *auth.SetACLRequest                   `protobuf:"bytes,11,opt,name=acl,proto3" json:"acl,omitempty"`
+	AuthToken            *auth.RestoreAuthTokenRequest         `protobuf:"bytes,12,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *Op) Reset()         { *m = Op{} }
+func (m *Op) String() string { return proto.CompactTextString(m) }
+func (*Op) ProtoMessage()    {}
+func (*Op) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{1}
+}
+func (m *Op) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Op) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Op.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Op) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Op.Merge(m, src)
+}
+func (m *Op) XXX_Size() int {
+	return m.Size()
+}
+func (m *Op) XXX_DiscardUnknown() {
+	xxx_messageInfo_Op.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Op proto.InternalMessageInfo
+
+func (m *Op) GetIdentityConfig() *identity.SetIdentityServerConfigRequest {
+	if m != nil {
+		return m.IdentityConfig
+	}
+	return nil
+}
+
+func (m *Op) GetIdpConnector() *identity.CreateIDPConnectorRequest {
+	if m != nil {
+		return m.IdpConnector
+	}
+	return nil
+}
+
+func (m *Op) GetOidcClient() *identity.CreateOIDCClientRequest {
+	if m != nil {
+		return m.OidcClient
+	}
+	return nil
+}
+
+func (m *Op) GetRepo() *pfs.CreateRepoRequest {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *Op) GetModifyFile() *pfs.ModifyFileRequest {
+	if m != nil {
+		return m.ModifyFile
+	}
+	return nil
+}
+
+func (m *Op) GetCommit() *pfs.BuildCommitRequest {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *Op) GetBranch() *pfs.CreateBranchRequest {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+func (m *Op) GetPipeline() *pps.CreatePipelineRequest {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *Op) GetAuthConfig() *auth.SetConfigurationRequest {
+	if m != nil {
+		return m.AuthConfig
+	}
+	return nil
+}
+
+func (m *Op) GetClusterRoleBinding() *auth.ModifyClusterRoleBindingRequest {
+	if m != nil {
+		return m.ClusterRoleBinding
+	}
+	return nil
+}
+
+func (m *Op) GetAcl() *auth.SetACLRequest {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+func (m *Op) GetAuthToken() *auth.RestoreAuthTokenRequest {
+	if m != nil {
+		return m.AuthToken
+	}
+	return nil
+}
+
+type ExtractRequest struct {
+	// URL is an optional object storage URL that the chunk objects are copied
+	// to, as a copy of the cluster's object storage. The ops contain the
+	// contents of the files either way, and restoring them does not use the
+	// copied chunks. The chunks are not copied if it is empty (the default).
+	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	// no_auth skips extracting the identity server config, auth config,
+	// cluster role bindings, ACLs and robot tokens.
+	NoAuth               bool     `protobuf:"varint,2,opt,name=no_auth,json=noAuth,proto3" json:"no_auth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtractRequest) Reset()         { *m = ExtractRequest{} }
+func (m *ExtractRequest) String() string { return proto.CompactTextString(m) }
+func (*ExtractRequest) ProtoMessage()    {}
+func (*ExtractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{2}
+}
+func (m *ExtractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtractRequest.Merge(m, src)
+}
+func (m *ExtractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtractRequest proto.InternalMessageInfo
+
+func (m *ExtractRequest) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *ExtractRequest) GetNoAuth() bool {
+	if m != nil {
+		return m.NoAuth
+	}
+	return false
+}
+
+type RestoreRequest struct {
+	Op                   *Op      `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreRequest) Reset()         { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()    {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{3}
+}
+func (m *RestoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreRequest.Merge(m, src)
+}
+func (m *RestoreRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreRequest proto.InternalMessageInfo
+
+func (m *RestoreRequest) GetOp() *Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterInfo)(nil), "admin.ClusterInfo")
+	proto.RegisterType((*Op)(nil), "admin.Op")
+	proto.RegisterType((*ExtractRequest)(nil), "admin.ExtractRequest")
+	proto.RegisterType((*RestoreRequest)(nil), "admin.RestoreRequest")
 }
 
 func init() { proto.RegisterFile("client/admin/admin.proto", fileDescriptor_6597bb2f2302afbd) }
 
 var fileDescriptor_6597bb2f2302afbd = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0xce, 0xc9, 0x4c,
-	0xcd, 0x2b, 0xd1, 0x4f, 0x4c, 0xc9, 0xcd, 0xcc, 0x83, 0x90, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0xac, 0x60, 0x8e, 0x94, 0x74, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x58, 0x30, 0xa9,
-	0x34, 0x4d, 0x3f, 0x35, 0xb7, 0xa0, 0xa4, 0x12, 0xa2, 0x46, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f,
-	0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0x31, 0x5c, 0xdc, 0xce, 0x39, 0xa5, 0xc5, 0x25, 0xa9,
-	0x45, 0x9e, 0x79, 0x69, 0xf9, 0x42, 0x62, 0x5c, 0x4c, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
-	0x9c, 0x4e, 0x6c, 0x8f, 0xee, 0xc9, 0x33, 0x79, 0xba, 0x04, 0x31, 0x65, 0xa6, 0x08, 0x99, 0x72,
-	0xf1, 0xa6, 0xa4, 0x16, 0xe4, 0xe4, 0x57, 0xe6, 0xa6, 0xe6, 0x95, 0xc4, 0x67, 0xa6, 0x48, 0x30,
-	0x81, 0x95, 0x08, 0x3c, 0xba, 0x27, 0xcf, 0xe3, 0x02, 0x97, 0xf0, 0x74, 0x09, 0xe2, 0x41, 0x28,
-	0xf3, 0x4c, 0x31, 0x72, 0xe5, 0x62, 0x76, 0x0c, 0xf0, 0x14, 0xb2, 0xe3, 0xe2, 0xf3, 0xcc, 0x2b,
-	0x2e, 0x48, 0x4d, 0x2e, 0x81, 0xda, 0x25, 0x24, 0xa6, 0x07, 0x71, 0xaa, 0x1e, 0xcc, 0xa9, 0x7a,
-	0xae, 0x20, 0xa7, 0x4a, 0x09, 0xe9, 0x41, 0xbc, 0x85, 0xe4, 0x26, 0x25, 0x06, 0x27, 0xdb, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x31, 0x4a, 0x3f, 0x3d, 0xb3,
-	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x20, 0x31, 0x39, 0xa3, 0x32, 0x25, 0xb5,
-	0x08, 0x99, 0x55, 0x5c, 0x94, 0xac, 0x8f, 0x1c, 0x52, 0x49, 0x6c, 0x60, 0x4b, 0x8c, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xee, 0x4e, 0xe6, 0x4b, 0x40, 0x01, 0x00, 0x00,
+	// 716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x6f, 0x6b, 0xd3, 0x40,
+	0x1c, 0x5e, 0xda, 0xad, 0x5d, 0xaf, 0x5d, 0x1d, 0xe7, 0xec, 0x62, 0xc5, 0x4e, 0x2b, 0x83, 0xa1,
+	0x90, 0x8c, 0x89, 0xfa, 0xc2, 0x39, 0x58, 0xd3, 0x89, 0x81, 0xc9, 0x66, 0xa6, 0x08, 0x22, 0x84,
+	0x34, 0xb9, 0xb6, 0x87, 0xc9, 0xdd, 0x99, 0x5e, 0xc4, 0x7e, 0x2f, 0x3f, 0x84, 0x2f, 0xfd, 0x04,
+	0x43, 0xea, 0x17, 0x91, 0xfb, 0x97, 0x75, 0x13, 0x5f, 0x24, 0xfc, 0xf2, 0xfc, 0x9e, 0xe7, 0xb9,
+	0x27, 0xf7, 0x0f, 0xd8, 0x71, 0x8a, 0x11, 0xe1, 0x6e, 0x94, 0x64, 0x98, 0xa8, 0xb7, 0xc3, 0x72,
+	0xca, 0x29, 0x5c, 0x93, 0x1f, 0xdd, 0x7b, 0x13, 0x4a, 0x27, 0x29, 0x72, 0x25, 0x38, 0x2a, 0xc6,
+	0x2e, 0xca, 0x18, 0x9f, 0x2b, 0x4e, 0x77, 0x6b, 0x42, 0x27, 0x54, 0x96, 0xae, 0xa8, 0x34, 0xda,
+	0x31, 0x9e, 0x05, 0x9f, 0xca, 0x97, 0xc6, 0x7b, 0x1a, 0xc7, 0x09, 0x22, 0x1c, 0xf3, 0x79, 0x59,
+	0x18, 0x37, 0xdd, 0x67, 0xe3, 0x99, 0x78, 0x6e, 0xa2, 0x6c, 0x26, 0x1e, 0x85, 0xf6, 0x3f, 0x83,
+	0xa6, 0x97, 0x16, 0x33, 0x8e, 0x72, 0x9f, 0x8c, 0x29, 0xec, 0x80, 0x0a, 0x4e, 0x6c, 0xeb, 0x81,
+	0xb5, 0xd7, 0x18, 0xd4, 0x16, 0x97, 0x3b, 0x15, 0x7f, 0x18, 0x54, 0x70, 0x02, 0x9f, 0x81, 0x8d,
+	0x04, 0xb1, 0x94, 0xce, 0x33, 0x44, 0x78, 0x88, 0x13, 0xbb, 0x22, 0x29, 0x9b, 0x8b, 0xcb, 0x9d,
+	0xd6, 0xb0, 0x6c, 0xf8, 0xc3, 0xa0, 0x75, 0x45, 0xf3, 0x93, 0xfe, 0x9f, 0x35, 0x50, 0x39, 0x63,
+	0xf0, 0x1d, 0xb8, 0x65, 0x22, 0x86, 0x31, 0x25, 0x63, 0x3c, 0x91, 0x43, 0x34, 0x0f, 0xf6, 0x9c,
+	0x32, 0xfa, 0x05, 0xe2, 0xbe, 0xae, 0x2f, 0x50, 0xfe, 0x0d, 0xe5, 0x9e, 0x24, 0x06, 0xe8, 0x6b,
+	0x81, 0x66, 0x3c, 0x68, 0x1b, 0xa2, 0x82, 0xe1, 0x1b, 0xb0, 0x81, 0x13, 0x26, 0xdc, 0x08, 0x8a,
+	0x39, 0xcd, 0x65, 0xa0, 0xe6, 0xc1, 0xa3, 0x2b, 0x43, 0x2f, 0x47, 0x11, 0x47, 0xfe, 0xf0, 0xdc,
+	0x33, 0x1c, 0xe3, 0xd5, 0xc2, 0x09, 0x2b, 0x41, 0x38, 0x00, 0x4d, 0x8a, 0x93, 0x38, 0x54, 0xd3,
+	0x63, 0x57, 0xa5, 0xcf, 0xc3, 0x9b, 0x3e, 0x67, 0xfe, 0xd0, 0xf3, 0x24, 0xc3, 0xb8, 0x00, 0xa1,
+	0x52, 0x10, 0x7c, 0x0c, 0x56, 0x73, 0xc4, 0xa8, 0xbd, 0x2a, 0xc5, 0x1d, 0x47, 0xcc, 0xba, 0xd2,
+	0x05, 0x88, 0x51, 0xa3, 0x90, 0x1c, 0xf8, 0x02, 0x34, 0x33, 0x9a, 0xe0, 0xf1, 0x3c, 0x1c, 0xe3,
+	0x14, 0xd9, 0x6b, 0x4b, 0x92, 0xb7, 0x12, 0x7f, 0x8d, 0x53, 0x54, 0x0e, 0x92, 0x95, 0x10, 0x74,
+	0x41, 0x2d, 0xa6, 0x59, 0x86, 0xb9, 0x5d, 0x93, 0x9a, 0x6d, 0xa9, 0x19, 0x14, 0x38, 0x4d, 0x3c,
+	0x89, 0x1b, 0x91, 0xa6, 0xc1, 0x7d, 0x50, 0x1b, 0xe5, 0x11, 0x89, 0xa7, 0x76, 0x5d, 0x0a, 0xec,
+	0xa5, 0x5c, 0x03, 0xd9, 0x28, 0x15, 0x8a, 0x07, 0x9f, 0x83, 0x75, 0x86, 0x19, 0x4a, 0x31, 0x41,
+	0xf6, 0xba, 0xd4, 0x74, 0x1d, 0xc6, 0x8c, 0xe6, 0x5c, 0xb7, 0x8c, 0xaa, 0xe4, 0xc2, 0x23, 0xd0,
+	0x14, 0xfb, 0xd3, 0x2c, 0x6e, 0x43, 0x4a, 0xef, 0x3b, 0x02, 0x13, 0x0b, 0xab, 0xd6, 0xac, 0xc8,
+	0x23, 0x8e, 0x29, 0x29, 0x7f, 0x4d, 0x74, 0xf5, 0x6a, 0x7e, 0x04, 0x5b, 0xb1, 0xda, 0x85, 0x61,
+	0x4e, 0x53, 0x14, 0x8e, 0x30, 0x49, 0x30, 0x99, 0xd8, 0x40, 0x1a, 0xed, 0x2a, 0x23, 0x35, 0x3b,
+	0x7a, 0xb7, 0x06, 0x34, 0x45, 0x03, 0xc5, 0x32, 0x86, 0x30, 0xfe, 0xa7, 0x05, 0x77, 0x41, 0x35,
+	0x8a, 0x53, 0xbb, 0x29, 0x7d, 0x6e, 0x97, 0x81, 0x8e, 0xbd, 0x53, 0xa3, 0x12, 0x7d, 0x78, 0x08,
+	0x64, 0x9a, 0x90, 0xd3, 0x2f, 0x88, 0xd8, 0xad, 0xe5, 0xf8, 0x01, 0x9a, 0x71, 0x9a, 0xa3, 0xe3,
+	0x82, 0x4f, 0xdf, 0x8b, 0xae, 0xd1, 0x35, 0x22, 0x83, 0xf4, 0x5f, 0x82, 0xf6, 0xc9, 0x77, 0x9e,
+	0x47, 0xb1, 0x59, 0x01, 0xb8, 0x09, 0xaa, 0x1f, 0x82, 0x53, 0x75, 0x8e, 0x02, 0x51, 0xc2, 0x6d,
+	0x50, 0x27, 0x34, 0x14, 0x1a, 0xb9, 0x53, 0xd7, 0x83, 0x1a, 0xa1, 0xc2, 0xb3, 0xff, 0x04, 0xb4,
+	0xf5, 0x10, 0x46, 0x7c, 0x17, 0x54, 0x28, 0xd3, 0x07, 0xa4, 0xe1, 0xa8, 0xab, 0xe4, 0x8c, 0x05,
+	0x15, 0xca, 0x0e, 0x7e, 0x58, 0xa0, 0x7a, 0x7c, 0xee, 0xc3, 0x23, 0xd0, 0xf6, 0xc9, 0x8c, 0xa1,
+	0x98, 0xeb, 0xe9, 0x80, 0x1d, 0x47, 0xdd, 0x2f, 0x8e, 0xb9, 0x5f, 0x9c, 0x13, 0x71, 0xbf, 0x74,
+	0xa1, 0x36, 0x58, 0x3a, 0xe4, 0xfd, 0x15, 0xe8, 0x82, 0xba, 0x4e, 0x0c, 0xef, 0x68, 0xc2, 0xf5,
+	0x3f, 0xe8, 0x5e, 0x0d, 0xdc, 0x5f, 0xd9, 0xb7, 0xe0, 0x21, 0xa8, 0xeb, 0x94, 0xa5, 0xe0, 0x7a,
+	0xea, 0xee, 0x7f, 0x02, 0xf4, 0x57, 0xf6, 0xac, 0xc1, 0xab, 0x9f, 0x8b, 0x9e, 0xf5, 0x6b, 0xd1,
+	0xb3, 0x7e, 0x2f, 0x7a, 0xd6, 0x27, 0x77, 0x82, 0xf9, 0xb4, 0x18, 0x39, 0x31, 0xcd, 0x5c, 0x16,
+	0xc5, 0xd3, 0x79, 0x82, 0xf2, 0xe5, 0x6a, 0x96, 0xc7, 0xee, 0xf2, 0x6d, 0x3a, 0xaa, 0x49, 0xcb,
+	0xa7, 0x7f, 0x07, 0x00, 0xd4, 0xd2, 0x28, 0xd6, 0x64, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -122,6 +406,10 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type APIClient interface {
 	InspectCluster(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterInfo, error)
+	// Extract streams the ops that recreate the cluster.
+	Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (API_ExtractClient, error)
+	// Restore recreates a cluster from extracted ops.
+	Restore(ctx context.Context, opts ...grpc.CallOption) (API_RestoreClient, error)
 }
 
 type aPIClient struct {
@@ -141,9 +429,79 @@ func (c *aPIClient) InspectCluster(ctx context.Context, in *types.Empty, opts ..
 	return out, nil
 }
 
+func (c *aPIClient) Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (API_ExtractClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/admin.API/Extract", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIExtractClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ExtractClient interface {
+	Recv() (*Op, error)
+	grpc.ClientStream
+}
+
+type aPIExtractClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIExtractClient) Recv() (*Op, error) {
+	m := new(Op)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) Restore(ctx context.Context, opts ...grpc.CallOption) (API_RestoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/admin.API/Restore", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIRestoreClient{stream}
+	return x, nil
+}
+
+type API_RestoreClient interface {
+	Send(*RestoreRequest) error
+	CloseAndRecv() (*types.Empty, error)
+	grpc.ClientStream
+}
+
+type aPIRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRestoreClient) Send(m *RestoreRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIRestoreClient) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(types.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // APIServer is the server API for API service.
 type APIServer interface {
 	InspectCluster(context.Context, *types.Empty) (*ClusterInfo, error)
+	// Extract streams the ops that recreate the cluster.
+	Extract(*ExtractRequest, API_ExtractServer) error
+	// Restore recreates a cluster from extracted ops.
+	Restore(API_RestoreServer) error
 }
 
 // UnimplementedAPIServer can be embedded to have forward compatible implementations.
@@ -153,6 +511,12 @@ type UnimplementedAPIServer struct {
 func (*UnimplementedAPIServer) InspectCluster(ctx context.Context, req *types.Empty) (*ClusterInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectCluster not implemented")
 }
+func (*UnimplementedAPIServer) Extract(req *ExtractRequest, srv API_ExtractServer) error {
+	return status.Errorf(codes.Unimplemented, "method Extract not implemented")
+}
+func (*UnimplementedAPIServer) Restore(srv API_RestoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
 	s.RegisterService(&_API_serviceDesc, srv)
@@ -176,6 +540,53 @@ func _API_InspectCluster_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_Extract_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExtractRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).Extract(m, &aPIExtractServer{stream})
+}
+
+type API_ExtractServer interface {
+	Send(*Op) error
+	grpc.ServerStream
+}
+
+type aPIExtractServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIExtractServer) Send(m *Op) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_Restore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).Restore(&aPIRestoreServer{stream})
+}
+
+type API_RestoreServer interface {
+	SendAndClose(*types.Empty) error
+	Recv() (*RestoreRequest, error)
+	grpc.ServerStream
+}
+
+type aPIRestoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIRestoreServer) SendAndClose(m *types.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIRestoreServer) Recv() (*RestoreRequest, error) {
+	m := new(RestoreRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "admin.API",
 	HandlerType: (*APIServer)(nil),
@@ -185,7 +596,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:    _API_InspectCluster_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Extract",
+			Handler:       _API_Extract_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Restore",
+			Handler:       _API_Restore_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "client/admin/admin.proto",
 }
 
@@ -230,38 +652,387 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Op) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClusterInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Op) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Op) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DeploymentID)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.AuthToken != nil {
+		{
+			size, err := m.AuthToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Acl != nil {
+		{
+			size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
-}
-
-func sovAdmin(x uint64) (n int) {
+	if m.ClusterRoleBinding != nil {
+		{
+			size, err := m.ClusterRoleBinding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.AuthConfig != nil {
+		{
+			size, err := m.AuthConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ModifyFile != nil {
+		{
+			size, err := m.ModifyFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OidcClient != nil {
+		{
+			size, err := m.OidcClient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IdpConnector != nil {
+		{
+			size, err := m.IdpConnector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IdentityConfig != nil {
+		{
+			size, err := m.IdentityConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NoAuth {
+		i--
+		if m.NoAuth {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Op != nil {
+		{
+			size, err := m.Op.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClusterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DeploymentID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Op) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IdentityConfig != nil {
+		l = m.IdentityConfig.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.IdpConnector != nil {
+		l = m.IdpConnector.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.OidcClient != nil {
+		l = m.OidcClient.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.ModifyFile != nil {
+		l = m.ModifyFile.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.AuthConfig != nil {
+		l = m.AuthConfig.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.ClusterRoleBinding != nil {
+		l = m.ClusterRoleBinding.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.AuthToken != nil {
+		l = m.AuthToken.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExtractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.NoAuth {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != nil {
+		l = m.Op.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozAdmin(x uint64) (n int) {
@@ -382,6 +1153,679 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Op) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Op: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Op: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IdentityConfig == nil {
+				m.IdentityConfig = &identity.SetIdentityServerConfigRequest{}
+			}
+			if err := m.IdentityConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdpConnector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IdpConnector == nil {
+				m.IdpConnector = &identity.CreateIDPConnectorRequest{}
+			}
+			if err := m.IdpConnector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OidcClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OidcClient == nil {
+				m.OidcClient = &identity.CreateOIDCClientRequest{}
+			}
+			if err := m.OidcClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs.CreateRepoRequest{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyFile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifyFile == nil {
+				m.ModifyFile = &pfs.ModifyFileRequest{}
+			}
+			if err := m.ModifyFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs.BuildCommitRequest{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &pfs.CreateBranchRequest{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &pps.CreatePipelineRequest{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthConfig == nil {
+				m.AuthConfig = &auth.SetConfigurationRequest{}
+			}
+			if err := m.AuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterRoleBinding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterRoleBinding == nil {
+				m.ClusterRoleBinding = &auth.ModifyClusterRoleBindingRequest{}
+			}
+			if err := m.ClusterRoleBinding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &auth.SetACLRequest{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthToken == nil {
+				m.AuthToken = &auth.RestoreAuthTokenRequest{}
+			}
+			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoAuth", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoAuth = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Op == nil {
+				m.Op = &Op{}
+			}
+			if err := m.Op.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAdmin(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
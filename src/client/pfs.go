@@ -1,9 +1,13 @@
 package client
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"io"
+	"path"
+	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -209,6 +213,30 @@ func (c APIClient) BlockCommit(repoName string, commitID string) (*pfs.CommitInf
 	return c.inspectCommit(repoName, commitID, pfs.CommitState_FINISHED)
 }
 
+// InspectReadCommit returns info about the commit that reads from commitID
+// should see. If commitID is a branch, this is the most recent finished commit
+// on the branch, or nil if the branch has no finished commits. Otherwise it is
+// the commit itself, which may not be finished.
+func (c APIClient) InspectReadCommit(repoName string, commitID string) (*pfs.CommitInfo, error) {
+	commitInfo, err := c.InspectCommit(repoName, commitID)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo.Commit.ID == commitID {
+		return commitInfo, nil
+	}
+	for commitInfo.Finished == nil {
+		if commitInfo.ParentCommit == nil {
+			return nil, nil
+		}
+		commitInfo, err = c.InspectCommit(repoName, commitInfo.ParentCommit.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return commitInfo, nil
+}
+
 func (c APIClient) inspectCommit(repoName string, commitID string, blockState pfs.CommitState) (*pfs.CommitInfo, error) {
 	commitInfo, err := c.PfsAPIClient.InspectCommit(
 		c.Ctx(),
@@ -574,11 +602,24 @@ type PutFileClient interface {
 	// overwrite the entire file, specify an index of 0.
 	PutFileOverwrite(repo, commit, path string, r io.Reader) error
 
+	// PutFileSplit writes a file to PFS from a reader, splitting it into a
+	// directory of numbered files with one or more records (delimited by
+	// delimiter) each.
+	// targetFileDatums and targetFileBytes bound the number of records and
+	// bytes in each file. If both are 0, each file contains a single record.
+	// headerRecords is the number of records at the beginning of the data that
+	// are written at the beginning of every file, rather than on their own.
+	PutFileSplit(repo, commit, path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, r io.Reader) error
+
 	// PutFileURL puts a file using the content found at a URL.
 	// The URL is sent to the server which performs the request.
 	// recursive allows for recursive scraping of some types URLs. For example on s3:// urls.
 	PutFileURL(repoName string, commitID string, path string, url string, recursive bool, overwrite bool) error
 
+	// PutFileURLSplit is like PutFileURL, but splits the content found at the
+	// URL into a directory of numbered files like PutFileSplit.
+	PutFileURLSplit(repo, commit, path, url string, recursive bool, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool) error
+
 	// DeleteFile deletes a file from a Commit.
 	// DeleteFile leaves a tombstone in the Commit, assuming the file isn't written
 	// to later attempting to get the file from the finished commit will result in
@@ -616,10 +657,18 @@ func (pfc *putFileClient) PutFileOverwrite(repo, commit, path string, r io.Reade
 	return pfc.c.AppendFile(repo, commit, path, true, r)
 }
 
+func (pfc *putFileClient) PutFileSplit(repo, commit, path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, r io.Reader) error {
+	return pfc.c.AppendFileSplit(repo, commit, path, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite, r)
+}
+
 func (pfc *putFileClient) PutFileURL(repo, commit, path, url string, recursive, overwrite bool) error {
 	return pfc.c.AppendFileURL(repo, commit, path, url, recursive, overwrite)
 }
 
+func (pfc *putFileClient) PutFileURLSplit(repo, commit, path, url string, recursive bool, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool) error {
+	return pfc.c.AppendFileURLSplit(repo, commit, path, url, recursive, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite)
+}
+
 func (pfc *putFileClient) DeleteFile(repo, commit, path string) error {
 	return pfc.c.deleteFile(repo, commit, path)
 }
@@ -660,6 +709,17 @@ func (c APIClient) PutFileOverwrite(repoName string, commitID string, path strin
 	return pfc.PutFileOverwrite(repoName, commitID, path, reader)
 }
 
+// PutFileSplit writes a file to PFS from a reader, splitting it into a
+// directory of numbered files with one or more records (delimited by
+// delimiter) each. See PutFileClient.PutFileSplit for details.
+func (c APIClient) PutFileSplit(repo, commit, path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, r io.Reader) error {
+	pfc, err := c.NewPutFileClient()
+	if err != nil {
+		return err
+	}
+	return pfc.PutFileSplit(repo, commit, path, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite, r)
+}
+
 // PutFileURL puts a file using the content found at a URL.
 // The URL is sent to the server which performs the request.
 // recursive allow for recursive scraping of some types URLs for example on s3:// urls.
@@ -671,6 +731,17 @@ func (c APIClient) PutFileURL(repoName string, commitID string, path string, url
 	return pfc.PutFileURL(repoName, commitID, path, url, recursive, overwrite)
 }
 
+// PutFileURLSplit is like PutFileURL, but splits the content found at the URL
+// into a directory of numbered files. See PutFileClient.PutFileSplit for
+// details.
+func (c APIClient) PutFileURLSplit(repo, commit, path, url string, recursive bool, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool) error {
+	pfc, err := c.NewPutFileClient()
+	if err != nil {
+		return err
+	}
+	return pfc.PutFileURLSplit(repo, commit, path, url, recursive, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite)
+}
+
 // CopyFile copys a file from one pfs location to another. It can be used on
 // directories or regular files.
 func (c APIClient) CopyFile(srcRepo, srcCommit, srcPath, dstRepo, dstCommit, dstPath string, overwrite bool) error {
@@ -686,12 +757,17 @@ func (c APIClient) CopyFile(srcRepo, srcCommit, srcPath, dstRepo, dstCommit, dst
 }
 
 // GetFile returns the contents of a file at a specific Commit.
-// offset specifies a number of bytes that should be skipped in the beginning of the file.
-// size limits the total amount of data returned, note you will get fewer bytes
-// than size if you pass a value larger than the size of the file.
-// If size is set to 0 then all of the data will be returned.
+// path may be a glob pattern, in which case the contents of all of the
+// matching files are written to w.
 func (c APIClient) GetFile(repo, commit, path string, w io.Writer) error {
-	r, err := c.getFile(repo, commit, path)
+	return c.GetFileRange(repo, commit, path, 0, 0, w)
+}
+
+// GetFileRange is like GetFile, except only the size bytes starting at offset
+// are returned from each file. If size is 0 the rest of the file is returned.
+// Only the data in the range is read by pachd.
+func (c APIClient) GetFileRange(repo, commit, path string, offset, size int64, w io.Writer) error {
+	r, err := c.getFile(repo, commit, path, offset, size)
 	if err != nil {
 		return err
 	}
@@ -702,15 +778,118 @@ func (c APIClient) GetFile(repo, commit, path string, w io.Writer) error {
 
 // GetTarFile gets a tar file from PFS.
 func (c APIClient) GetTarFile(repo, commit, path string) (io.Reader, error) {
-	return c.getFile(repo, commit, path)
+	return c.getFile(repo, commit, path, 0, 0)
+}
+
+// GetFileReadSeeker returns an io.ReadSeeker for the file at path, which is
+// not treated as a glob pattern. The file is read from the commit it is in
+// when GetFileReadSeeker is called, even if commit is a branch which later
+// moves. Each read after a seek starts a new ranged read at the new offset,
+// so the data before it is not downloaded.
+func (c APIClient) GetFileReadSeeker(repo, commit, path string) (io.ReadSeeker, error) {
+	fi, err := c.InspectFile(repo, commit, path)
+	if err != nil {
+		return nil, err
+	}
+	if fi.FileType != pfs.FileType_FILE {
+		return nil, errors.Errorf("%q is not a file", path)
+	}
+	return &getFileReadSeeker{
+		c:    c,
+		file: fi.File,
+		size: int64(fi.SizeBytes),
+	}, nil
+}
+
+// globChars are the characters which have a special meaning in the paths
+// passed to GetFile.
+const globChars = `*?[]{}!()@+^\`
+
+// GlobPattern returns a pattern for GetFile which matches at least the file
+// at p. GetFile takes a glob rather than a path, so for paths containing glob
+// characters this is everything under the nearest directory above p without
+// any, and the file has to be picked out of the results by its path.
+func GlobPattern(p string) string {
+	if i := strings.IndexAny(p, globChars); i >= 0 {
+		return path.Join(path.Dir(p[:i]), "**")
+	}
+	return p
+}
+
+type getFileReadSeeker struct {
+	c      APIClient
+	file   *pfs.File
+	size   int64
+	offset int64
+	r      io.Reader
+}
+
+func (gfrs *getFileReadSeeker) Read(p []byte) (int, error) {
+	if gfrs.offset >= gfrs.size {
+		return 0, io.EOF
+	}
+	if gfrs.r == nil {
+		r, err := gfrs.open()
+		if err != nil {
+			return 0, err
+		}
+		gfrs.r = r
+	}
+	n, err := gfrs.r.Read(p)
+	gfrs.offset += int64(n)
+	return n, err
 }
 
-func (c APIClient) getFile(repo, commit, path string) (_ io.Reader, retErr error) {
+// open starts a ranged read of the file at the current offset. The file is
+// picked out of the files matched by its glob pattern (see GlobPattern).
+func (gfrs *getFileReadSeeker) open() (io.Reader, error) {
+	r, err := gfrs.c.getFile(gfrs.file.Commit.Repo.Name, gfrs.file.Commit.ID, GlobPattern(gfrs.file.Path), gfrs.offset, 0)
+	if err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.Errorf("file %q not found", gfrs.file.Path)
+			}
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeDir && path.Join("/", hdr.Name) == path.Join("/", gfrs.file.Path) {
+			return tr, nil
+		}
+	}
+}
+
+func (gfrs *getFileReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += gfrs.offset
+	case io.SeekEnd:
+		offset += gfrs.size
+	default:
+		return 0, errors.Errorf("invalid whence: %d", whence)
+	}
+	if offset < 0 {
+		return 0, errors.Errorf("negative offset: %d", offset)
+	}
+	if offset != gfrs.offset {
+		gfrs.offset = offset
+		gfrs.r = nil
+	}
+	return offset, nil
+}
+
+func (c APIClient) getFile(repo, commit, path string, offset, size int64) (_ io.Reader, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	req := &pfs.GetFileRequest{
-		File: NewFile(repo, commit, path),
+		File:        NewFile(repo, commit, path),
+		OffsetBytes: offset,
+		SizeBytes:   size,
 	}
 	client, err := c.PfsAPIClient.GetFile(c.Ctx(), req)
 	if err != nil {
@@ -738,14 +917,36 @@ func (c APIClient) inspectFile(repoName string, commitID string, path string) (*
 }
 
 // ListFile returns info about all files in a Commit under path, calling cb with each FileInfo.
-func (c APIClient) ListFile(repo, commit, path string, cb func(fi *pfs.FileInfo) error) (retErr error) {
+func (c APIClient) ListFile(repo, commit, path string, cb func(fi *pfs.FileInfo) error) error {
+	return c.ListFileF(repo, commit, path, 0, cb)
+}
+
+// ListFileHistory returns info about the historical versions of the files in
+// a Commit under path. history is the number of versions of each file to
+// return, or -1 for all of them. Each FileInfo's commit is the commit that
+// version of the file was last modified in.
+func (c APIClient) ListFileHistory(repo, commit, path string, history int64) ([]*pfs.FileInfo, error) {
+	var fis []*pfs.FileInfo
+	if err := c.ListFileF(repo, commit, path, history, func(fi *pfs.FileInfo) error {
+		fis = append(fis, fi)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return fis, nil
+}
+
+// ListFileF is like ListFileHistory, except it calls cb with each FileInfo
+// rather than returning them.
+func (c APIClient) ListFileF(repo, commit, path string, history int64, cb func(fi *pfs.FileInfo) error) (retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	fs, err := c.PfsAPIClient.ListFile(
 		c.Ctx(),
 		&pfs.ListFileRequest{
-			File: NewFile(repo, commit, path),
+			File:    NewFile(repo, commit, path),
+			History: history,
 		},
 	)
 	if err != nil {
@@ -936,7 +1137,24 @@ func (c APIClient) DeleteFile(repoName string, commitID string, path string) err
 // prevent the completion of fsck. Errors that do prevent completion will be
 // returned from the function.
 func (c APIClient) Fsck(fix bool, cb func(*pfs.FsckResponse) error) error {
-	fsckClient, err := c.PfsAPIClient.Fsck(c.Ctx(), &pfs.FsckRequest{Fix: fix})
+	return c.fsck(&pfs.FsckRequest{Fix: fix}, cb)
+}
+
+// FsckStorage performs the same checks as Fsck, and also checks that the
+// chunks referenced by every commit's file sets exist and are tracked. If
+// verifyContent is true, the chunks are downloaded and checked against their
+// hashes. If fix is true, the files that reference missing or corrupted chunks
+// are quarantined.
+func (c APIClient) FsckStorage(verifyContent, fix bool, cb func(*pfs.FsckResponse) error) error {
+	return c.fsck(&pfs.FsckRequest{
+		Fix:           fix,
+		Storage:       true,
+		VerifyContent: verifyContent,
+	}, cb)
+}
+
+func (c APIClient) fsck(req *pfs.FsckRequest, cb func(*pfs.FsckResponse) error) error {
+	fsckClient, err := c.PfsAPIClient.Fsck(c.Ctx(), req)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
@@ -958,6 +1176,88 @@ func (c APIClient) Fsck(fix bool, cb func(*pfs.FsckResponse) error) error {
 	return nil
 }
 
+// GarbageCollect deletes the storage objects that are no longer referenced and
+// reports the number of objects and bytes reclaimed. If dryRun is true,
+// nothing is deleted, and the report is of what would be reclaimed.
+func (c APIClient) GarbageCollect(dryRun bool) (*pfs.GarbageCollectResponse, error) {
+	resp, err := c.PfsAPIClient.GarbageCollect(c.Ctx(), &pfs.GarbageCollectRequest{DryRun: dryRun})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
+
+// RewrapDataKeys re-wraps the data keys that are wrapped with a previous
+// master key with the current master key, and returns the number of data keys
+// that were re-wrapped.
+func (c APIClient) RewrapDataKeys() (int64, error) {
+	resp, err := c.PfsAPIClient.RewrapDataKeys(c.Ctx(), &pfs.RewrapDataKeysRequest{})
+	if err != nil {
+		return 0, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Rewrapped, nil
+}
+
+// ReconcileStorage finds the chunks that are in object storage, but not the
+// tracker, or vice versa. Chunks are only reported as missing from object
+// storage if they have been tracked for longer than gracePeriod. If fix is
+// true, the untracked chunks are garbage collected once the grace period has
+// passed, and the missing chunks that are not referenced are deleted from the
+// tracker.
+func (c APIClient) ReconcileStorage(gracePeriod time.Duration, fix bool, cb func(*pfs.ReconcileStorageResponse) error) error {
+	reconcileClient, err := c.PfsAPIClient.ReconcileStorage(c.Ctx(), &pfs.ReconcileStorageRequest{
+		GracePeriodSeconds: int64(gracePeriod.Seconds()),
+		Fix:                fix,
+	})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		resp, err := reconcileClient.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := cb(resp); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// MigrateV1 converts the hash trees of the commits written by V1 into V2 file
+// sets, and verifies the file sets against the V1 data. The V1 data is read
+// from v1StorageRoot, or the storage root if it is empty. The migration is
+// resumable, commits that have already been migrated (and verified) are
+// skipped.
+func (c APIClient) MigrateV1(v1StorageRoot string, cb func(*pfs.MigrateV1Response) error) error {
+	migrateClient, err := c.PfsAPIClient.MigrateV1(c.Ctx(), &pfs.MigrateV1Request{
+		V1StorageRoot: v1StorageRoot,
+	})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		resp, err := migrateClient.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := cb(resp); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
 // FsckFastExit performs checks on pfs, similar to Fsck, except that it returns the
 // first fsck error it encounters and exits.
 func (c APIClient) FsckFastExit() error {
@@ -23,6 +23,22 @@ func (c APIClient) AppendFile(repo, commit, path string, overwrite bool, r io.Re
 	return mfc.Close()
 }
 
+// AppendFileSplit splits the data read from r into records with the given
+// delimiter, and appends them to a directory of numbered files at path.
+// targetFileDatums and targetFileBytes bound the number of records and bytes
+// in each file (if neither is set, each file contains a single record), and
+// the first headerRecords records are written at the beginning of each file.
+func (c APIClient) AppendFileSplit(repo, commit, path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, r io.Reader, tag ...string) error {
+	mfc, err := c.NewModifyFileClient(repo, commit)
+	if err != nil {
+		return err
+	}
+	if err := mfc.AppendFileSplit(path, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite, r, tag...); err != nil {
+		return err
+	}
+	return mfc.Close()
+}
+
 // AppendFileTar appends a set of files from a tar stream.
 func (c APIClient) AppendFileTar(repo, commit string, overwrite bool, r io.Reader, tag ...string) error {
 	mfc, err := c.NewModifyFileClient(repo, commit)
@@ -47,6 +63,20 @@ func (c APIClient) AppendFileURL(repo, commit, path, url string, recursive, over
 	return mfc.Close()
 }
 
+// AppendFileURLSplit splits the content found at a URL into records with the
+// given delimiter, and appends them to a directory of numbered files at path.
+// See AppendFileSplit for details.
+func (c APIClient) AppendFileURLSplit(repo, commit, path, url string, recursive bool, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, tag ...string) error {
+	mfc, err := c.NewModifyFileClient(repo, commit)
+	if err != nil {
+		return err
+	}
+	if err := mfc.AppendFileURLSplit(path, url, recursive, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite, tag...); err != nil {
+		return err
+	}
+	return mfc.Close()
+}
+
 // DeleteFile deletes a set of files.
 // The optional tag field indicates specific tags in the files to delete.
 func (c APIClient) deleteFile(repo, commit, path string, tag ...string) error {
@@ -137,27 +167,57 @@ func (mfc *modifyFileCore) AppendFile(path string, overwrite bool, r io.Reader,
 			}
 			af.Tag = tag[0]
 		}
-		if err := mfc.sendAppendFile(af); err != nil {
-			return err
-		}
-		if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
-			return mfc.sendAppendFile(&pfs.AppendFile{
-				Source: &pfs.AppendFile_RawFileSource{
-					RawFileSource: &pfs.RawFileSource{
-						Data: data,
-					},
+		return mfc.sendRawFile(af, r)
+	})
+}
+
+// AppendFileSplit splits the data read from r into records with the given
+// delimiter, and appends them to a directory of numbered files at path.
+func (mfc *modifyFileCore) AppendFileSplit(path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, r io.Reader, tag ...string) error {
+	return mfc.maybeError(func() error {
+		af := &pfs.AppendFile{
+			Overwrite:        overwrite,
+			Delimiter:        delimiter,
+			TargetFileDatums: targetFileDatums,
+			TargetFileBytes:  targetFileBytes,
+			HeaderRecords:    headerRecords,
+			Source: &pfs.AppendFile_RawFileSource{
+				RawFileSource: &pfs.RawFileSource{
+					Path: path,
 				},
-			})
-		}); err != nil {
-			return err
+			},
 		}
+		if len(tag) > 0 {
+			if len(tag) > 1 {
+				return errors.Errorf("AppendFileSplit called with %v tags, expected 0 or 1", len(tag))
+			}
+			af.Tag = tag[0]
+		}
+		return mfc.sendRawFile(af, r)
+	})
+}
+
+func (mfc *modifyFileCore) sendRawFile(af *pfs.AppendFile, r io.Reader) error {
+	if err := mfc.sendAppendFile(af); err != nil {
+		return err
+	}
+	if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
 		return mfc.sendAppendFile(&pfs.AppendFile{
 			Source: &pfs.AppendFile_RawFileSource{
 				RawFileSource: &pfs.RawFileSource{
-					EOF: true,
+					Data: data,
 				},
 			},
 		})
+	}); err != nil {
+		return err
+	}
+	return mfc.sendAppendFile(&pfs.AppendFile{
+		Source: &pfs.AppendFile_RawFileSource{
+			RawFileSource: &pfs.RawFileSource{
+				EOF: true,
+			},
+		},
 	})
 }
 
@@ -214,9 +274,23 @@ func (mfc *modifyFileCore) AppendFileTar(overwrite bool, r io.Reader, tag ...str
 }
 
 func (mfc *modifyFileCore) AppendFileURL(path, url string, recursive, overwrite bool, tag ...string) error {
+	return mfc.appendFileURL(path, url, recursive, pfs.Delimiter_NONE, 0, 0, 0, overwrite, tag...)
+}
+
+// AppendFileURLSplit splits the content found at a URL into records with the
+// given delimiter, and appends them to a directory of numbered files at path.
+func (mfc *modifyFileCore) AppendFileURLSplit(path, url string, recursive bool, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, tag ...string) error {
+	return mfc.appendFileURL(path, url, recursive, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite, tag...)
+}
+
+func (mfc *modifyFileCore) appendFileURL(path, url string, recursive bool, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, tag ...string) error {
 	return mfc.maybeError(func() error {
 		af := &pfs.AppendFile{
-			Overwrite: overwrite,
+			Overwrite:        overwrite,
+			Delimiter:        delimiter,
+			TargetFileDatums: targetFileDatums,
+			TargetFileBytes:  targetFileBytes,
+			HeaderRecords:    headerRecords,
 			Source: &pfs.AppendFile_UrlFileSource{
 				UrlFileSource: &pfs.URLFileSource{
 					Path:      path,
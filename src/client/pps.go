@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"io"
+	"strconv"
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -405,49 +406,87 @@ func (c APIClient) RestartDatum(jobID string, datumFilter []string) error {
 }
 
 // ListDatum returns info about datums in a job.
-func (c APIClient) ListDatum(job string, cb func(*pps.DatumInfo) error) (retErr error) {
+func (c APIClient) ListDatum(job string, cb func(*pps.DatumInfo) error) error {
+	_, err := c.listDatum(&pps.ListDatumRequest{Job: NewJob(job)}, cb)
+	return err
+}
+
+// ListDatumAll returns info about datums in a job.
+func (c APIClient) ListDatumAll(job string) (_ []*pps.DatumInfo, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
-	client, err := c.PpsAPIClient.ListDatum(
-		c.Ctx(),
-		&pps.ListDatumRequest{
-			Job: NewJob(job),
-		},
-	)
+	var dis []*pps.DatumInfo
+	if err := c.ListDatum(job, func(di *pps.DatumInfo) error {
+		dis = append(dis, di)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return dis, nil
+}
+
+// ListDatumInput returns info about the datums that a pipeline with input
+// would process, without creating a job. If pageSize is nonzero, only the
+// datums in page (counting from 0) are returned. The total number of datums,
+// in all pages, is returned unless cb returns errutil.ErrBreak.
+func (c APIClient) ListDatumInput(input *pps.Input, pageSize, page int64, cb func(*pps.DatumInfo) error) (int64, error) {
+	return c.listDatum(&pps.ListDatumRequest{
+		Input:    input,
+		PageSize: pageSize,
+		Page:     page,
+	}, cb)
+}
+
+// ListDatumInputAll returns info about the datums that a pipeline with input
+// would process, without creating a job. If pageSize is nonzero, only the
+// datums in page (counting from 0) are returned. The total number of datums,
+// in all pages, is returned along with them.
+func (c APIClient) ListDatumInputAll(input *pps.Input, pageSize, page int64) (_ []*pps.DatumInfo, _ int64, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var dis []*pps.DatumInfo
+	total, err := c.ListDatumInput(input, pageSize, page, func(di *pps.DatumInfo) error {
+		dis = append(dis, di)
+		return nil
+	})
 	if err != nil {
-		return err
+		return nil, 0, err
+	}
+	return dis, total, nil
+}
+
+// listDatum calls cb with each datum in the response to req, and returns the
+// total number of datums from the response trailer.
+func (c APIClient) listDatum(req *pps.ListDatumRequest, cb func(*pps.DatumInfo) error) (_ int64, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	client, err := c.PpsAPIClient.ListDatum(c.Ctx(), req)
+	if err != nil {
+		return 0, err
 	}
 	for {
 		di, err := client.Recv()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
-				return nil
+				break
 			}
-			return err
+			return 0, err
 		}
 		if err := cb(di); err != nil {
 			if errors.Is(err, errutil.ErrBreak) {
-				return nil
+				return 0, nil
 			}
-			return err
+			return 0, err
 		}
 	}
-}
-
-// ListDatumAll returns info about datums in a job.
-func (c APIClient) ListDatumAll(job string) (_ []*pps.DatumInfo, retErr error) {
-	defer func() {
-		retErr = grpcutil.ScrubGRPC(retErr)
-	}()
-	var dis []*pps.DatumInfo
-	if err := c.ListDatum(job, func(di *pps.DatumInfo) error {
-		dis = append(dis, di)
-		return nil
-	}); err != nil {
-		return nil, err
+	totals := client.Trailer().Get(pps.ListDatumTotalKey)
+	if len(totals) == 0 {
+		return 0, errors.Errorf("ListDatum response is missing the %q trailer", pps.ListDatumTotalKey)
 	}
-	return dis, nil
+	return strconv.ParseInt(totals[0], 10, 64)
 }
 
 // InspectDatum returns info about a single datum
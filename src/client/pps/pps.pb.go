@@ -2797,6 +2797,8 @@ type PipelineInfo struct {
 	PodPatch             string          `protobuf:"bytes,44,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
 	S3Out                bool            `protobuf:"varint,47,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
 	Metadata             *Metadata       `protobuf:"bytes,48,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Speculative          bool            `protobuf:"varint,52,opt,name=speculative,proto3" json:"speculative,omitempty"`
+	PrioritySpec         *PrioritySpec   `protobuf:"bytes,53,opt,name=priority_spec,json=prioritySpec,proto3" json:"priority_spec,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -3115,6 +3117,20 @@ func (m *PipelineInfo) GetMetadata() *Metadata {
 	return nil
 }
 
+func (m *PipelineInfo) GetSpeculative() bool {
+	if m != nil {
+		return m.Speculative
+	}
+	return false
+}
+
+func (m *PipelineInfo) GetPrioritySpec() *PrioritySpec {
+	if m != nil {
+		return m.PrioritySpec
+	}
+	return nil
+}
+
 type PipelineInfos struct {
 	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -4108,7 +4124,16 @@ type ListDatumRequest struct {
 	// Job and Input are two different ways to specify the datums you want.
 	// Only one can be set.
 	// Job is the job to list datums from.
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// Input is the input to list datums from.
+	// The datums listed are the ones that would be run if a pipeline was created
+	// with input, on the current heads of its input branches.
+	Input *Input `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	// page_size, if nonzero, is the number of datums in a page, and only the
+	// datums in page (counting from 0) are listed. The total number of datums,
+	// in all pages, is returned in the "pach-list-datum-total" trailer.
+	PageSize             int64    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Page                 int64    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4154,6 +4179,27 @@ func (m *ListDatumRequest) GetJob() *Job {
 	return nil
 }
 
+func (m *ListDatumRequest) GetInput() *Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *ListDatumRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListDatumRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
 // ChunkSpec specifies how a pipeline should chunk its datums.
 type ChunkSpec struct {
 	// number, if nonzero, specifies that each chunk should contain `number`
@@ -4271,6 +4317,68 @@ func (m *SchedulingSpec) GetPriorityClassName() string {
 	return ""
 }
 
+// PrioritySpec specifies how workers that are shared between pipelines
+// schedule the pipeline's work, which is the storage work of its output
+// commits (a pipeline's datums are only processed by its own workers).
+type PrioritySpec struct {
+	// level is the priority of the pipeline's work. Workers process the work of
+	// pipelines with a higher level first.
+	Level int64 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// weight is the share of the workers that the pipeline gets, relative to the
+	// other pipelines with the same level. Zero is treated as one.
+	Weight               int64    `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrioritySpec) Reset()         { *m = PrioritySpec{} }
+func (m *PrioritySpec) String() string { return proto.CompactTextString(m) }
+func (*PrioritySpec) ProtoMessage()    {}
+func (*PrioritySpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{45}
+}
+func (m *PrioritySpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrioritySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrioritySpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrioritySpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrioritySpec.Merge(m, src)
+}
+func (m *PrioritySpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrioritySpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrioritySpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrioritySpec proto.InternalMessageInfo
+
+func (m *PrioritySpec) GetLevel() int64 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *PrioritySpec) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 type CreatePipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	// tf_job encodes a Kubeflow TFJob spec. Pachyderm uses this to create TFJobs
@@ -4297,31 +4405,36 @@ type CreatePipelineRequest struct {
 	EnableStats           bool          `protobuf:"varint,17,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,18,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	MaxQueueSize         int64           `protobuf:"varint,20,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service              *Service        `protobuf:"bytes,21,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,33,opt,name=spout,proto3" json:"spout,omitempty"`
-	ChunkSpec            *ChunkSpec      `protobuf:"bytes,23,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,26,opt,name=salt,proto3" json:"salt,omitempty"`
-	Standby              bool            `protobuf:"varint,27,opt,name=standby,proto3" json:"standby,omitempty"`
-	DatumTries           int64           `protobuf:"varint,28,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,29,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,30,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,32,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,34,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,46,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess      bool            `protobuf:"varint,18,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	MaxQueueSize   int64           `protobuf:"varint,20,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service        *Service        `protobuf:"bytes,21,opt,name=service,proto3" json:"service,omitempty"`
+	Spout          *Spout          `protobuf:"bytes,33,opt,name=spout,proto3" json:"spout,omitempty"`
+	ChunkSpec      *ChunkSpec      `protobuf:"bytes,23,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout   *types.Duration `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout     *types.Duration `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt           string          `protobuf:"bytes,26,opt,name=salt,proto3" json:"salt,omitempty"`
+	Standby        bool            `protobuf:"varint,27,opt,name=standby,proto3" json:"standby,omitempty"`
+	DatumTries     int64           `protobuf:"varint,28,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec *SchedulingSpec `protobuf:"bytes,29,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec        string          `protobuf:"bytes,30,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch       string          `protobuf:"bytes,32,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SpecCommit     *pfs.Commit     `protobuf:"bytes,34,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Metadata       *Metadata       `protobuf:"bytes,46,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// speculative, if set, re-runs the slowest datum sets of a job on idle
+	// workers once most of the job's datum sets are done, and uses the result
+	// that finishes first.
+	Speculative          bool          `protobuf:"varint,48,opt,name=speculative,proto3" json:"speculative,omitempty"`
+	PrioritySpec         *PrioritySpec `protobuf:"bytes,49,opt,name=priority_spec,json=prioritySpec,proto3" json:"priority_spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()    {}
 func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{45}
+	return fileDescriptor_dbf57f97f56369c0, []int{46}
 }
 func (m *CreatePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4560,6 +4673,20 @@ func (m *CreatePipelineRequest) GetMetadata() *Metadata {
 	return nil
 }
 
+func (m *CreatePipelineRequest) GetSpeculative() bool {
+	if m != nil {
+		return m.Speculative
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetPrioritySpec() *PrioritySpec {
+	if m != nil {
+		return m.PrioritySpec
+	}
+	return nil
+}
+
 type InspectPipelineRequest struct {
 	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -4571,7 +4698,7 @@ func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{}
 func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectPipelineRequest) ProtoMessage()    {}
 func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{46}
+	return fileDescriptor_dbf57f97f56369c0, []int{47}
 }
 func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4633,7 +4760,7 @@ func (m *ListPipelineRequest) Reset()         { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()    {}
 func (*ListPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{47}
+	return fileDescriptor_dbf57f97f56369c0, []int{48}
 }
 func (m *ListPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4704,7 +4831,7 @@ func (m *DeletePipelineRequest) Reset()         { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()    {}
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{48}
+	return fileDescriptor_dbf57f97f56369c0, []int{49}
 }
 func (m *DeletePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4772,7 +4899,7 @@ func (m *StartPipelineRequest) Reset()         { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()    {}
 func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{49}
+	return fileDescriptor_dbf57f97f56369c0, []int{50}
 }
 func (m *StartPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4819,7 +4946,7 @@ func (m *StopPipelineRequest) Reset()         { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()    {}
 func (*StopPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{50}
+	return fileDescriptor_dbf57f97f56369c0, []int{51}
 }
 func (m *StopPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4868,7 +4995,7 @@ func (m *RunPipelineRequest) Reset()         { *m = RunPipelineRequest{} }
 func (m *RunPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*RunPipelineRequest) ProtoMessage()    {}
 func (*RunPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{51}
+	return fileDescriptor_dbf57f97f56369c0, []int{52}
 }
 func (m *RunPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4929,7 +5056,7 @@ func (m *RunCronRequest) Reset()         { *m = RunCronRequest{} }
 func (m *RunCronRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCronRequest) ProtoMessage()    {}
 func (*RunCronRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{52}
+	return fileDescriptor_dbf57f97f56369c0, []int{53}
 }
 func (m *RunCronRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4976,7 +5103,7 @@ func (m *CreateSecretRequest) Reset()         { *m = CreateSecretRequest{} }
 func (m *CreateSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateSecretRequest) ProtoMessage()    {}
 func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{53}
+	return fileDescriptor_dbf57f97f56369c0, []int{54}
 }
 func (m *CreateSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5023,7 +5150,7 @@ func (m *DeleteSecretRequest) Reset()         { *m = DeleteSecretRequest{} }
 func (m *DeleteSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteSecretRequest) ProtoMessage()    {}
 func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{54}
+	return fileDescriptor_dbf57f97f56369c0, []int{55}
 }
 func (m *DeleteSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5070,7 +5197,7 @@ func (m *InspectSecretRequest) Reset()         { *m = InspectSecretRequest{} }
 func (m *InspectSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectSecretRequest) ProtoMessage()    {}
 func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{55}
+	return fileDescriptor_dbf57f97f56369c0, []int{56}
 }
 func (m *InspectSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5117,7 +5244,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{56}
+	return fileDescriptor_dbf57f97f56369c0, []int{57}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5166,7 +5293,7 @@ func (m *SecretInfo) Reset()         { *m = SecretInfo{} }
 func (m *SecretInfo) String() string { return proto.CompactTextString(m) }
 func (*SecretInfo) ProtoMessage()    {}
 func (*SecretInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{57}
+	return fileDescriptor_dbf57f97f56369c0, []int{58}
 }
 func (m *SecretInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5227,7 +5354,7 @@ func (m *SecretInfos) Reset()         { *m = SecretInfos{} }
 func (m *SecretInfos) String() string { return proto.CompactTextString(m) }
 func (*SecretInfos) ProtoMessage()    {}
 func (*SecretInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{58}
+	return fileDescriptor_dbf57f97f56369c0, []int{59}
 }
 func (m *SecretInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5277,7 +5404,7 @@ func (m *GarbageCollectRequest) Reset()         { *m = GarbageCollectRequest{} }
 func (m *GarbageCollectRequest) String() string { return proto.CompactTextString(m) }
 func (*GarbageCollectRequest) ProtoMessage()    {}
 func (*GarbageCollectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{59}
+	return fileDescriptor_dbf57f97f56369c0, []int{60}
 }
 func (m *GarbageCollectRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5323,7 +5450,7 @@ func (m *GarbageCollectResponse) Reset()         { *m = GarbageCollectResponse{}
 func (m *GarbageCollectResponse) String() string { return proto.CompactTextString(m) }
 func (*GarbageCollectResponse) ProtoMessage()    {}
 func (*GarbageCollectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{60}
+	return fileDescriptor_dbf57f97f56369c0, []int{61}
 }
 func (m *GarbageCollectResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5362,7 +5489,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{61}
+	return fileDescriptor_dbf57f97f56369c0, []int{62}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5401,7 +5528,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{62}
+	return fileDescriptor_dbf57f97f56369c0, []int{63}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5486,6 +5613,7 @@ func init() {
 	proto.RegisterType((*ChunkSpec)(nil), "pps.ChunkSpec")
 	proto.RegisterType((*SchedulingSpec)(nil), "pps.SchedulingSpec")
 	proto.RegisterMapType((map[string]string)(nil), "pps.SchedulingSpec.NodeSelectorEntry")
+	proto.RegisterType((*PrioritySpec)(nil), "pps.PrioritySpec")
 	proto.RegisterType((*CreatePipelineRequest)(nil), "pps.CreatePipelineRequest")
 	proto.RegisterType((*InspectPipelineRequest)(nil), "pps.InspectPipelineRequest")
 	proto.RegisterType((*ListPipelineRequest)(nil), "pps.ListPipelineRequest")
@@ -5509,319 +5637,325 @@ func init() {
 func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptor_dbf57f97f56369c0) }
 
 var fileDescriptor_dbf57f97f56369c0 = []byte{
-	// 4987 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5b, 0xcd, 0x6f, 0x1b, 0x49,
-	0x76, 0x37, 0xc9, 0x26, 0xd9, 0x7c, 0xa4, 0xa8, 0x56, 0xe9, 0xc3, 0x14, 0x6d, 0x4b, 0x72, 0xfb,
-	0x63, 0x6c, 0xaf, 0x57, 0xf2, 0xc8, 0xbb, 0x93, 0x5d, 0xcf, 0x64, 0x66, 0xf4, 0x65, 0x47, 0x5c,
-	0x8d, 0xad, 0x6d, 0x59, 0x13, 0x24, 0x17, 0xa2, 0xd9, 0x2c, 0x52, 0x6d, 0x35, 0xbb, 0x7b, 0xfa,
-	0x43, 0x1e, 0xcd, 0x25, 0xff, 0x40, 0x0e, 0x41, 0x02, 0xe4, 0xb0, 0x87, 0x20, 0xf9, 0x03, 0x82,
-	0xe4, 0x94, 0xd3, 0x5e, 0x72, 0x5b, 0x20, 0x08, 0x90, 0x4b, 0xae, 0x46, 0x60, 0x2c, 0x90, 0x3f,
-	0x20, 0xb7, 0xec, 0x25, 0xa8, 0x57, 0xd5, 0xcd, 0x6e, 0x92, 0x22, 0x29, 0x69, 0x90, 0x03, 0x81,
-	0xae, 0xf7, 0x5e, 0x55, 0x57, 0xbd, 0x7a, 0xf5, 0xde, 0xef, 0xbd, 0x6a, 0xc2, 0x82, 0x61, 0x99,
-	0xd4, 0x0e, 0x36, 0x5c, 0xd7, 0x67, 0xbf, 0x75, 0xd7, 0x73, 0x02, 0x87, 0xe4, 0x5c, 0xd7, 0xaf,
-	0xdf, 0xea, 0x3a, 0x4e, 0xd7, 0xa2, 0x1b, 0x48, 0x6a, 0x85, 0x9d, 0x0d, 0xda, 0x73, 0x83, 0x73,
-	0x2e, 0x51, 0x5f, 0x1d, 0x64, 0x06, 0x66, 0x8f, 0xfa, 0x81, 0xde, 0x73, 0x85, 0xc0, 0xca, 0xa0,
-	0x40, 0x3b, 0xf4, 0xf4, 0xc0, 0x74, 0x6c, 0xc1, 0x5f, 0xe8, 0x3a, 0x5d, 0x07, 0x1f, 0x37, 0xd8,
-	0x53, 0x44, 0x8d, 0xa6, 0xd3, 0xf1, 0xd9, 0x8f, 0x53, 0xd5, 0x53, 0x28, 0x1f, 0x51, 0xc3, 0xa3,
-	0xc1, 0x37, 0x4e, 0x68, 0x07, 0x84, 0x80, 0x64, 0xeb, 0x3d, 0x5a, 0xcb, 0xac, 0x65, 0x1e, 0x95,
-	0x34, 0x7c, 0x26, 0x0a, 0xe4, 0x4e, 0xe9, 0x79, 0x4d, 0x42, 0x12, 0x7b, 0x24, 0x77, 0x00, 0x7a,
-	0x4c, 0xbc, 0xe9, 0xea, 0xc1, 0x49, 0x2d, 0x8b, 0x8c, 0x12, 0x52, 0x0e, 0xf5, 0xe0, 0x84, 0xdc,
-	0x84, 0x22, 0xb5, 0xcf, 0x9a, 0x67, 0xba, 0x57, 0xcb, 0x21, 0xaf, 0x40, 0xed, 0xb3, 0x6f, 0x75,
-	0x4f, 0xfd, 0x43, 0x0e, 0x4a, 0x6f, 0x3d, 0xdd, 0xf6, 0x3b, 0x8e, 0xd7, 0x23, 0x0b, 0x90, 0x37,
-	0x7b, 0x7a, 0x37, 0x7a, 0x19, 0x6f, 0xb0, 0xb7, 0x19, 0xbd, 0x76, 0x2d, 0xbb, 0x96, 0x63, 0x6f,
-	0x33, 0x7a, 0x6d, 0x1c, 0xce, 0xf3, 0x9a, 0x8c, 0x3a, 0x83, 0xd4, 0x02, 0xf5, 0xbc, 0x9d, 0x5e,
-	0x9b, 0x3c, 0x86, 0x1c, 0xb5, 0xcf, 0x6a, 0xb9, 0xb5, 0xdc, 0xa3, 0xf2, 0xe6, 0xcd, 0x75, 0xa6,
-	0xe3, 0x78, 0xf4, 0xf5, 0x3d, 0xfb, 0x6c, 0xcf, 0x0e, 0xbc, 0x73, 0x8d, 0xc9, 0x90, 0x27, 0x50,
-	0xf4, 0x71, 0x99, 0x7e, 0x4d, 0x42, 0x71, 0x05, 0xc5, 0x13, 0x4b, 0xd7, 0x22, 0x01, 0xf2, 0x14,
-	0x08, 0x4e, 0xa5, 0xe9, 0x86, 0x96, 0xd5, 0x8c, 0xba, 0x95, 0xf0, 0xd5, 0x0a, 0x72, 0x0e, 0x43,
-	0xcb, 0x3a, 0x12, 0xd2, 0x0b, 0x90, 0xf7, 0x83, 0xb6, 0x69, 0xd7, 0xf2, 0x28, 0xc0, 0x1b, 0xe4,
-	0x16, 0x94, 0xd8, 0x9c, 0x39, 0xa7, 0x8a, 0x1c, 0x99, 0x7a, 0xde, 0x11, 0x32, 0x9f, 0x02, 0xd1,
-	0x0d, 0x83, 0xba, 0x41, 0xd3, 0xa3, 0x41, 0xe8, 0xd9, 0x4d, 0xc3, 0x69, 0xd3, 0x5a, 0x61, 0x2d,
-	0xf7, 0x28, 0xa7, 0x29, 0x9c, 0xa3, 0x21, 0x63, 0xc7, 0x69, 0x53, 0xf6, 0x82, 0x36, 0x6d, 0x85,
-	0xdd, 0x5a, 0x71, 0x2d, 0xf3, 0x48, 0xd6, 0x78, 0x83, 0x6d, 0x54, 0xe8, 0x53, 0xaf, 0x06, 0x7c,
-	0xa3, 0xd8, 0x33, 0x59, 0x85, 0xf2, 0x7b, 0xc7, 0x3b, 0x35, 0xed, 0x6e, 0xb3, 0x6d, 0x7a, 0xb5,
-	0x32, 0xb2, 0x40, 0x90, 0x76, 0x4d, 0x8f, 0xac, 0x00, 0xb4, 0x1d, 0xe3, 0x94, 0x7a, 0x1d, 0xd3,
-	0xa2, 0xb5, 0x0a, 0xe7, 0xf7, 0x29, 0xe4, 0x3e, 0xe4, 0x5b, 0xa1, 0x69, 0xb5, 0x6b, 0xb3, 0x6b,
-	0x99, 0x47, 0xe5, 0xcd, 0x2a, 0xea, 0x68, 0x9b, 0x51, 0x8e, 0x5c, 0x6a, 0x68, 0x9c, 0x59, 0xff,
-	0x0c, 0xe4, 0x48, 0xb9, 0x91, 0x6d, 0x64, 0xfa, 0xb6, 0xb1, 0x00, 0xf9, 0x33, 0xdd, 0x0a, 0xa9,
-	0x30, 0x0b, 0xde, 0x78, 0x91, 0xfd, 0x45, 0x46, 0xfd, 0x35, 0x94, 0xe2, 0xb1, 0xd8, 0xfc, 0xd1,
-	0x78, 0x84, 0xa1, 0xb1, 0x67, 0x52, 0x07, 0xd9, 0xd2, 0xed, 0x6e, 0xc8, 0x6c, 0x82, 0xf7, 0x8e,
-	0xdb, 0x7d, 0x63, 0xc9, 0x25, 0x8c, 0x45, 0x7d, 0x0c, 0xf9, 0xb7, 0x2f, 0x1b, 0x4e, 0x8b, 0xac,
-	0x41, 0x21, 0xe8, 0x34, 0xdf, 0x39, 0x2d, 0x3e, 0xe0, 0x76, 0xe9, 0xe3, 0x87, 0x55, 0xce, 0xd2,
-	0xf2, 0x41, 0xa7, 0xe1, 0xb4, 0xd4, 0x3a, 0x14, 0xf6, 0xba, 0x1e, 0xf5, 0x7d, 0x36, 0xe7, 0x63,
-	0xed, 0x20, 0x9a, 0xf3, 0xb1, 0x76, 0xa0, 0xde, 0x81, 0x1c, 0x1b, 0x64, 0x09, 0xb2, 0x66, 0x5b,
-	0x0c, 0x50, 0xf8, 0xf8, 0x61, 0x35, 0xbb, 0xbf, 0xab, 0x65, 0xcd, 0xb6, 0xfa, 0xbf, 0x19, 0x90,
-	0xbf, 0xa1, 0x81, 0xde, 0xd6, 0x03, 0x9d, 0x7c, 0x0d, 0x65, 0xdd, 0xb6, 0x9d, 0x00, 0x0f, 0x9c,
-	0x5f, 0xcb, 0xa0, 0x35, 0xad, 0xa0, 0xa6, 0x22, 0x99, 0xf5, 0xad, 0xbe, 0x00, 0xb7, 0xc1, 0x64,
-	0x17, 0xf2, 0x29, 0x14, 0x2c, 0xbd, 0x45, 0x2d, 0x1f, 0x8d, 0xbc, 0xbc, 0xb9, 0x9c, 0xee, 0x7c,
-	0x80, 0x3c, 0xde, 0x4f, 0x08, 0xd6, 0xbf, 0x04, 0x65, 0x70, 0xcc, 0xcb, 0xa8, 0xbe, 0xfe, 0x4b,
-	0x28, 0x27, 0x86, 0xbd, 0xd4, 0xae, 0xfd, 0x05, 0x14, 0x8f, 0xa8, 0x77, 0x66, 0x1a, 0x94, 0xdc,
-	0x83, 0x19, 0xd3, 0x0e, 0xa8, 0x67, 0xeb, 0x56, 0xd3, 0x75, 0xbc, 0x00, 0x07, 0xc8, 0x6b, 0x95,
-	0x88, 0x78, 0xe8, 0x78, 0x01, 0x13, 0xa2, 0xdf, 0x27, 0x85, 0xb2, 0x5c, 0x28, 0x22, 0xa2, 0x10,
-	0xd3, 0xb4, 0xcb, 0xb7, 0x52, 0x68, 0xfa, 0x50, 0xcb, 0x9a, 0x2e, 0xb3, 0x8a, 0xe0, 0xdc, 0xa5,
-	0xc2, 0xd7, 0xe0, 0xb3, 0x4a, 0x21, 0x7f, 0xe4, 0x3a, 0x61, 0x40, 0x6e, 0x43, 0xc9, 0x39, 0xa3,
-	0xde, 0x7b, 0xcf, 0x0c, 0xb8, 0xcf, 0x90, 0xb5, 0x3e, 0x81, 0x3c, 0x64, 0x27, 0x1c, 0xe7, 0x89,
-	0x6f, 0x2c, 0x6f, 0x56, 0xc4, 0x09, 0x47, 0x9a, 0x16, 0x31, 0xc9, 0x12, 0x14, 0x7a, 0xba, 0x77,
-	0x4a, 0x63, 0xdf, 0xc4, 0x5b, 0xea, 0xbf, 0x64, 0x41, 0x3e, 0x7c, 0x79, 0xb4, 0x6f, 0xbb, 0xe1,
-	0x68, 0x37, 0x48, 0x40, 0xf2, 0xa8, 0xeb, 0x08, 0x0d, 0xe1, 0x33, 0x1b, 0xac, 0xe5, 0xe9, 0xb6,
-	0x71, 0x12, 0x0d, 0xc6, 0x5b, 0x8c, 0x6e, 0x38, 0xbd, 0x9e, 0x19, 0x88, 0x95, 0x88, 0x16, 0x1b,
-	0xa3, 0x6b, 0x39, 0xad, 0x5a, 0x9e, 0x8f, 0xc1, 0x9e, 0x99, 0x7b, 0x7b, 0xe7, 0x98, 0x76, 0xd3,
-	0xb1, 0x6b, 0x32, 0x17, 0x66, 0xcd, 0x37, 0x36, 0xf3, 0xb2, 0x4e, 0x18, 0x50, 0xaf, 0xc9, 0xda,
-	0x78, 0x5a, 0xd9, 0x82, 0x19, 0xa5, 0xe1, 0x98, 0x36, 0x59, 0x06, 0xb9, 0xeb, 0x39, 0xa1, 0xdb,
-	0x6c, 0x9d, 0x8b, 0xa3, 0x5e, 0xc4, 0xf6, 0xf6, 0x39, 0x7b, 0x8d, 0xa5, 0xff, 0x70, 0x5e, 0x2b,
-	0x60, 0x1f, 0x7c, 0x66, 0xce, 0x01, 0x83, 0x4c, 0x93, 0x9d, 0x74, 0x5f, 0x38, 0x13, 0x40, 0xd2,
-	0x4b, 0x46, 0x21, 0x55, 0xc8, 0xfa, 0xcf, 0x6b, 0x25, 0xa4, 0x67, 0xfd, 0xe7, 0x4c, 0xa1, 0x81,
-	0x67, 0x76, 0xbb, 0xc2, 0xc9, 0xa0, 0x42, 0x3b, 0xcc, 0xc3, 0x22, 0x4d, 0x8b, 0x98, 0xea, 0x3f,
-	0x65, 0xa0, 0xb4, 0xe3, 0x39, 0xf6, 0xa5, 0x35, 0x27, 0x34, 0x94, 0x1b, 0xd4, 0x90, 0xef, 0x52,
-	0x23, 0xb2, 0x00, 0xf6, 0x9c, 0xde, 0xf8, 0xc2, 0xe0, 0xc6, 0x3f, 0x63, 0x0e, 0x58, 0xf7, 0x02,
-	0x54, 0x6a, 0x79, 0xb3, 0xbe, 0xce, 0xa3, 0xe3, 0x7a, 0x14, 0x1d, 0xd7, 0xdf, 0x46, 0xe1, 0x53,
-	0xe3, 0x82, 0xaa, 0x09, 0xf2, 0x2b, 0x33, 0xb8, 0x78, 0xbe, 0xcb, 0x90, 0x0b, 0x3d, 0x8b, 0x4f,
-	0x77, 0xbb, 0xf8, 0xf1, 0xc3, 0x2a, 0x73, 0x12, 0x1a, 0xa3, 0x5d, 0x76, 0xc3, 0xd5, 0xff, 0xc9,
-	0x40, 0x9e, 0xbf, 0x68, 0x15, 0x72, 0x6e, 0xc7, 0xc7, 0xe9, 0x97, 0x37, 0x67, 0xd0, 0x36, 0x23,
-	0x73, 0xd3, 0x18, 0x87, 0xac, 0x80, 0x84, 0x1b, 0x5d, 0x44, 0xa7, 0x00, 0x28, 0xc1, 0xd9, 0x48,
-	0x27, 0x6b, 0x90, 0xc7, 0xfd, 0xad, 0xc9, 0x43, 0x02, 0x9c, 0xc1, 0x24, 0x0c, 0xcf, 0xf1, 0x23,
-	0xbf, 0x92, 0x92, 0x40, 0x06, 0x93, 0x08, 0x6d, 0xd3, 0xb1, 0x45, 0xcc, 0x4c, 0x49, 0x20, 0x83,
-	0xa8, 0x20, 0x19, 0x9e, 0x63, 0xe3, 0x32, 0xa2, 0x08, 0x10, 0xef, 0xae, 0x86, 0x3c, 0xb6, 0x94,
-	0xae, 0x19, 0xe9, 0x9b, 0x2f, 0x25, 0xd2, 0xa7, 0xc6, 0x38, 0xea, 0x29, 0xc8, 0x0d, 0xa7, 0x95,
-	0x56, 0xb0, 0x94, 0x50, 0xf0, 0xbd, 0x58, 0x5b, 0x19, 0x1c, 0xa3, 0x8c, 0x96, 0xb5, 0x83, 0xa4,
-	0xa1, 0xb3, 0x92, 0x4d, 0x9c, 0x95, 0xc8, 0xb0, 0x73, 0x7d, 0xc3, 0x56, 0x8f, 0x61, 0xf6, 0x50,
-	0xf7, 0x74, 0xcb, 0xa2, 0x96, 0xe9, 0xf7, 0x30, 0xb8, 0xd4, 0x41, 0x36, 0x1c, 0xdb, 0x0f, 0x74,
-	0x9b, 0xbb, 0x1f, 0x49, 0x8b, 0xdb, 0x64, 0x0d, 0xca, 0x86, 0x43, 0x3b, 0x1d, 0xd3, 0x60, 0x68,
-	0x08, 0x47, 0xca, 0x68, 0x49, 0x52, 0x43, 0x92, 0x33, 0x4a, 0x56, 0x7d, 0x0e, 0x25, 0x5c, 0x00,
-	0x3b, 0x1c, 0x71, 0xb4, 0x92, 0x12, 0xd1, 0x8a, 0x80, 0x74, 0xa2, 0xfb, 0x27, 0xa8, 0x86, 0x8a,
-	0x86, 0xcf, 0xea, 0xe7, 0x90, 0xdf, 0xd5, 0x83, 0xb0, 0x77, 0x51, 0x28, 0x21, 0x75, 0xc8, 0xbd,
-	0x13, 0x6b, 0x2a, 0x6f, 0xca, 0xa8, 0x3a, 0x16, 0xa3, 0x18, 0x51, 0xfd, 0x5d, 0x06, 0x4a, 0xd8,
-	0x7b, 0xdf, 0xee, 0x38, 0x6c, 0xab, 0xda, 0xac, 0x21, 0x54, 0xc4, 0xb7, 0x0a, 0xd9, 0x1a, 0x67,
-	0x90, 0x07, 0x68, 0xf8, 0x01, 0xf7, 0x77, 0xd5, 0xcd, 0xd9, 0xbe, 0xc4, 0x11, 0x23, 0x6b, 0x9c,
-	0x4b, 0x3e, 0xe1, 0x62, 0x3e, 0x2e, 0xb5, 0xbc, 0x39, 0xc7, 0x4d, 0xcf, 0x73, 0x0c, 0xea, 0xfb,
-	0x4c, 0xd0, 0xe7, 0x82, 0x3e, 0x79, 0x08, 0x25, 0xb7, 0xe3, 0x37, 0xf9, 0x98, 0x7c, 0xff, 0x4b,
-	0xb8, 0x31, 0x4c, 0x05, 0x9a, 0xec, 0x76, 0x50, 0x9c, 0x92, 0xbb, 0x20, 0xb1, 0x40, 0x85, 0x80,
-	0x07, 0xf7, 0x5f, 0x88, 0xb0, 0x69, 0x6b, 0xc8, 0x52, 0xff, 0x39, 0x03, 0xa5, 0xad, 0x6e, 0xd7,
-	0xa3, 0x5d, 0xd6, 0x61, 0x01, 0xf2, 0x06, 0x83, 0x58, 0xb8, 0x94, 0x9c, 0xc6, 0x1b, 0x4c, 0x7f,
-	0x3d, 0xaa, 0xdb, 0x38, 0xfb, 0x8c, 0x86, 0xcf, 0xec, 0x18, 0xf9, 0x41, 0xbb, 0x4d, 0xcf, 0xc4,
-	0xbe, 0x88, 0x16, 0x79, 0x0c, 0x4a, 0xc7, 0xec, 0x04, 0x27, 0x4d, 0x97, 0x7a, 0x06, 0xb5, 0x03,
-	0x06, 0x5f, 0x24, 0x94, 0x98, 0x45, 0xfa, 0x61, 0x4c, 0x26, 0x9f, 0xc1, 0x4d, 0xdb, 0xb4, 0x29,
-	0x3a, 0xba, 0x81, 0x1e, 0x79, 0xec, 0xb1, 0xc8, 0xd9, 0x2f, 0xd3, 0xfd, 0xd4, 0xbf, 0xce, 0x42,
-	0x25, 0xa9, 0x15, 0xf2, 0x25, 0xcc, 0xb4, 0x9d, 0xf7, 0xb6, 0xe5, 0xe8, 0xed, 0x26, 0x43, 0xe0,
-	0x62, 0x23, 0x96, 0x87, 0xfc, 0xcb, 0xae, 0x40, 0xdf, 0x5a, 0x25, 0x92, 0x67, 0x1e, 0x87, 0x7c,
-	0x01, 0x15, 0x97, 0x8f, 0xc7, 0xbb, 0x67, 0x27, 0x75, 0x2f, 0x0b, 0x71, 0xec, 0xfd, 0x02, 0xca,
-	0xa1, 0xdb, 0x7f, 0x77, 0x6e, 0x52, 0x67, 0xe0, 0xd2, 0xd8, 0xf7, 0x01, 0x54, 0xe3, 0x99, 0xb7,
-	0xce, 0x03, 0xea, 0xa3, 0xae, 0x24, 0x2d, 0x5e, 0xcf, 0x36, 0x23, 0x92, 0xbb, 0x50, 0x11, 0xaf,
-	0xe0, 0x42, 0x79, 0x14, 0x12, 0xaf, 0x45, 0x11, 0xf5, 0x37, 0x59, 0x58, 0x8c, 0xf7, 0x31, 0xa5,
-	0x9d, 0xe7, 0xa3, 0xb5, 0xc3, 0x1d, 0x46, 0xdc, 0x65, 0x40, 0x25, 0x9f, 0x8e, 0x54, 0xc9, 0x60,
-	0x9f, 0x94, 0x1e, 0x36, 0x46, 0xe9, 0x61, 0xb0, 0x47, 0x72, 0xf1, 0x3f, 0x1f, 0xb9, 0xf8, 0xe1,
-	0x3e, 0x03, 0xca, 0xf8, 0x74, 0x84, 0x32, 0x46, 0x4c, 0x2d, 0xa9, 0x9c, 0x7f, 0xcb, 0x42, 0xe5,
-	0x4f, 0x1d, 0x06, 0x1e, 0x98, 0x4a, 0x42, 0x9f, 0x3c, 0x86, 0xd2, 0x7b, 0x6c, 0x37, 0xe3, 0xb3,
-	0x5f, 0xf9, 0xf8, 0x61, 0x55, 0xe6, 0x42, 0xfb, 0xbb, 0x9a, 0xcc, 0xd9, 0xfb, 0x6d, 0x86, 0x57,
-	0xdf, 0x39, 0x2d, 0x26, 0x97, 0xed, 0xe3, 0x55, 0xe6, 0x33, 0x77, 0xb5, 0xfc, 0x3b, 0xa7, 0xb5,
-	0xdf, 0x66, 0x8e, 0x18, 0x4f, 0x19, 0xf7, 0xd4, 0xd5, 0xbe, 0xa7, 0xc6, 0xd3, 0x88, 0x3c, 0xf2,
-	0x33, 0x28, 0x62, 0x44, 0xa3, 0x6d, 0xb1, 0xc8, 0x71, 0xc1, 0x2f, 0x12, 0xed, 0x3b, 0x84, 0xfc,
-	0x04, 0x87, 0x70, 0x07, 0xe0, 0xbb, 0x90, 0x86, 0xb4, 0xe9, 0x9b, 0x3f, 0xf0, 0xc0, 0x9b, 0xd3,
-	0x4a, 0x48, 0x39, 0x32, 0x7f, 0xe0, 0x66, 0xa6, 0x07, 0x7a, 0x53, 0x6c, 0x17, 0x6d, 0x23, 0xa8,
-	0xc8, 0x69, 0x33, 0x8c, 0x7a, 0x18, 0x11, 0x63, 0x31, 0x8f, 0x1a, 0x2c, 0x68, 0xd3, 0x36, 0xc2,
-	0x1c, 0x21, 0xa6, 0x45, 0x44, 0xd5, 0x83, 0x8a, 0x46, 0x7d, 0x27, 0xf4, 0x0c, 0x8a, 0x3e, 0x9c,
-	0xe5, 0x81, 0x6e, 0x88, 0x6a, 0xcc, 0x6a, 0xec, 0x11, 0x91, 0x1b, 0xed, 0x39, 0xde, 0xb9, 0x08,
-	0x09, 0xa2, 0x45, 0x56, 0x20, 0xd7, 0x75, 0x43, 0xb1, 0x1a, 0x8e, 0xfa, 0x5e, 0x1d, 0x1e, 0x63,
-	0xc6, 0xc2, 0x18, 0xcc, 0xd1, 0xb4, 0x4d, 0xff, 0x34, 0x72, 0xde, 0xec, 0xb9, 0x21, 0xc9, 0x39,
-	0x45, 0x52, 0x7f, 0x0e, 0x45, 0x21, 0x19, 0x23, 0xcf, 0x4c, 0x1f, 0x79, 0xb2, 0x17, 0xda, 0x61,
-	0xaf, 0x45, 0x3d, 0x7c, 0x61, 0x4e, 0x13, 0x2d, 0xf5, 0x3f, 0x25, 0x28, 0xef, 0x05, 0x46, 0x1b,
-	0x63, 0x5c, 0xc7, 0x89, 0x9c, 0x7a, 0x66, 0x84, 0x53, 0x27, 0x8f, 0x41, 0x76, 0x4d, 0x97, 0x5a,
-	0xa6, 0x1d, 0x99, 0xbb, 0x88, 0xfd, 0x82, 0xa8, 0xc5, 0x6c, 0xf2, 0x0c, 0x66, 0x9c, 0x30, 0x70,
-	0xc3, 0xa0, 0x99, 0x40, 0x46, 0x03, 0xc1, 0xb1, 0xc2, 0x25, 0x78, 0x8b, 0xd4, 0xa0, 0xe8, 0x51,
-	0x0e, 0x7e, 0xf8, 0x09, 0x8f, 0x9a, 0x23, 0xf6, 0x26, 0x3f, 0x6a, 0x6f, 0xee, 0x42, 0x05, 0xc5,
-	0xfc, 0x53, 0xd3, 0x75, 0x69, 0x5b, 0xec, 0x71, 0x99, 0xd1, 0x8e, 0x38, 0x89, 0x19, 0x01, 0x8a,
-	0x04, 0x4e, 0xa0, 0x5b, 0x62, 0x87, 0x4b, 0x8c, 0xf2, 0x96, 0x11, 0x18, 0xac, 0x44, 0x76, 0x47,
-	0x37, 0xad, 0x78, 0x6b, 0xb1, 0xc7, 0x4b, 0xa4, 0x8c, 0xd8, 0xfe, 0xd9, 0x11, 0xdb, 0xdf, 0x37,
-	0xca, 0xd2, 0x04, 0xa3, 0x5c, 0x87, 0x0a, 0x3e, 0x44, 0x4a, 0x82, 0x61, 0x25, 0x95, 0x51, 0x40,
-	0xe8, 0xe8, 0x5e, 0x14, 0x25, 0xcb, 0x18, 0x25, 0x67, 0xa2, 0xed, 0x49, 0xc5, 0xc8, 0x25, 0x28,
-	0x78, 0x54, 0xf7, 0x1d, 0x5b, 0x24, 0xc5, 0xa2, 0x95, 0x3c, 0x60, 0x33, 0xd3, 0x1f, 0xb0, 0xcf,
-	0x40, 0xee, 0x98, 0xb6, 0xe9, 0x9f, 0xd0, 0x76, 0xad, 0x3a, 0xb1, 0x5b, 0x2c, 0xab, 0xfe, 0x7e,
-	0x06, 0x8a, 0xd3, 0xd8, 0xd4, 0x53, 0x28, 0x05, 0x51, 0x9d, 0x23, 0xe5, 0x43, 0xe3, 0xea, 0x87,
-	0xd6, 0x17, 0x48, 0x59, 0x60, 0x6e, 0xbc, 0x05, 0x3e, 0x06, 0x25, 0x7a, 0x6e, 0x9e, 0x51, 0xcf,
-	0x67, 0x48, 0x71, 0x06, 0x0d, 0x6b, 0x36, 0xa2, 0x7f, 0xcb, 0xc9, 0xe4, 0x29, 0x94, 0x19, 0x36,
-	0x8f, 0x76, 0x61, 0x63, 0x78, 0x17, 0x80, 0xf1, 0xc5, 0x26, 0x7c, 0x05, 0x8a, 0xdb, 0xc7, 0x68,
-	0x4d, 0x44, 0xf8, 0x15, 0xec, 0xb2, 0xc0, 0xe7, 0x92, 0x06, 0x70, 0xda, 0xac, 0x3b, 0x80, 0xe8,
-	0xee, 0x41, 0x81, 0x62, 0xf6, 0x2e, 0x4a, 0x13, 0x65, 0xec, 0xc6, 0x13, 0x7a, 0x4d, 0xb0, 0xc8,
-	0x27, 0x00, 0xae, 0xee, 0x51, 0x3b, 0xc0, 0x42, 0x40, 0x61, 0x40, 0x75, 0x25, 0xce, 0x63, 0x89,
-	0x7e, 0x62, 0x5b, 0x8b, 0x57, 0xdb, 0x56, 0x79, 0xfa, 0x6d, 0x1d, 0x3e, 0xd7, 0xa5, 0x49, 0xe7,
-	0x3a, 0xb6, 0x59, 0x98, 0xca, 0x66, 0xef, 0xa5, 0x6c, 0x36, 0x91, 0x08, 0x57, 0xc7, 0x25, 0xc2,
-	0x6b, 0x90, 0xf7, 0x59, 0x5e, 0x5d, 0xfb, 0x69, 0x02, 0x60, 0x62, 0xa6, 0xad, 0x71, 0x06, 0x79,
-	0x02, 0x65, 0x31, 0x71, 0x4c, 0xdf, 0x48, 0x02, 0x12, 0x6a, 0xd4, 0x75, 0x34, 0xe0, 0x5c, 0xf6,
-	0xcc, 0xd2, 0x7e, 0x21, 0x2b, 0xf2, 0xa3, 0x39, 0x9c, 0x94, 0x58, 0xd7, 0x36, 0xcf, 0x92, 0x12,
-	0xfe, 0x6a, 0x61, 0x92, 0xbf, 0x5a, 0x9a, 0xc6, 0x5f, 0xad, 0x0c, 0xfb, 0xab, 0x01, 0x87, 0xf4,
-	0x68, 0x0a, 0x87, 0xb4, 0x3e, 0xca, 0x21, 0xa5, 0xfd, 0xde, 0xcd, 0x41, 0xbf, 0x17, 0xfb, 0xab,
-	0xd5, 0x09, 0xfe, 0xea, 0x33, 0x98, 0x11, 0xa0, 0xc0, 0x47, 0x94, 0x50, 0xab, 0x61, 0x40, 0xe7,
-	0x1d, 0x92, 0xf0, 0x41, 0xab, 0xbc, 0x4f, 0x82, 0x89, 0x2f, 0x61, 0xce, 0x13, 0xf1, 0xb0, 0xe9,
-	0xd1, 0xef, 0x42, 0xea, 0x07, 0x7e, 0x6d, 0x39, 0xf1, 0xb2, 0x64, 0xb4, 0xd4, 0x94, 0x48, 0x56,
-	0x13, 0xa2, 0xe4, 0x05, 0xcc, 0xc6, 0xfd, 0x2d, 0xb3, 0x67, 0x06, 0x7e, 0xed, 0xfe, 0x45, 0xbd,
-	0xab, 0x91, 0xe4, 0x01, 0x0a, 0x92, 0x7d, 0xb8, 0xe9, 0x9b, 0x6d, 0x6a, 0xe8, 0x5e, 0x73, 0x70,
-	0x8c, 0x67, 0x17, 0x8d, 0xb1, 0x28, 0x7a, 0x68, 0xe9, 0xa1, 0xd6, 0x20, 0x6f, 0x32, 0xd4, 0x52,
-	0xab, 0x27, 0xac, 0x4c, 0x64, 0x9c, 0xc8, 0x20, 0xeb, 0x00, 0x36, 0x7d, 0x1f, 0x99, 0xcd, 0x2d,
-	0x14, 0x9b, 0x45, 0x23, 0xe3, 0x56, 0x83, 0x69, 0x45, 0xc9, 0xa6, 0xef, 0x85, 0x11, 0x0d, 0x06,
-	0x80, 0x3b, 0x13, 0x02, 0xc0, 0x5d, 0xa8, 0x50, 0x5b, 0x6f, 0x59, 0xb4, 0xc9, 0x37, 0x6c, 0x0d,
-	0x73, 0xc7, 0x32, 0xa7, 0x71, 0x30, 0x4b, 0x40, 0xf2, 0x75, 0x2b, 0xa8, 0xdd, 0x15, 0x45, 0x07,
-	0xdd, 0x0a, 0xc8, 0x4f, 0x01, 0x8c, 0x93, 0xd0, 0x3e, 0xe5, 0xce, 0xea, 0x41, 0x32, 0x1d, 0x66,
-	0x64, 0x5c, 0x73, 0xc9, 0x88, 0x1e, 0x31, 0x5b, 0x60, 0xa9, 0x17, 0xc2, 0x54, 0x76, 0xaa, 0x1e,
-	0x4e, 0xce, 0x16, 0x98, 0xfc, 0x5b, 0x2e, 0xce, 0xf0, 0x3e, 0x03, 0x84, 0x51, 0xef, 0x4f, 0x26,
-	0xe2, 0xfd, 0x77, 0x4e, 0x2b, 0xea, 0xcb, 0x4d, 0x9e, 0xbd, 0xdb, 0x33, 0xa9, 0x5f, 0x7b, 0x1c,
-	0x9b, 0x7c, 0xd8, 0x7b, 0xcb, 0x28, 0xe4, 0x0b, 0x98, 0xf5, 0x8d, 0x13, 0xda, 0x0e, 0x2d, 0xd3,
-	0xee, 0xf2, 0x05, 0x3d, 0xc1, 0x17, 0xcc, 0xf3, 0x43, 0x1f, 0xf3, 0xb8, 0x35, 0xf8, 0xa9, 0x36,
-	0x59, 0x06, 0xd9, 0x75, 0xda, 0xbc, 0xdb, 0x4f, 0x78, 0xa1, 0xc9, 0x75, 0x78, 0x15, 0xf7, 0x16,
-	0x94, 0x18, 0xcb, 0xd5, 0x03, 0xe3, 0xa4, 0xf6, 0x94, 0x97, 0x6c, 0x5d, 0xa7, 0x7d, 0xc8, 0xda,
-	0x0d, 0x49, 0x96, 0x94, 0x7c, 0x43, 0x92, 0xf3, 0x4a, 0xa1, 0x21, 0xc9, 0xb7, 0x95, 0x3b, 0x0d,
-	0x49, 0x56, 0x95, 0x7b, 0xea, 0x2e, 0x14, 0xb8, 0xdd, 0x8f, 0x2c, 0xbe, 0x3c, 0x4c, 0x67, 0xb5,
-	0xca, 0xc0, 0x39, 0x89, 0xdc, 0x9f, 0xba, 0x02, 0x72, 0x14, 0xc1, 0x46, 0x8d, 0xa3, 0xfe, 0x21,
-	0x0b, 0x0a, 0x03, 0x69, 0x91, 0x10, 0x46, 0xd5, 0x47, 0xd1, 0xe0, 0x19, 0x1c, 0x9c, 0xa4, 0x02,
-	0xe1, 0x05, 0xde, 0x55, 0x4a, 0x79, 0xd7, 0x81, 0xb8, 0x97, 0x1d, 0x1f, 0xf7, 0x76, 0x80, 0xed,
-	0x53, 0x13, 0x13, 0x5e, 0x5f, 0x40, 0xf9, 0xfb, 0x3c, 0x74, 0x0d, 0x4c, 0x8d, 0xb9, 0xf7, 0x1d,
-	0x14, 0xe3, 0x95, 0xdf, 0xd2, 0xbb, 0xa8, 0xcd, 0x3c, 0x91, 0x1e, 0x06, 0x27, 0xcd, 0xc0, 0x39,
-	0xa5, 0xb6, 0x28, 0x1d, 0x96, 0x18, 0xe5, 0x2d, 0x23, 0x90, 0xe7, 0x50, 0xb5, 0x74, 0x1f, 0x63,
-	0x9e, 0xc8, 0xdd, 0x0b, 0xa3, 0xa2, 0x46, 0x85, 0x09, 0x45, 0x2d, 0xb2, 0x06, 0xe5, 0x44, 0x88,
-	0xc5, 0x28, 0x28, 0x69, 0x49, 0x52, 0xfd, 0x0b, 0xa8, 0xa6, 0xa7, 0x94, 0xac, 0x1a, 0xe7, 0x47,
-	0x54, 0x8d, 0xf3, 0xc9, 0xaa, 0xf1, 0x5f, 0x56, 0xa1, 0x92, 0xd2, 0x3c, 0x2f, 0x88, 0xcc, 0x0d,
-	0x15, 0x44, 0x92, 0xe8, 0x24, 0x33, 0x1e, 0x9d, 0xd4, 0xa0, 0x18, 0x81, 0x92, 0x32, 0x8f, 0x1e,
-	0x67, 0x31, 0x18, 0xb9, 0x0c, 0x20, 0x7a, 0x1a, 0xdf, 0x15, 0xac, 0x27, 0x7c, 0x12, 0x5e, 0x16,
-	0x0c, 0xdf, 0x1b, 0x8c, 0x84, 0x2e, 0xf0, 0xa3, 0x43, 0x97, 0x5f, 0x02, 0x18, 0x1e, 0xd5, 0x03,
-	0xda, 0x6e, 0xea, 0x81, 0x80, 0x2e, 0xe3, 0xd0, 0x45, 0x49, 0x48, 0x6f, 0x05, 0x7d, 0x9b, 0x2e,
-	0x4e, 0xb2, 0xe9, 0x1a, 0x83, 0x3d, 0x0e, 0x06, 0xce, 0x87, 0xe8, 0x04, 0xa3, 0x26, 0xf3, 0x91,
-	0x1e, 0x35, 0x18, 0x72, 0xa2, 0x9e, 0xe7, 0x78, 0xa2, 0x10, 0x5d, 0xe6, 0xb4, 0x3d, 0x46, 0x22,
-	0x3f, 0x81, 0x39, 0x1e, 0x9f, 0xfc, 0x28, 0x1c, 0xd1, 0x76, 0xed, 0x53, 0x74, 0x35, 0x8a, 0x60,
-	0x68, 0x11, 0x3d, 0x29, 0xac, 0x9f, 0xe9, 0xa6, 0xc5, 0x5c, 0x6d, 0x6d, 0x33, 0x25, 0xbc, 0x15,
-	0xd1, 0xc9, 0x57, 0xa9, 0x43, 0x52, 0xc2, 0x43, 0xb2, 0x96, 0x5a, 0xc5, 0x84, 0x03, 0x32, 0x7c,
-	0x02, 0x7e, 0x32, 0xf9, 0x04, 0x0c, 0x01, 0x16, 0x65, 0x04, 0x60, 0x19, 0x19, 0x84, 0xe7, 0xaf,
-	0x15, 0x84, 0x57, 0x7f, 0x84, 0x20, 0xfc, 0xfc, 0xaa, 0x41, 0x78, 0xe1, 0xa2, 0x20, 0xbc, 0x06,
-	0xe5, 0x36, 0xf5, 0x0d, 0xcf, 0x74, 0x59, 0x74, 0xa9, 0x2d, 0xf2, 0xfd, 0x4f, 0x90, 0x98, 0x17,
-	0x32, 0x74, 0xe3, 0x44, 0x14, 0x03, 0x6e, 0x72, 0x2f, 0x84, 0x14, 0x2c, 0x06, 0x0c, 0x46, 0xd9,
-	0xda, 0xc5, 0x51, 0x76, 0x39, 0x11, 0x65, 0xfb, 0x6e, 0xf6, 0x76, 0xca, 0xcd, 0xde, 0x87, 0x6a,
-	0x4f, 0xff, 0xbe, 0x99, 0x28, 0x3f, 0xdc, 0x41, 0xeb, 0xa9, 0xf4, 0xf4, 0xef, 0x7f, 0x1d, 0x57,
-	0x20, 0x12, 0x50, 0x77, 0xe5, 0x7a, 0x50, 0x37, 0x1d, 0xed, 0xd7, 0x2e, 0x1d, 0xed, 0xef, 0x5e,
-	0x2b, 0xda, 0xab, 0x97, 0x89, 0xf6, 0x1b, 0x50, 0xee, 0x9a, 0xc1, 0x89, 0xe3, 0x9c, 0x36, 0x43,
-	0xcf, 0xe2, 0xe0, 0x7f, 0xbb, 0xfa, 0xf1, 0xc3, 0x2a, 0xbc, 0xe2, 0xe4, 0x63, 0xed, 0x40, 0x03,
-	0x21, 0x72, 0xec, 0x59, 0x83, 0x21, 0xeb, 0xfe, 0xf8, 0x90, 0x85, 0x4e, 0x42, 0xb7, 0xdb, 0xad,
-	0x73, 0x04, 0x3d, 0xe8, 0x24, 0xb0, 0x39, 0x08, 0x33, 0x3e, 0x99, 0x06, 0x66, 0x3c, 0xba, 0x1a,
-	0xcc, 0x78, 0x3c, 0x3d, 0xcc, 0x20, 0x8b, 0x50, 0xf0, 0x9f, 0x37, 0x99, 0x1a, 0x37, 0xf8, 0x05,
-	0xb9, 0xff, 0xfc, 0x4d, 0x18, 0xb0, 0xc0, 0xd2, 0x13, 0x57, 0xaa, 0x02, 0xb4, 0xce, 0xa4, 0xee,
-	0x59, 0xb5, 0x98, 0x7d, 0xbd, 0x50, 0xc7, 0x4b, 0x49, 0x31, 0xd8, 0x59, 0x52, 0x6e, 0x36, 0x24,
-	0xb9, 0xae, 0xdc, 0x6a, 0x48, 0xf2, 0x2d, 0xe5, 0x76, 0x43, 0x92, 0x89, 0x32, 0xaf, 0xbe, 0x82,
-	0x99, 0xa4, 0x2f, 0xc3, 0xac, 0x20, 0xce, 0xb4, 0x4d, 0xbb, 0xe3, 0x88, 0x7b, 0xe4, 0xb9, 0x21,
-	0xb7, 0xa7, 0x55, 0xdc, 0x44, 0x4b, 0xfd, 0x6d, 0x1e, 0x94, 0x1d, 0x74, 0xfd, 0x2c, 0x44, 0x71,
-	0x37, 0x73, 0xad, 0x1a, 0xd3, 0xf2, 0x25, 0x6a, 0x4c, 0xf5, 0x49, 0x39, 0xdb, 0xad, 0x69, 0x72,
-	0xb6, 0xdb, 0x93, 0x6a, 0x4c, 0x77, 0x26, 0xd4, 0x98, 0x56, 0xa6, 0x48, 0xe9, 0x56, 0xc7, 0xd6,
-	0x98, 0xd6, 0x2e, 0x59, 0x63, 0xba, 0x3b, 0x6d, 0x8d, 0x49, 0xbd, 0x42, 0xbe, 0x9e, 0x28, 0x46,
-	0xdc, 0xbf, 0x5a, 0x31, 0xe2, 0xc1, 0xf4, 0xc5, 0x88, 0x01, 0x6b, 0xcd, 0x28, 0xd9, 0x86, 0x24,
-	0x83, 0x52, 0x6e, 0x48, 0x72, 0x51, 0x91, 0x1b, 0x92, 0x5c, 0x52, 0xa0, 0x21, 0xc9, 0xb2, 0x52,
-	0x6a, 0x48, 0x72, 0x45, 0x99, 0x69, 0x48, 0x72, 0x59, 0xa9, 0x34, 0x24, 0x79, 0x46, 0xa9, 0x36,
-	0x24, 0xb9, 0xaa, 0xcc, 0x36, 0x24, 0x79, 0x51, 0x59, 0x6a, 0x48, 0xf2, 0xac, 0xa2, 0x34, 0x24,
-	0x59, 0x51, 0xe6, 0x1a, 0x92, 0x3c, 0xa7, 0x10, 0x6e, 0xe9, 0x0d, 0x49, 0x9e, 0x57, 0x16, 0x1a,
-	0x92, 0xbc, 0xa0, 0x2c, 0xc6, 0xa7, 0xe1, 0xa6, 0x52, 0x6b, 0x48, 0x72, 0x4d, 0x59, 0x56, 0xff,
-	0x36, 0x03, 0x73, 0xfb, 0x36, 0x3b, 0xe2, 0x41, 0xc2, 0x7e, 0xc7, 0xd5, 0xba, 0x2e, 0x5f, 0x14,
-	0x5d, 0x85, 0x72, 0xcb, 0x72, 0x8c, 0xd3, 0x66, 0x3f, 0x8d, 0x90, 0x35, 0x40, 0x12, 0x8f, 0xfc,
-	0x04, 0xa4, 0x4e, 0x68, 0x59, 0x08, 0xec, 0x65, 0x0d, 0x9f, 0xd5, 0xff, 0xce, 0x40, 0xf5, 0xc0,
-	0xf4, 0x83, 0x0b, 0x4e, 0xd5, 0x04, 0x64, 0xba, 0x0e, 0x15, 0x0c, 0xa3, 0xfd, 0xac, 0x20, 0x37,
-	0x64, 0x2f, 0x28, 0x20, 0xa6, 0x78, 0xa5, 0x4a, 0xef, 0x89, 0xe9, 0x07, 0x8e, 0xc7, 0xbf, 0xc3,
-	0xca, 0x69, 0x51, 0x33, 0x5e, 0x4d, 0xbe, 0xbf, 0x1a, 0x52, 0x07, 0xf9, 0xdd, 0x77, 0x2f, 0x4d,
-	0x2b, 0xa0, 0x1e, 0x62, 0xc9, 0x92, 0x16, 0xb7, 0xd5, 0x77, 0x30, 0xfb, 0xd2, 0x0a, 0xfd, 0x93,
-	0xc4, 0x4a, 0x1f, 0x40, 0x91, 0xcf, 0x23, 0xfa, 0x9c, 0x25, 0x35, 0x91, 0x88, 0x47, 0x9e, 0x41,
-	0x25, 0x70, 0x9a, 0xd1, 0xa2, 0xa3, 0x5b, 0xe6, 0x01, 0xa5, 0x94, 0x03, 0x27, 0x7a, 0xf6, 0xd5,
-	0x75, 0x50, 0x76, 0xa9, 0x45, 0x53, 0xce, 0x6a, 0xcc, 0x66, 0xab, 0x4f, 0xa1, 0x7a, 0x14, 0x38,
-	0xee, 0x94, 0xd2, 0xbf, 0xcf, 0xc2, 0xe2, 0xb1, 0xdb, 0xe6, 0xbe, 0x90, 0x1f, 0xb5, 0x29, 0x0c,
-	0xea, 0x5e, 0x3a, 0xbf, 0x9c, 0x74, 0x56, 0x73, 0xa9, 0xb3, 0xfa, 0xff, 0x51, 0x70, 0x1f, 0xf0,
-	0x76, 0xc5, 0x29, 0xbc, 0x9d, 0x3c, 0xb9, 0x80, 0x55, 0xba, 0xb0, 0x80, 0x05, 0xe3, 0x9d, 0xa1,
-	0xfa, 0xaf, 0x59, 0xa8, 0xbe, 0xa2, 0xc1, 0x81, 0xd3, 0xf5, 0xaf, 0x10, 0x70, 0xc6, 0x6d, 0x45,
-	0xa4, 0x8c, 0x0e, 0x5a, 0x26, 0xcf, 0x8f, 0x4b, 0x5c, 0x19, 0xdc, 0x58, 0xfd, 0xfe, 0x2d, 0x78,
-	0xe1, 0xa2, 0x5b, 0x70, 0xfc, 0x9e, 0xc7, 0x67, 0x96, 0xce, 0x4f, 0x80, 0x68, 0x31, 0x7a, 0xc7,
-	0xb1, 0x2c, 0xe7, 0xbd, 0xf8, 0xd4, 0x45, 0xb4, 0xf0, 0xa2, 0x47, 0x37, 0x2d, 0xa1, 0x33, 0x7c,
-	0x26, 0x8f, 0x40, 0x09, 0x7d, 0xda, 0xb4, 0x9c, 0x53, 0xb3, 0xd9, 0xd2, 0x8d, 0x53, 0x6a, 0xb7,
-	0xc5, 0x87, 0x30, 0xd5, 0xd0, 0xa7, 0x07, 0xce, 0xa9, 0xb9, 0xcd, 0xa9, 0x64, 0x03, 0xf2, 0xbe,
-	0x69, 0x1b, 0x54, 0x68, 0x6d, 0x0c, 0x64, 0xe3, 0x72, 0xdc, 0xd3, 0xaa, 0xbf, 0xcd, 0x02, 0x1c,
-	0x38, 0xdd, 0x6f, 0xa8, 0xef, 0xeb, 0x5d, 0xcc, 0x3d, 0xe2, 0xe8, 0x9f, 0x28, 0x5c, 0xc4, 0xa1,
-	0xfe, 0xb5, 0xde, 0xa3, 0x89, 0x2b, 0xc2, 0xdc, 0x05, 0x57, 0x84, 0xa9, 0xfb, 0xc6, 0xe2, 0xd8,
-	0xfb, 0xc6, 0x87, 0x20, 0x73, 0xec, 0x66, 0xf2, 0x95, 0x95, 0xb6, 0xcb, 0x1f, 0x3f, 0xac, 0x16,
-	0xf9, 0xe7, 0x06, 0xbb, 0x5a, 0x11, 0x99, 0xfb, 0xed, 0x84, 0x36, 0x21, 0xa5, 0xcd, 0xe8, 0x36,
-	0x52, 0x1a, 0x73, 0x1b, 0x19, 0x7d, 0x92, 0x28, 0x73, 0x4f, 0x84, 0x9f, 0x24, 0x3e, 0x81, 0x6c,
-	0x7c, 0xd1, 0x38, 0x2e, 0x40, 0x65, 0x03, 0x9f, 0x1d, 0xae, 0x1e, 0x57, 0x90, 0x70, 0x5a, 0x51,
-	0x53, 0x7d, 0x0b, 0xf3, 0x1a, 0x3f, 0x67, 0x7c, 0xeb, 0xa7, 0x38, 0xe6, 0x83, 0xb6, 0x95, 0x1d,
-	0xb2, 0x2d, 0xf5, 0x8f, 0x60, 0x5e, 0xc4, 0xa2, 0xd4, 0xa8, 0x13, 0x3f, 0xbc, 0x60, 0x6e, 0x8d,
-	0xc5, 0x8a, 0x69, 0xe7, 0xa2, 0x6e, 0x43, 0x29, 0xce, 0x22, 0x12, 0x97, 0x8a, 0x99, 0xe4, 0xa5,
-	0x22, 0x3b, 0xae, 0x2c, 0xcf, 0x11, 0xd7, 0xcf, 0xfc, 0xc2, 0xb1, 0xc4, 0x28, 0xfc, 0xb2, 0xf9,
-	0xdf, 0x33, 0x50, 0x4d, 0x03, 0x68, 0xd2, 0x80, 0x19, 0xdb, 0x69, 0xd3, 0xa6, 0x4f, 0x2d, 0x6a,
-	0x04, 0x8e, 0x27, 0x9c, 0xf7, 0x83, 0x11, 0x60, 0x7b, 0xfd, 0xb5, 0xd3, 0xa6, 0x47, 0x42, 0x8e,
-	0xe7, 0xcf, 0x15, 0x3b, 0x41, 0x22, 0xeb, 0x30, 0xef, 0x7a, 0xa6, 0xe3, 0x99, 0xc1, 0x79, 0xd3,
-	0xb0, 0x74, 0xdf, 0xe7, 0x76, 0xc9, 0x2f, 0x5a, 0xe7, 0x22, 0xd6, 0x0e, 0xe3, 0x30, 0xe3, 0xac,
-	0x7f, 0x05, 0x73, 0x43, 0x43, 0x5e, 0xea, 0xb3, 0xc2, 0xdf, 0x00, 0x2c, 0x72, 0x20, 0x1b, 0x3b,
-	0x8d, 0xcb, 0xc7, 0xdd, 0x7e, 0x25, 0xe7, 0xde, 0x14, 0x95, 0x9c, 0xcb, 0x55, 0x89, 0x46, 0xd5,
-	0x7d, 0x8a, 0x57, 0xab, 0xfb, 0x94, 0x2e, 0xae, 0xfb, 0x2c, 0x41, 0x21, 0xc4, 0x10, 0x16, 0x79,
-	0x2f, 0xde, 0x1a, 0xae, 0x4e, 0xc0, 0x88, 0xea, 0x44, 0x3f, 0xf3, 0xb9, 0x9f, 0xcc, 0x7c, 0x46,
-	0x16, 0x2d, 0x2a, 0xd7, 0x2a, 0x5a, 0x2c, 0xfd, 0x08, 0x45, 0x8b, 0x8d, 0xab, 0x16, 0x2d, 0x66,
-	0xa6, 0x2c, 0x5a, 0x54, 0x27, 0x15, 0x2d, 0x94, 0x49, 0x45, 0x8b, 0xb9, 0xe1, 0xa2, 0xc5, 0x6d,
-	0x28, 0x79, 0x54, 0x04, 0x75, 0xbc, 0x01, 0x93, 0xb5, 0x3e, 0x61, 0x44, 0x99, 0x62, 0x61, 0x7c,
-	0x99, 0x62, 0x71, 0xaa, 0x32, 0xc5, 0xdd, 0xe9, 0xca, 0x14, 0x37, 0x2f, 0x5d, 0xa6, 0xa8, 0x5d,
-	0xab, 0x4c, 0xb1, 0x7c, 0x99, 0x32, 0x45, 0x54, 0xed, 0xa9, 0x27, 0xaa, 0x3d, 0x89, 0xda, 0xc2,
-	0xad, 0xb1, 0xb5, 0x85, 0xdb, 0xd3, 0xd4, 0x16, 0xee, 0x5c, 0xad, 0xb6, 0xb0, 0x32, 0xa6, 0xb6,
-	0xb0, 0x36, 0x50, 0x5b, 0x18, 0x28, 0x9d, 0xa8, 0xe3, 0x4b, 0x27, 0xc9, 0x92, 0xc3, 0xfa, 0xd8,
-	0x92, 0xc3, 0x40, 0x1a, 0xc6, 0x53, 0x2c, 0x9e, 0x50, 0xcd, 0x2b, 0x0b, 0xea, 0x0e, 0x2c, 0x89,
-	0xc8, 0x74, 0x75, 0xe7, 0xa8, 0xfe, 0x43, 0x06, 0xe6, 0x59, 0x98, 0xba, 0x86, 0x7f, 0x4d, 0x64,
-	0x1d, 0xd9, 0x74, 0xd6, 0xf1, 0x18, 0x14, 0x9d, 0xc1, 0xa9, 0xa6, 0x69, 0x1b, 0x4e, 0xcf, 0x65,
-	0x18, 0x5f, 0x7c, 0x94, 0x39, 0x8b, 0xf4, 0xfd, 0x98, 0x9c, 0x4a, 0x46, 0xa4, 0x81, 0x64, 0xe4,
-	0x6f, 0x32, 0xb0, 0xc8, 0x33, 0x84, 0x6b, 0xcc, 0x52, 0x81, 0x9c, 0x1e, 0xa7, 0x73, 0xec, 0x91,
-	0x85, 0x9d, 0x8e, 0xe3, 0x19, 0x91, 0x53, 0xe5, 0x0d, 0xb6, 0xd3, 0xa7, 0x94, 0xba, 0xfc, 0x32,
-	0x9b, 0x7f, 0x46, 0x2c, 0x33, 0x82, 0x46, 0x5d, 0xa7, 0x21, 0xc9, 0x59, 0x25, 0x27, 0x3e, 0x0b,
-	0xda, 0x82, 0x85, 0x23, 0x06, 0x36, 0xae, 0xa1, 0xfc, 0xaf, 0x61, 0x9e, 0x65, 0x32, 0xd7, 0x18,
-	0xe1, 0xef, 0x32, 0x40, 0xb4, 0xd0, 0xbe, 0x86, 0x5e, 0x7e, 0x0e, 0xe0, 0x7a, 0xce, 0x19, 0xb5,
-	0x75, 0x1b, 0x3f, 0x8a, 0x67, 0xe0, 0x60, 0x31, 0x61, 0xbb, 0x87, 0x31, 0x53, 0x4b, 0x08, 0x26,
-	0x70, 0xa7, 0x34, 0x1a, 0x77, 0x0a, 0x2d, 0x7d, 0x0e, 0x55, 0x2d, 0xb4, 0x77, 0x3c, 0xc7, 0xbe,
-	0xc2, 0xea, 0x1e, 0xc3, 0x3c, 0x8f, 0xfe, 0xfc, 0x6f, 0x34, 0xd1, 0x08, 0x2c, 0x99, 0x35, 0x2d,
-	0xde, 0xbb, 0xa2, 0xe1, 0xb3, 0xfa, 0x02, 0xe6, 0xb9, 0x89, 0xa4, 0x45, 0xef, 0x41, 0x81, 0xff,
-	0x35, 0xa7, 0xff, 0x0d, 0x71, 0xfc, 0x87, 0x1e, 0x4d, 0xb0, 0xd4, 0xcf, 0x61, 0x41, 0x1c, 0xa4,
-	0x2b, 0x74, 0xbe, 0x0d, 0x05, 0x4e, 0x19, 0x79, 0xbf, 0xf8, 0x57, 0x19, 0x00, 0xce, 0xc6, 0xfb,
-	0xad, 0x69, 0x46, 0x8c, 0x3f, 0x32, 0xcb, 0x26, 0x3e, 0x32, 0xdb, 0x07, 0x82, 0x77, 0x39, 0xa6,
-	0x63, 0x37, 0xe3, 0x3f, 0x7a, 0x89, 0x82, 0xc0, 0x38, 0xc4, 0x3c, 0x17, 0xf5, 0x8a, 0x49, 0xea,
-	0x57, 0xd1, 0x7f, 0xb9, 0x78, 0x8d, 0xf1, 0x19, 0x94, 0xf9, 0x7b, 0x93, 0x15, 0xc6, 0xd9, 0xc4,
-	0xbc, 0xb0, 0xbe, 0x08, 0x7e, 0xfc, 0xac, 0xbe, 0x80, 0xc5, 0x57, 0xba, 0xd7, 0xd2, 0xbb, 0x74,
-	0xc7, 0xb1, 0x18, 0xb2, 0x8b, 0xf4, 0x75, 0x17, 0x2a, 0xfc, 0x63, 0x3b, 0x01, 0x4f, 0x39, 0x74,
-	0x2d, 0x73, 0x1a, 0x07, 0xa8, 0x35, 0x58, 0x1a, 0xec, 0xeb, 0xbb, 0x8e, 0xed, 0x53, 0x75, 0x11,
-	0xe6, 0xb7, 0x8c, 0xc0, 0x3c, 0xd3, 0x03, 0xba, 0x15, 0x06, 0x27, 0x62, 0x4c, 0x75, 0x09, 0x16,
-	0xd2, 0x64, 0x2e, 0xfe, 0xc4, 0xc3, 0x8f, 0xc7, 0x79, 0xa9, 0x46, 0x81, 0x4a, 0xe3, 0xcd, 0x76,
-	0xf3, 0xe8, 0xed, 0x96, 0xf6, 0x76, 0xff, 0xf5, 0x2b, 0xe5, 0x06, 0x99, 0x85, 0x32, 0xa3, 0x68,
-	0xc7, 0xaf, 0x5f, 0x33, 0x42, 0x26, 0x22, 0xbc, 0xdc, 0xda, 0x3f, 0x38, 0xd6, 0xf6, 0x94, 0x6c,
-	0x44, 0x38, 0x3a, 0xde, 0xd9, 0xd9, 0x3b, 0x3a, 0x52, 0x72, 0xa4, 0x0a, 0xc0, 0x08, 0xbf, 0xda,
-	0x3f, 0x38, 0xd8, 0xdb, 0x55, 0x24, 0x32, 0x07, 0x33, 0xac, 0xbd, 0xf7, 0x4a, 0xdb, 0x3b, 0x3a,
-	0x62, 0x83, 0x14, 0x9e, 0xbc, 0x01, 0xe8, 0x7f, 0x38, 0x4d, 0x00, 0x0a, 0x6c, 0xb8, 0xbd, 0x5d,
-	0xe5, 0x06, 0x29, 0x43, 0x31, 0x1a, 0x29, 0x83, 0x8d, 0x5f, 0xed, 0x1f, 0x1e, 0xee, 0xed, 0x2a,
-	0x59, 0x52, 0x01, 0x39, 0x9e, 0x57, 0x8e, 0xcc, 0x40, 0x49, 0xdb, 0xdb, 0x79, 0xf3, 0xed, 0x9e,
-	0xc6, 0xde, 0xf1, 0xe4, 0x2b, 0x28, 0x27, 0xee, 0xac, 0xd9, 0x9c, 0x0e, 0xdf, 0xec, 0xc6, 0xb3,
-	0xbe, 0x11, 0x11, 0xfa, 0x43, 0x57, 0x01, 0x18, 0x41, 0xbc, 0x37, 0xfb, 0xe4, 0x1f, 0x33, 0xfd,
-	0x92, 0x31, 0x1f, 0x63, 0x11, 0xe6, 0x0e, 0xf7, 0x0f, 0xf7, 0x0e, 0xf6, 0x5f, 0xef, 0x25, 0x15,
-	0xb2, 0x00, 0x4a, 0x4c, 0xee, 0x6b, 0xe5, 0x26, 0xcc, 0xf7, 0xa9, 0x7b, 0xb1, 0x78, 0x36, 0x25,
-	0x1e, 0xe9, 0x2c, 0x47, 0xe6, 0x61, 0x36, 0xa6, 0x1e, 0x6e, 0x1d, 0x1f, 0xa1, 0x9e, 0x92, 0xa2,
-	0x47, 0x6f, 0xb7, 0x5e, 0xef, 0x6e, 0xff, 0x99, 0x92, 0x4f, 0x4d, 0x63, 0x47, 0xdb, 0x3a, 0xfa,
-	0x13, 0xd4, 0xe0, 0xe6, 0xdf, 0x57, 0x20, 0xb7, 0x75, 0xb8, 0x4f, 0xd6, 0xa1, 0x14, 0xd7, 0xa7,
-	0xc9, 0xa2, 0xf8, 0xfb, 0x40, 0xba, 0x5e, 0x5d, 0x8f, 0xd3, 0x23, 0xf5, 0x06, 0xf9, 0x19, 0x40,
-	0xbf, 0x20, 0x48, 0x96, 0x04, 0xc8, 0x1b, 0xa8, 0x10, 0xd6, 0x2b, 0x51, 0x0f, 0x34, 0xd3, 0x1b,
-	0xe4, 0x19, 0x14, 0x45, 0xb5, 0x8e, 0xf0, 0xf8, 0x9f, 0xae, 0xdd, 0x0d, 0xca, 0x3f, 0xcb, 0x90,
-	0x4d, 0x90, 0xa3, 0xb2, 0x17, 0xe1, 0x00, 0x7e, 0xa0, 0x0a, 0x36, 0xa2, 0xcf, 0x17, 0x50, 0x8a,
-	0xcb, 0x57, 0x62, 0x2d, 0x83, 0xe5, 0xac, 0xfa, 0xd2, 0xd0, 0x11, 0xdd, 0xeb, 0xb9, 0xc1, 0xb9,
-	0x7a, 0x83, 0xfc, 0x02, 0x8a, 0xa2, 0x98, 0x25, 0xe6, 0x98, 0x2e, 0x6d, 0x8d, 0xe9, 0xf9, 0x02,
-	0x2a, 0xc9, 0xc4, 0x94, 0xd4, 0x92, 0x5a, 0x49, 0x66, 0x9d, 0xf5, 0x6a, 0x3f, 0x39, 0x15, 0x9a,
-	0xf9, 0x0c, 0x4a, 0x71, 0x6e, 0x2a, 0xe6, 0x3c, 0x98, 0xab, 0x0e, 0xf7, 0x7a, 0x96, 0x21, 0xdb,
-	0xf8, 0xf9, 0x6d, 0x9c, 0x62, 0x8b, 0x77, 0x8e, 0xc8, 0xba, 0xc7, 0xcc, 0xfb, 0x25, 0x54, 0xd3,
-	0x29, 0x1d, 0xa9, 0x27, 0x0c, 0x60, 0x20, 0x92, 0x8d, 0x19, 0x67, 0x07, 0x66, 0x07, 0xe0, 0x0f,
-	0xb9, 0x95, 0x54, 0xc1, 0xe0, 0x48, 0xc3, 0xb7, 0x26, 0xea, 0x0d, 0xf2, 0x25, 0x54, 0x92, 0xe8,
-	0x47, 0x2c, 0x68, 0x04, 0x20, 0xaa, 0x93, 0xa1, 0xee, 0x3e, 0x5f, 0x4c, 0x1a, 0x99, 0x88, 0xc5,
-	0x8c, 0x84, 0x2b, 0x63, 0x16, 0xb3, 0x0b, 0x33, 0x29, 0x30, 0x41, 0x96, 0x85, 0x31, 0x0c, 0x03,
-	0x8c, 0x31, 0xa3, 0x6c, 0x43, 0x25, 0x89, 0x27, 0xc4, 0x6a, 0x46, 0x40, 0x8c, 0x31, 0x63, 0x7c,
-	0x0d, 0xe5, 0x04, 0xa0, 0x20, 0xfc, 0x0f, 0xb3, 0xc3, 0x10, 0x63, 0xbc, 0x49, 0x8b, 0x90, 0x2f,
-	0x4c, 0x3a, 0x0d, 0x00, 0xc6, 0xcf, 0x3f, 0x19, 0xef, 0xc5, 0xfc, 0x47, 0x40, 0x80, 0xf1, 0x63,
-	0x24, 0x81, 0x80, 0x18, 0x63, 0x04, 0x36, 0x18, 0xbb, 0x02, 0x60, 0x26, 0x20, 0x46, 0xb8, 0x40,
-	0xae, 0xae, 0x0c, 0x04, 0x49, 0x66, 0x0f, 0x7f, 0x0c, 0x33, 0x29, 0x28, 0x21, 0xf6, 0x71, 0x14,
-	0xbc, 0xa8, 0x0f, 0x06, 0x59, 0xec, 0x2e, 0x7c, 0xc9, 0x96, 0x65, 0x5d, 0xf8, 0xde, 0x8b, 0xe7,
-	0xfd, 0x1c, 0x8a, 0xa2, 0x06, 0x2b, 0x34, 0x9f, 0xae, 0xc8, 0x8a, 0x37, 0xf6, 0x4b, 0x8c, 0x78,
-	0xa6, 0xf7, 0xa0, 0x92, 0x8c, 0xb0, 0x42, 0x61, 0x23, 0x62, 0x71, 0x7d, 0x79, 0x04, 0x47, 0x44,
-	0x6f, 0x3c, 0x09, 0xe9, 0x32, 0xbb, 0x38, 0x09, 0x23, 0x6b, 0xef, 0x17, 0xaf, 0x61, 0xfb, 0xf3,
-	0xdf, 0x7d, 0x5c, 0xc9, 0xfc, 0xc7, 0xc7, 0x95, 0xcc, 0x7f, 0x7d, 0x5c, 0xc9, 0xfc, 0xf9, 0x4f,
-	0xbb, 0x66, 0x70, 0x12, 0xb6, 0xd6, 0x0d, 0xa7, 0xb7, 0xe1, 0xea, 0xc6, 0xc9, 0x79, 0x9b, 0x7a,
-	0xc9, 0x27, 0xdf, 0x33, 0x36, 0xfa, 0x7f, 0xa0, 0x6f, 0x15, 0x70, 0xb8, 0xe7, 0xff, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0xec, 0x9d, 0xa3, 0x96, 0x55, 0x3f, 0x00, 0x00,
+	// 5073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x76, 0xbf, 0x49, 0x36, 0xc9, 0xe6, 0x21, 0x45, 0xb5, 0x4a, 0x0f, 0xb7, 0x69, 0x5b, 0x92, 0xdb,
+	0x8f, 0xb1, 0x3d, 0x1e, 0xd9, 0x23, 0xcf, 0xcc, 0xff, 0x5e, 0xcf, 0xfc, 0x67, 0x46, 0x2f, 0x3b,
+	0xe2, 0xd5, 0xd8, 0xba, 0x4d, 0x7b, 0x82, 0x64, 0x43, 0x34, 0xc9, 0x22, 0xd5, 0x56, 0xb3, 0xbb,
+	0xa7, 0x1f, 0xf2, 0x68, 0x36, 0x41, 0xbe, 0x41, 0x90, 0x00, 0x59, 0x64, 0x11, 0x24, 0x1f, 0x20,
+	0x48, 0x56, 0x59, 0xdd, 0x4d, 0x76, 0x17, 0x08, 0x02, 0x64, 0x93, 0xad, 0x11, 0x18, 0x17, 0xc9,
+	0x07, 0x48, 0x56, 0xb9, 0x9b, 0xe0, 0x54, 0x55, 0x37, 0xbb, 0x49, 0x8a, 0xa4, 0xa4, 0x41, 0x16,
+	0x02, 0xba, 0xce, 0x39, 0x55, 0x5d, 0x75, 0xea, 0xd4, 0x79, 0xfc, 0xaa, 0x29, 0x58, 0x6a, 0x5b,
+	0x26, 0xb5, 0x83, 0xc7, 0xae, 0xeb, 0xe3, 0xdf, 0x86, 0xeb, 0x39, 0x81, 0x43, 0x72, 0xae, 0xeb,
+	0xd7, 0xae, 0xf7, 0x1c, 0xa7, 0x67, 0xd1, 0xc7, 0x8c, 0xd4, 0x0a, 0xbb, 0x8f, 0x69, 0xdf, 0x0d,
+	0x4e, 0xb9, 0x44, 0x6d, 0x6d, 0x98, 0x19, 0x98, 0x7d, 0xea, 0x07, 0x46, 0xdf, 0x15, 0x02, 0xab,
+	0xc3, 0x02, 0x9d, 0xd0, 0x33, 0x02, 0xd3, 0xb1, 0x05, 0x7f, 0xa9, 0xe7, 0xf4, 0x1c, 0xf6, 0xf8,
+	0x18, 0x9f, 0x22, 0x6a, 0x34, 0x9d, 0xae, 0x8f, 0x7f, 0x9c, 0xaa, 0x1d, 0x43, 0xb9, 0x41, 0xdb,
+	0x1e, 0x0d, 0xbe, 0x73, 0x42, 0x3b, 0x20, 0x04, 0x24, 0xdb, 0xe8, 0x53, 0x35, 0xb3, 0x9e, 0xb9,
+	0x5f, 0xd2, 0xd9, 0x33, 0x51, 0x20, 0x77, 0x4c, 0x4f, 0x55, 0x89, 0x91, 0xf0, 0x91, 0xdc, 0x04,
+	0xe8, 0xa3, 0x78, 0xd3, 0x35, 0x82, 0x23, 0x35, 0xcb, 0x18, 0x25, 0x46, 0x39, 0x34, 0x82, 0x23,
+	0x72, 0x15, 0x8a, 0xd4, 0x3e, 0x69, 0x9e, 0x18, 0x9e, 0x9a, 0x63, 0xbc, 0x02, 0xb5, 0x4f, 0xbe,
+	0x37, 0x3c, 0xed, 0xf7, 0x39, 0x28, 0xbd, 0xf6, 0x0c, 0xdb, 0xef, 0x3a, 0x5e, 0x9f, 0x2c, 0x41,
+	0xde, 0xec, 0x1b, 0xbd, 0xe8, 0x65, 0xbc, 0x81, 0x6f, 0x6b, 0xf7, 0x3b, 0x6a, 0x76, 0x3d, 0x87,
+	0x6f, 0x6b, 0xf7, 0x3b, 0x6c, 0x38, 0xcf, 0x6b, 0x22, 0x75, 0x8e, 0x51, 0x0b, 0xd4, 0xf3, 0x76,
+	0xfa, 0x1d, 0xf2, 0x00, 0x72, 0xd4, 0x3e, 0x51, 0x73, 0xeb, 0xb9, 0xfb, 0xe5, 0xcd, 0xab, 0x1b,
+	0xa8, 0xe3, 0x78, 0xf4, 0x8d, 0x3d, 0xfb, 0x64, 0xcf, 0x0e, 0xbc, 0x53, 0x1d, 0x65, 0xc8, 0x43,
+	0x28, 0xfa, 0x6c, 0x99, 0xbe, 0x2a, 0x31, 0x71, 0x85, 0x89, 0x27, 0x96, 0xae, 0x47, 0x02, 0xe4,
+	0x11, 0x10, 0x36, 0x95, 0xa6, 0x1b, 0x5a, 0x56, 0x33, 0xea, 0x56, 0x62, 0xaf, 0x56, 0x18, 0xe7,
+	0x30, 0xb4, 0xac, 0x86, 0x90, 0x5e, 0x82, 0xbc, 0x1f, 0x74, 0x4c, 0x5b, 0xcd, 0x33, 0x01, 0xde,
+	0x20, 0xd7, 0xa1, 0x84, 0x73, 0xe6, 0x9c, 0x2a, 0xe3, 0xc8, 0xd4, 0xf3, 0x1a, 0x8c, 0xf9, 0x08,
+	0x88, 0xd1, 0x6e, 0x53, 0x37, 0x68, 0x7a, 0x34, 0x08, 0x3d, 0xbb, 0xd9, 0x76, 0x3a, 0x54, 0x2d,
+	0xac, 0xe7, 0xee, 0xe7, 0x74, 0x85, 0x73, 0x74, 0xc6, 0xd8, 0x71, 0x3a, 0x14, 0x5f, 0xd0, 0xa1,
+	0xad, 0xb0, 0xa7, 0x16, 0xd7, 0x33, 0xf7, 0x65, 0x9d, 0x37, 0x70, 0xa3, 0x42, 0x9f, 0x7a, 0x2a,
+	0xf0, 0x8d, 0xc2, 0x67, 0xb2, 0x06, 0xe5, 0x77, 0x8e, 0x77, 0x6c, 0xda, 0xbd, 0x66, 0xc7, 0xf4,
+	0xd4, 0x32, 0x63, 0x81, 0x20, 0xed, 0x9a, 0x1e, 0x59, 0x05, 0xe8, 0x38, 0xed, 0x63, 0xea, 0x75,
+	0x4d, 0x8b, 0xaa, 0x15, 0xce, 0x1f, 0x50, 0xc8, 0x1d, 0xc8, 0xb7, 0x42, 0xd3, 0xea, 0xa8, 0xf3,
+	0xeb, 0x99, 0xfb, 0xe5, 0xcd, 0x2a, 0xd3, 0xd1, 0x36, 0x52, 0x1a, 0x2e, 0x6d, 0xeb, 0x9c, 0x59,
+	0xfb, 0x02, 0xe4, 0x48, 0xb9, 0x91, 0x6d, 0x64, 0x06, 0xb6, 0xb1, 0x04, 0xf9, 0x13, 0xc3, 0x0a,
+	0xa9, 0x30, 0x0b, 0xde, 0x78, 0x96, 0xfd, 0x45, 0x46, 0xfb, 0x35, 0x94, 0xe2, 0xb1, 0x70, 0xfe,
+	0xcc, 0x78, 0x84, 0xa1, 0xe1, 0x33, 0xa9, 0x81, 0x6c, 0x19, 0x76, 0x2f, 0x34, 0x7a, 0x51, 0xef,
+	0xb8, 0x3d, 0x30, 0x96, 0x5c, 0xc2, 0x58, 0xb4, 0x07, 0x90, 0x7f, 0xfd, 0xbc, 0xee, 0xb4, 0xc8,
+	0x3a, 0x14, 0x82, 0x6e, 0xf3, 0xad, 0xd3, 0xe2, 0x03, 0x6e, 0x97, 0x3e, 0xbc, 0x5f, 0xe3, 0x2c,
+	0x3d, 0x1f, 0x74, 0xeb, 0x4e, 0x4b, 0xab, 0x41, 0x61, 0xaf, 0xe7, 0x51, 0xdf, 0xc7, 0x39, 0xbf,
+	0xd1, 0x0f, 0xa2, 0x39, 0xbf, 0xd1, 0x0f, 0xb4, 0x9b, 0x90, 0xc3, 0x41, 0x56, 0x20, 0x6b, 0x76,
+	0xc4, 0x00, 0x85, 0x0f, 0xef, 0xd7, 0xb2, 0xfb, 0xbb, 0x7a, 0xd6, 0xec, 0x68, 0xff, 0x93, 0x01,
+	0xf9, 0x3b, 0x1a, 0x18, 0x1d, 0x23, 0x30, 0xc8, 0xb7, 0x50, 0x36, 0x6c, 0xdb, 0x09, 0xd8, 0x81,
+	0xf3, 0xd5, 0x0c, 0xb3, 0xa6, 0x55, 0xa6, 0xa9, 0x48, 0x66, 0x63, 0x6b, 0x20, 0xc0, 0x6d, 0x30,
+	0xd9, 0x85, 0x7c, 0x0a, 0x05, 0xcb, 0x68, 0x51, 0xcb, 0x67, 0x46, 0x5e, 0xde, 0xbc, 0x96, 0xee,
+	0x7c, 0xc0, 0x78, 0xbc, 0x9f, 0x10, 0xac, 0x7d, 0x0d, 0xca, 0xf0, 0x98, 0xe7, 0x51, 0x7d, 0xed,
+	0x97, 0x50, 0x4e, 0x0c, 0x7b, 0xae, 0x5d, 0xfb, 0x13, 0x28, 0x36, 0xa8, 0x77, 0x62, 0xb6, 0x29,
+	0xb9, 0x0d, 0x73, 0xa6, 0x1d, 0x50, 0xcf, 0x36, 0xac, 0xa6, 0xeb, 0x78, 0x01, 0x1b, 0x20, 0xaf,
+	0x57, 0x22, 0xe2, 0xa1, 0xe3, 0x05, 0x28, 0x44, 0x7f, 0x4c, 0x0a, 0x65, 0xb9, 0x10, 0xfd, 0x31,
+	0x21, 0x84, 0x9a, 0x76, 0xd5, 0x5c, 0x42, 0xd3, 0x87, 0x7a, 0xd6, 0x74, 0xd1, 0x2a, 0x82, 0x53,
+	0x97, 0x0a, 0x5f, 0xc3, 0x9e, 0x35, 0x0a, 0xf9, 0x86, 0xeb, 0x84, 0x01, 0xb9, 0x01, 0x25, 0xe7,
+	0x84, 0x7a, 0xef, 0x3c, 0x33, 0xe0, 0x3e, 0x43, 0xd6, 0x07, 0x04, 0x72, 0x0f, 0x4f, 0x38, 0x9b,
+	0x27, 0x7b, 0x63, 0x79, 0xb3, 0x22, 0x4e, 0x38, 0xa3, 0xe9, 0x11, 0x93, 0xac, 0x40, 0xa1, 0x6f,
+	0x78, 0xc7, 0x34, 0xf6, 0x4d, 0xbc, 0xa5, 0xfd, 0x63, 0x16, 0xe4, 0xc3, 0xe7, 0x8d, 0x7d, 0xdb,
+	0x0d, 0xc7, 0xbb, 0x41, 0x02, 0x92, 0x47, 0x5d, 0x47, 0x68, 0x88, 0x3d, 0xe3, 0x60, 0x2d, 0xcf,
+	0xb0, 0xdb, 0x47, 0xd1, 0x60, 0xbc, 0x85, 0xf4, 0xb6, 0xd3, 0xef, 0x9b, 0x81, 0x58, 0x89, 0x68,
+	0xe1, 0x18, 0x3d, 0xcb, 0x69, 0xa9, 0x79, 0x3e, 0x06, 0x3e, 0xa3, 0x7b, 0x7b, 0xeb, 0x98, 0x76,
+	0xd3, 0xb1, 0x55, 0x99, 0x0b, 0x63, 0xf3, 0x95, 0x8d, 0x5e, 0xd6, 0x09, 0x03, 0xea, 0x35, 0xb1,
+	0xad, 0x56, 0xc4, 0x82, 0x91, 0x52, 0x77, 0x4c, 0x9b, 0x5c, 0x03, 0xb9, 0xe7, 0x39, 0xa1, 0xdb,
+	0x6c, 0x9d, 0x8a, 0xa3, 0x5e, 0x64, 0xed, 0xed, 0x53, 0x7c, 0x8d, 0x65, 0xfc, 0x74, 0xaa, 0x16,
+	0x58, 0x1f, 0xf6, 0x8c, 0xce, 0x81, 0x05, 0x99, 0x26, 0x9e, 0x74, 0x5f, 0x38, 0x13, 0x60, 0xa4,
+	0xe7, 0x48, 0x21, 0x55, 0xc8, 0xfa, 0x4f, 0xd5, 0x12, 0xa3, 0x67, 0xfd, 0xa7, 0xa8, 0xd0, 0xc0,
+	0x33, 0x7b, 0x3d, 0xe1, 0x64, 0x98, 0x42, 0xbb, 0xe8, 0x61, 0x19, 0x4d, 0x8f, 0x98, 0xda, 0xdf,
+	0x67, 0xa0, 0xb4, 0xe3, 0x39, 0xf6, 0xb9, 0x35, 0x27, 0x34, 0x94, 0x1b, 0xd6, 0x90, 0xef, 0xd2,
+	0x76, 0x64, 0x01, 0xf8, 0x9c, 0xde, 0xf8, 0xc2, 0xf0, 0xc6, 0x3f, 0x41, 0x07, 0x6c, 0x78, 0x01,
+	0x53, 0x6a, 0x79, 0xb3, 0xb6, 0xc1, 0xa3, 0xe3, 0x46, 0x14, 0x1d, 0x37, 0x5e, 0x47, 0xe1, 0x53,
+	0xe7, 0x82, 0x9a, 0x09, 0xf2, 0x0b, 0x33, 0x38, 0x7b, 0xbe, 0xd7, 0x20, 0x17, 0x7a, 0x16, 0x9f,
+	0xee, 0x76, 0xf1, 0xc3, 0xfb, 0x35, 0x74, 0x12, 0x3a, 0xd2, 0xce, 0xbb, 0xe1, 0xda, 0x7f, 0x65,
+	0x20, 0xcf, 0x5f, 0xb4, 0x06, 0x39, 0xb7, 0xeb, 0xb3, 0xe9, 0x97, 0x37, 0xe7, 0x98, 0x6d, 0x46,
+	0xe6, 0xa6, 0x23, 0x87, 0xac, 0x82, 0xc4, 0x36, 0xba, 0xc8, 0x9c, 0x02, 0x30, 0x09, 0xce, 0x66,
+	0x74, 0xb2, 0x0e, 0x79, 0xb6, 0xbf, 0xaa, 0x3c, 0x22, 0xc0, 0x19, 0x28, 0xd1, 0xf6, 0x1c, 0x3f,
+	0xf2, 0x2b, 0x29, 0x09, 0xc6, 0x40, 0x89, 0xd0, 0x36, 0x1d, 0x5b, 0xcd, 0x8d, 0x4a, 0x30, 0x06,
+	0xd1, 0x40, 0x6a, 0x7b, 0x8e, 0xad, 0x4a, 0x89, 0x08, 0x10, 0xef, 0xae, 0xce, 0x78, 0xb8, 0x94,
+	0x9e, 0x19, 0xe9, 0x9b, 0x2f, 0x25, 0xd2, 0xa7, 0x8e, 0x1c, 0xed, 0x18, 0xe4, 0xba, 0xd3, 0x4a,
+	0x2b, 0x58, 0x4a, 0x28, 0xf8, 0x76, 0xac, 0xad, 0x0c, 0x1b, 0xa3, 0xcc, 0x2c, 0x6b, 0x87, 0x91,
+	0x46, 0xce, 0x4a, 0x36, 0x71, 0x56, 0x22, 0xc3, 0xce, 0x0d, 0x0c, 0x5b, 0x7b, 0x03, 0xf3, 0x87,
+	0x86, 0x67, 0x58, 0x16, 0xb5, 0x4c, 0xbf, 0xcf, 0x82, 0x4b, 0x0d, 0xe4, 0xb6, 0x63, 0xfb, 0x81,
+	0x61, 0x73, 0xf7, 0x23, 0xe9, 0x71, 0x9b, 0xac, 0x43, 0xb9, 0xed, 0xd0, 0x6e, 0xd7, 0x6c, 0x63,
+	0x36, 0xc4, 0x46, 0xca, 0xe8, 0x49, 0x52, 0x5d, 0x92, 0x33, 0x4a, 0x56, 0x7b, 0x0a, 0x25, 0xb6,
+	0x00, 0x3c, 0x1c, 0x71, 0xb4, 0x92, 0x12, 0xd1, 0x8a, 0x80, 0x74, 0x64, 0xf8, 0x47, 0x4c, 0x0d,
+	0x15, 0x9d, 0x3d, 0x6b, 0x5f, 0x42, 0x7e, 0xd7, 0x08, 0xc2, 0xfe, 0x59, 0xa1, 0x84, 0xd4, 0x20,
+	0xf7, 0x56, 0xac, 0xa9, 0xbc, 0x29, 0x33, 0xd5, 0x61, 0x8c, 0x42, 0xa2, 0xf6, 0xdb, 0x0c, 0x94,
+	0x58, 0xef, 0x7d, 0xbb, 0xeb, 0xe0, 0x56, 0x75, 0xb0, 0x21, 0x54, 0xc4, 0xb7, 0x8a, 0xb1, 0x75,
+	0xce, 0x20, 0x77, 0x99, 0xe1, 0x07, 0xdc, 0xdf, 0x55, 0x37, 0xe7, 0x07, 0x12, 0x0d, 0x24, 0xeb,
+	0x9c, 0x4b, 0x3e, 0xe2, 0x62, 0x3e, 0x5b, 0x6a, 0x79, 0x73, 0x81, 0x9b, 0x9e, 0xe7, 0xb4, 0xa9,
+	0xef, 0xa3, 0xa0, 0xcf, 0x05, 0x7d, 0x72, 0x0f, 0x4a, 0x6e, 0xd7, 0x6f, 0xf2, 0x31, 0xf9, 0xfe,
+	0x97, 0xd8, 0xc6, 0xa0, 0x0a, 0x74, 0xd9, 0xed, 0x32, 0x71, 0x4a, 0x6e, 0x81, 0x84, 0x81, 0x8a,
+	0x25, 0x3c, 0x6c, 0xff, 0x85, 0x08, 0x4e, 0x5b, 0x67, 0x2c, 0xed, 0x1f, 0x32, 0x50, 0xda, 0xea,
+	0xf5, 0x3c, 0xda, 0xc3, 0x0e, 0x4b, 0x90, 0x6f, 0x63, 0x8a, 0xc5, 0x96, 0x92, 0xd3, 0x79, 0x03,
+	0xf5, 0xd7, 0xa7, 0x86, 0xcd, 0x66, 0x9f, 0xd1, 0xd9, 0x33, 0x1e, 0x23, 0x3f, 0xe8, 0x74, 0xe8,
+	0x89, 0xd8, 0x17, 0xd1, 0x22, 0x0f, 0x40, 0xe9, 0x9a, 0xdd, 0xe0, 0xa8, 0xe9, 0x52, 0xaf, 0x4d,
+	0xed, 0xc0, 0xb4, 0xf8, 0x0c, 0x33, 0xfa, 0x3c, 0xa3, 0x1f, 0xc6, 0x64, 0xf2, 0x05, 0x5c, 0xb5,
+	0x4d, 0x9b, 0x32, 0x47, 0x37, 0xd4, 0x23, 0xcf, 0x7a, 0x2c, 0x73, 0xf6, 0xf3, 0x74, 0x3f, 0xed,
+	0xcf, 0xb3, 0x50, 0x49, 0x6a, 0x85, 0x7c, 0x0d, 0x73, 0x1d, 0xe7, 0x9d, 0x6d, 0x39, 0x46, 0xa7,
+	0x89, 0x19, 0xb8, 0xd8, 0x88, 0x6b, 0x23, 0xfe, 0x65, 0x57, 0x64, 0xdf, 0x7a, 0x25, 0x92, 0x47,
+	0x8f, 0x43, 0xbe, 0x82, 0x8a, 0xcb, 0xc7, 0xe3, 0xdd, 0xb3, 0xd3, 0xba, 0x97, 0x85, 0x38, 0xeb,
+	0xfd, 0x0c, 0xca, 0xa1, 0x3b, 0x78, 0x77, 0x6e, 0x5a, 0x67, 0xe0, 0xd2, 0xac, 0xef, 0x5d, 0xa8,
+	0xc6, 0x33, 0x6f, 0x9d, 0x06, 0xd4, 0x67, 0xba, 0x92, 0xf4, 0x78, 0x3d, 0xdb, 0x48, 0x24, 0xb7,
+	0xa0, 0x12, 0xba, 0x09, 0xa1, 0x3c, 0x13, 0x12, 0xaf, 0x65, 0x22, 0xda, 0x5f, 0x65, 0x61, 0x39,
+	0xde, 0xc7, 0x94, 0x76, 0x9e, 0x8e, 0xd7, 0x0e, 0x77, 0x18, 0x71, 0x97, 0x21, 0x95, 0x7c, 0x3a,
+	0x56, 0x25, 0xc3, 0x7d, 0x52, 0x7a, 0x78, 0x3c, 0x4e, 0x0f, 0xc3, 0x3d, 0x92, 0x8b, 0xff, 0x7c,
+	0xec, 0xe2, 0x47, 0xfb, 0x0c, 0x29, 0xe3, 0xd3, 0x31, 0xca, 0x18, 0x33, 0xb5, 0xa4, 0x72, 0xfe,
+	0x39, 0x0b, 0x95, 0x3f, 0x74, 0x30, 0x79, 0x40, 0x95, 0x84, 0x3e, 0x79, 0x00, 0xa5, 0x77, 0xac,
+	0xdd, 0x8c, 0xcf, 0x7e, 0xe5, 0xc3, 0xfb, 0x35, 0x99, 0x0b, 0xed, 0xef, 0xea, 0x32, 0x67, 0xef,
+	0x77, 0x30, 0x5f, 0x7d, 0xeb, 0xb4, 0x50, 0x2e, 0x3b, 0xc8, 0x57, 0xd1, 0x67, 0xee, 0xea, 0xf9,
+	0xb7, 0x4e, 0x6b, 0xbf, 0x83, 0x8e, 0x98, 0x9d, 0x32, 0xee, 0xa9, 0xab, 0x03, 0x4f, 0xcd, 0x4e,
+	0x23, 0xe3, 0x91, 0xcf, 0xa0, 0xc8, 0x22, 0x1a, 0xed, 0xa8, 0xd2, 0xd4, 0xe0, 0x17, 0x89, 0x0e,
+	0x1c, 0x42, 0x7e, 0x8a, 0x43, 0xb8, 0x09, 0xf0, 0x43, 0x48, 0x43, 0xda, 0xf4, 0xcd, 0x9f, 0x78,
+	0xe0, 0xcd, 0xe9, 0x25, 0x46, 0x69, 0x98, 0x3f, 0x71, 0x33, 0x33, 0x02, 0xa3, 0x29, 0xb6, 0x8b,
+	0x76, 0x58, 0x52, 0x91, 0xd3, 0xe7, 0x90, 0x7a, 0x18, 0x11, 0x63, 0x31, 0x8f, 0xb6, 0x31, 0x68,
+	0xd3, 0x8e, 0x2a, 0x0f, 0xc4, 0xf4, 0x88, 0xa8, 0x79, 0x50, 0xd1, 0xa9, 0xef, 0x84, 0x5e, 0x9b,
+	0x32, 0x1f, 0x8e, 0x75, 0xa0, 0x1b, 0x32, 0x35, 0x66, 0x75, 0x7c, 0x64, 0x99, 0x1b, 0xed, 0x3b,
+	0xde, 0xa9, 0x08, 0x09, 0xa2, 0x45, 0x56, 0x21, 0xd7, 0x73, 0x43, 0x35, 0x9f, 0xc8, 0xfa, 0x5e,
+	0x1c, 0xbe, 0xc1, 0x41, 0x74, 0x64, 0xa0, 0xa3, 0xe9, 0x98, 0xfe, 0x71, 0xe4, 0xbc, 0xf1, 0xb9,
+	0x2e, 0xc9, 0x39, 0x45, 0xd2, 0x3e, 0x87, 0xa2, 0x90, 0x8c, 0x33, 0xcf, 0xcc, 0x20, 0xf3, 0xc4,
+	0x17, 0xda, 0x61, 0xbf, 0x45, 0x3d, 0xf6, 0xc2, 0x9c, 0x2e, 0x5a, 0xda, 0xbf, 0x49, 0x50, 0xde,
+	0x0b, 0xda, 0x1d, 0x16, 0xe3, 0xba, 0x4e, 0xe4, 0xd4, 0x33, 0x63, 0x9c, 0x3a, 0x79, 0x00, 0xb2,
+	0x6b, 0xba, 0xd4, 0x32, 0xed, 0xc8, 0xdc, 0x45, 0xec, 0x17, 0x44, 0x3d, 0x66, 0x93, 0x27, 0x30,
+	0xe7, 0x84, 0x81, 0x1b, 0x06, 0xcd, 0x44, 0x66, 0x34, 0x14, 0x1c, 0x2b, 0x5c, 0x82, 0xb7, 0x88,
+	0x0a, 0x45, 0x8f, 0xf2, 0xe4, 0x87, 0x9f, 0xf0, 0xa8, 0x39, 0x66, 0x6f, 0xf2, 0xe3, 0xf6, 0xe6,
+	0x16, 0x54, 0x98, 0x98, 0x7f, 0x6c, 0xba, 0x2e, 0xed, 0x88, 0x3d, 0x2e, 0x23, 0xad, 0xc1, 0x49,
+	0x68, 0x04, 0x4c, 0x24, 0x70, 0x02, 0xc3, 0x12, 0x3b, 0x5c, 0x42, 0xca, 0x6b, 0x24, 0x60, 0x5a,
+	0xc9, 0xd8, 0x5d, 0xc3, 0xb4, 0xe2, 0xad, 0x65, 0x3d, 0x9e, 0x33, 0xca, 0x98, 0xed, 0x9f, 0x1f,
+	0xb3, 0xfd, 0x03, 0xa3, 0x2c, 0x4d, 0x31, 0xca, 0x0d, 0xa8, 0xb0, 0x87, 0x48, 0x49, 0x30, 0xaa,
+	0xa4, 0x32, 0x13, 0xe0, 0x0d, 0x72, 0x3b, 0x8a, 0x92, 0x65, 0x16, 0x25, 0xe7, 0xa2, 0xed, 0x49,
+	0xc5, 0xc8, 0x15, 0x28, 0x78, 0xd4, 0xf0, 0x1d, 0x5b, 0x14, 0xc5, 0xa2, 0x95, 0x3c, 0x60, 0x73,
+	0xb3, 0x1f, 0xb0, 0x2f, 0x40, 0xee, 0x9a, 0xb6, 0xe9, 0x1f, 0xd1, 0x8e, 0x5a, 0x9d, 0xda, 0x2d,
+	0x96, 0xd5, 0x7e, 0x37, 0x07, 0xc5, 0x59, 0x6c, 0xea, 0x11, 0x94, 0x82, 0x08, 0xe7, 0x48, 0xf9,
+	0xd0, 0x18, 0xfd, 0xd0, 0x07, 0x02, 0x29, 0x0b, 0xcc, 0x4d, 0xb6, 0xc0, 0x07, 0xa0, 0x44, 0xcf,
+	0xcd, 0x13, 0xea, 0xf9, 0x98, 0x29, 0xce, 0x31, 0xc3, 0x9a, 0x8f, 0xe8, 0xdf, 0x73, 0x32, 0x79,
+	0x04, 0x65, 0xcc, 0xcd, 0xa3, 0x5d, 0x78, 0x3c, 0xba, 0x0b, 0x80, 0x7c, 0xfe, 0x4c, 0xbe, 0x01,
+	0xc5, 0x1d, 0xe4, 0x68, 0x4d, 0xe4, 0x30, 0x4d, 0x97, 0x37, 0x97, 0xf8, 0x5c, 0xd2, 0x09, 0x9c,
+	0x3e, 0xef, 0xa6, 0x09, 0x98, 0x31, 0x52, 0x56, 0xbd, 0x0b, 0x68, 0xa2, 0xcc, 0xba, 0xf1, 0x82,
+	0x5e, 0x17, 0x2c, 0xf2, 0x11, 0x80, 0x6b, 0x78, 0xd4, 0x0e, 0x18, 0x10, 0x50, 0x18, 0x52, 0x5d,
+	0x89, 0xf3, 0xb0, 0xd0, 0x4f, 0x6c, 0x6b, 0xf1, 0x62, 0xdb, 0x2a, 0xcf, 0xbe, 0xad, 0xa3, 0xe7,
+	0xba, 0x34, 0xed, 0x5c, 0xc7, 0x36, 0x0b, 0x33, 0xd9, 0xec, 0xed, 0x94, 0xcd, 0x26, 0x0a, 0xe1,
+	0xea, 0xa4, 0x42, 0x78, 0x1d, 0xf2, 0x3e, 0xd6, 0xd5, 0xea, 0x27, 0x89, 0x04, 0x93, 0x55, 0xda,
+	0x3a, 0x67, 0x90, 0x87, 0x50, 0x16, 0x13, 0x67, 0xe5, 0x1b, 0x49, 0xa4, 0x84, 0x3a, 0x75, 0x1d,
+	0x1d, 0x38, 0x17, 0x9f, 0xb1, 0xec, 0x17, 0xb2, 0xa2, 0x3e, 0x5a, 0x60, 0x93, 0x12, 0xeb, 0xda,
+	0x66, 0xb4, 0xa4, 0xbf, 0x5a, 0x9a, 0xe6, 0xaf, 0x56, 0x66, 0xf1, 0x57, 0xab, 0xa3, 0xfe, 0x6a,
+	0xc8, 0x21, 0xdd, 0x9f, 0xc1, 0x21, 0x6d, 0x8c, 0x73, 0x48, 0x69, 0xbf, 0x77, 0x75, 0xd8, 0xef,
+	0xc5, 0xfe, 0x6a, 0x6d, 0x8a, 0xbf, 0xfa, 0x02, 0xe6, 0x44, 0x52, 0xe0, 0xb3, 0x2c, 0x41, 0x55,
+	0xd7, 0x73, 0x71, 0x87, 0x64, 0xfa, 0xa0, 0x57, 0xde, 0x25, 0x5a, 0xe4, 0x6b, 0x58, 0xf0, 0x44,
+	0x3c, 0x6c, 0x7a, 0xf4, 0x87, 0x90, 0xfa, 0x81, 0xaf, 0x5e, 0x4b, 0xbc, 0x2c, 0x19, 0x2d, 0x75,
+	0x25, 0x92, 0xd5, 0x85, 0x28, 0x79, 0x06, 0xf3, 0x71, 0x7f, 0xcb, 0xec, 0x9b, 0x81, 0xaf, 0xde,
+	0x39, 0xab, 0x77, 0x35, 0x92, 0x3c, 0x60, 0x82, 0x64, 0x1f, 0xae, 0xfa, 0x66, 0x87, 0xb6, 0x0d,
+	0xaf, 0x39, 0x3c, 0xc6, 0x93, 0xb3, 0xc6, 0x58, 0x16, 0x3d, 0xf4, 0xf4, 0x50, 0xeb, 0x90, 0x37,
+	0x31, 0x6b, 0x51, 0x6b, 0x09, 0x2b, 0x13, 0x15, 0x27, 0x63, 0x90, 0x0d, 0x00, 0x9b, 0xbe, 0x8b,
+	0xcc, 0xe6, 0x3a, 0x13, 0x9b, 0x67, 0x46, 0xc6, 0xad, 0x86, 0x95, 0x15, 0x25, 0x9b, 0xbe, 0xe3,
+	0xcd, 0x91, 0x00, 0x70, 0x73, 0x4a, 0x00, 0xb8, 0x05, 0x15, 0x6a, 0x1b, 0x2d, 0x8b, 0x36, 0xf9,
+	0x86, 0xad, 0xb3, 0xda, 0xb1, 0xcc, 0x69, 0x3c, 0x99, 0x45, 0xd0, 0xc1, 0xb0, 0x02, 0xf5, 0x96,
+	0x00, 0x1d, 0x0c, 0x2b, 0x20, 0x9f, 0x00, 0xb4, 0x8f, 0x42, 0xfb, 0x98, 0x3b, 0xab, 0xbb, 0xc9,
+	0x72, 0x18, 0xc9, 0x6c, 0xcd, 0xa5, 0x76, 0xf4, 0xc8, 0xaa, 0x05, 0x2c, 0xbd, 0x58, 0x9a, 0x8a,
+	0xa7, 0xea, 0xde, 0xf4, 0x6a, 0x01, 0xe5, 0x5f, 0x73, 0x71, 0xcc, 0xf7, 0x31, 0x21, 0x8c, 0x7a,
+	0x7f, 0x34, 0xad, 0x37, 0xbc, 0x75, 0x5a, 0x51, 0x5f, 0x6e, 0xf2, 0xf8, 0x6e, 0xcf, 0xa4, 0xbe,
+	0xfa, 0x20, 0x36, 0xf9, 0xb0, 0xff, 0x1a, 0x29, 0xe4, 0x2b, 0x98, 0xf7, 0xdb, 0x47, 0xb4, 0x13,
+	0x5a, 0x88, 0x0d, 0xb3, 0x05, 0x3d, 0x64, 0x2f, 0x58, 0xe4, 0x87, 0x3e, 0xe6, 0x71, 0x6b, 0xf0,
+	0x53, 0x6d, 0x04, 0x9a, 0x5c, 0xa7, 0xc3, 0xbb, 0x7d, 0xcc, 0x81, 0x26, 0xd7, 0xe1, 0x28, 0xee,
+	0x75, 0x28, 0x21, 0xcb, 0x35, 0x82, 0xf6, 0x91, 0xfa, 0x88, 0xf1, 0x50, 0xf6, 0x10, 0xdb, 0x75,
+	0x49, 0x96, 0x94, 0x7c, 0x5d, 0x92, 0xf3, 0x4a, 0xa1, 0x2e, 0xc9, 0x37, 0x94, 0x9b, 0x75, 0x49,
+	0xd6, 0x94, 0xdb, 0xda, 0x2e, 0x14, 0xb8, 0xdd, 0x8f, 0x05, 0x5f, 0xee, 0xa5, 0xab, 0x5a, 0x65,
+	0xe8, 0x9c, 0x44, 0xee, 0x4f, 0x5b, 0x05, 0x39, 0x8a, 0x60, 0xe3, 0xc6, 0xd1, 0x7e, 0x9f, 0x05,
+	0x05, 0x93, 0xb4, 0x48, 0x88, 0x45, 0xd5, 0xfb, 0xd1, 0xe0, 0x19, 0x36, 0x38, 0x49, 0x05, 0xc2,
+	0x33, 0xbc, 0xab, 0x94, 0xf2, 0xae, 0x43, 0x71, 0x2f, 0x3b, 0x39, 0xee, 0xed, 0x00, 0xee, 0x53,
+	0x93, 0x15, 0xbc, 0xbe, 0x48, 0xe5, 0xef, 0xf0, 0xd0, 0x35, 0x34, 0x35, 0x74, 0xef, 0x3b, 0x4c,
+	0x8c, 0x23, 0xbf, 0xa5, 0xb7, 0x51, 0x1b, 0x3d, 0x91, 0x11, 0x06, 0x47, 0xcd, 0xc0, 0x39, 0xa6,
+	0xb6, 0x80, 0x0e, 0x4b, 0x48, 0x79, 0x8d, 0x04, 0xf2, 0x14, 0xaa, 0x96, 0xe1, 0xb3, 0x98, 0x27,
+	0x6a, 0xf7, 0xc2, 0xb8, 0xa8, 0x51, 0x41, 0xa1, 0xa8, 0x85, 0x28, 0x48, 0x22, 0xc4, 0xb2, 0x28,
+	0x28, 0xe9, 0x49, 0x52, 0xed, 0x2b, 0xa8, 0xa6, 0xa7, 0x94, 0x44, 0x8d, 0xf3, 0x63, 0x50, 0xe3,
+	0x7c, 0x12, 0x35, 0xfe, 0x8f, 0x2a, 0x54, 0x52, 0x9a, 0xe7, 0x80, 0xc8, 0xc2, 0x08, 0x20, 0x92,
+	0xcc, 0x4e, 0x32, 0x93, 0xb3, 0x13, 0x15, 0x8a, 0x51, 0x52, 0x52, 0xe6, 0xd1, 0xe3, 0x24, 0x4e,
+	0x46, 0xce, 0x93, 0x10, 0x3d, 0x8a, 0xef, 0x0a, 0x36, 0x12, 0x3e, 0x89, 0x5d, 0x16, 0x8c, 0xde,
+	0x1b, 0x8c, 0x4d, 0x5d, 0xe0, 0x67, 0x4f, 0x5d, 0x7e, 0x09, 0xd0, 0xf6, 0xa8, 0x11, 0xd0, 0x4e,
+	0xd3, 0x08, 0xd4, 0xc2, 0xd4, 0xec, 0xa2, 0x24, 0xa4, 0xb7, 0x82, 0x81, 0x4d, 0x17, 0xa7, 0xd9,
+	0xb4, 0x8a, 0x69, 0x8f, 0xc3, 0x02, 0xe7, 0x3d, 0xe6, 0x04, 0xa3, 0x26, 0xfa, 0x48, 0x8f, 0x22,
+	0x12, 0xd2, 0xa4, 0x9e, 0xe7, 0x78, 0x02, 0x88, 0x2e, 0x73, 0xda, 0x1e, 0x92, 0xc8, 0xc7, 0xb0,
+	0xc0, 0xe3, 0x93, 0x1f, 0x85, 0x23, 0xda, 0x51, 0x3f, 0x65, 0xae, 0x46, 0x11, 0x0c, 0x3d, 0xa2,
+	0x27, 0x85, 0x8d, 0x13, 0xc3, 0xb4, 0xd0, 0xd5, 0xaa, 0x9b, 0x29, 0xe1, 0xad, 0x88, 0x4e, 0xbe,
+	0x49, 0x1d, 0x92, 0x12, 0x3b, 0x24, 0xeb, 0xa9, 0x55, 0x4c, 0x39, 0x20, 0xa3, 0x27, 0xe0, 0xe3,
+	0xe9, 0x27, 0x60, 0x24, 0x61, 0x51, 0xc6, 0x24, 0x2c, 0x63, 0x83, 0xf0, 0xe2, 0xa5, 0x82, 0xf0,
+	0xda, 0xcf, 0x10, 0x84, 0x9f, 0x5e, 0x34, 0x08, 0x2f, 0x9d, 0x15, 0x84, 0xd7, 0xa1, 0xdc, 0xa1,
+	0x7e, 0xdb, 0x33, 0x5d, 0x8c, 0x2e, 0xea, 0x32, 0xdf, 0xff, 0x04, 0x09, 0xbd, 0x50, 0xdb, 0x68,
+	0x1f, 0x09, 0x30, 0xe0, 0x2a, 0xf7, 0x42, 0x8c, 0xc2, 0xc0, 0x80, 0xe1, 0x28, 0xab, 0x9e, 0x1d,
+	0x65, 0xaf, 0x25, 0xa2, 0xec, 0xc0, 0xcd, 0xde, 0x48, 0xb9, 0xd9, 0x3b, 0x50, 0xed, 0x1b, 0x3f,
+	0x36, 0x13, 0xf0, 0xc3, 0x4d, 0x66, 0x3d, 0x95, 0xbe, 0xf1, 0xe3, 0xaf, 0x63, 0x04, 0x22, 0x91,
+	0xea, 0xae, 0x5e, 0x2e, 0xd5, 0x4d, 0x47, 0xfb, 0xf5, 0x73, 0x47, 0xfb, 0x5b, 0x97, 0x8a, 0xf6,
+	0xda, 0x79, 0xa2, 0xfd, 0x63, 0x28, 0xf7, 0xcc, 0xe0, 0xc8, 0x71, 0x8e, 0x9b, 0x78, 0x4b, 0xc1,
+	0x92, 0xff, 0xed, 0xea, 0x87, 0xf7, 0x6b, 0xf0, 0x82, 0x93, 0xf1, 0xb2, 0x02, 0x84, 0xc8, 0x1b,
+	0xcf, 0x1a, 0x0e, 0x59, 0x77, 0x26, 0x87, 0x2c, 0xe6, 0x24, 0x0c, 0xbb, 0xd3, 0x3a, 0x55, 0xef,
+	0x46, 0x4e, 0x82, 0x35, 0x87, 0xd3, 0x8c, 0x8f, 0x66, 0x49, 0x33, 0xee, 0x5f, 0x2c, 0xcd, 0x78,
+	0x30, 0x7b, 0x9a, 0x41, 0x96, 0xa1, 0xe0, 0x3f, 0x6d, 0x3a, 0x21, 0x2f, 0x42, 0x65, 0x3d, 0xef,
+	0x3f, 0x7d, 0x15, 0x06, 0x18, 0x58, 0xfa, 0xe2, 0x4a, 0x55, 0x24, 0xad, 0x73, 0xa9, 0x7b, 0x56,
+	0x3d, 0x66, 0xa3, 0xf1, 0xe3, 0x5b, 0x43, 0xcb, 0x08, 0xcc, 0x13, 0xaa, 0x7e, 0xc6, 0x4d, 0x37,
+	0x41, 0xc2, 0x24, 0xde, 0xf5, 0x4c, 0xc7, 0x33, 0x83, 0x53, 0x3e, 0xc1, 0xcf, 0x53, 0x59, 0x3f,
+	0xe7, 0xb0, 0x55, 0x55, 0xdc, 0x44, 0xeb, 0x72, 0x41, 0x94, 0x83, 0x54, 0x71, 0x1a, 0xb5, 0xa2,
+	0x5c, 0xad, 0x4b, 0x72, 0x4d, 0xb9, 0x5e, 0x97, 0xe4, 0xeb, 0xca, 0x8d, 0xba, 0x24, 0x13, 0x65,
+	0x51, 0x7b, 0x01, 0x73, 0x49, 0x2f, 0xc9, 0xea, 0x8d, 0xb8, 0x86, 0x37, 0xed, 0xae, 0x23, 0x6e,
+	0xa8, 0x17, 0x46, 0x1c, 0xaa, 0x5e, 0x71, 0x13, 0x2d, 0xed, 0x37, 0x79, 0x50, 0x76, 0x58, 0x50,
+	0xc1, 0xe0, 0xc7, 0x1d, 0xd8, 0xa5, 0xd0, 0xab, 0x6b, 0xe7, 0x40, 0xaf, 0x6a, 0xd3, 0xaa, 0xc1,
+	0xeb, 0xb3, 0x54, 0x83, 0x37, 0xa6, 0xa1, 0x57, 0x37, 0xa7, 0xa0, 0x57, 0xab, 0x33, 0x14, 0x8b,
+	0x6b, 0x13, 0xd1, 0xab, 0xf5, 0x73, 0xa2, 0x57, 0xb7, 0x66, 0x45, 0xaf, 0xb4, 0x0b, 0x20, 0x01,
+	0x09, 0x98, 0xe3, 0xce, 0xc5, 0x60, 0x8e, 0xbb, 0xb3, 0xc3, 0x1c, 0x43, 0xd6, 0x9a, 0x51, 0xb2,
+	0x75, 0x49, 0x06, 0xa5, 0x5c, 0x97, 0xe4, 0xa2, 0x22, 0xd7, 0x25, 0xb9, 0xa4, 0x40, 0x5d, 0x92,
+	0x65, 0xa5, 0x54, 0x97, 0xe4, 0x8a, 0x32, 0x57, 0x97, 0xe4, 0xb2, 0x52, 0xa9, 0x4b, 0xf2, 0x9c,
+	0x52, 0xad, 0x4b, 0x72, 0x55, 0x99, 0xaf, 0x4b, 0xf2, 0xb2, 0xb2, 0x52, 0x97, 0xe4, 0x79, 0x45,
+	0xa9, 0x4b, 0xb2, 0xa2, 0x2c, 0xd4, 0x25, 0x79, 0x41, 0x21, 0xdc, 0xd2, 0xeb, 0x92, 0xbc, 0xa8,
+	0x2c, 0xd5, 0x25, 0x79, 0x49, 0x59, 0x8e, 0x4f, 0xc3, 0x55, 0x45, 0xad, 0x4b, 0xb2, 0xaa, 0x5c,
+	0xd3, 0xfe, 0x32, 0x03, 0x0b, 0xfb, 0x36, 0x9e, 0xcd, 0x20, 0x61, 0xbf, 0x93, 0x50, 0xb4, 0xf3,
+	0xc3, 0xad, 0x6b, 0x50, 0x6e, 0x59, 0x4e, 0xfb, 0xb8, 0x39, 0x28, 0x50, 0x64, 0x1d, 0x18, 0x89,
+	0xe7, 0x14, 0x04, 0xa4, 0x6e, 0x68, 0x59, 0xac, 0x64, 0x90, 0x75, 0xf6, 0xac, 0xfd, 0x67, 0x06,
+	0xaa, 0x07, 0xa6, 0x1f, 0x9c, 0x71, 0xaa, 0xa6, 0xe4, 0xbc, 0x1b, 0x50, 0x31, 0xed, 0xc4, 0x1c,
+	0xf9, 0xcd, 0x6e, 0xda, 0x5e, 0x98, 0x80, 0x98, 0xe2, 0x85, 0x30, 0xe4, 0x23, 0xd3, 0x0f, 0x10,
+	0x56, 0x97, 0x98, 0x69, 0x47, 0xcd, 0x78, 0x35, 0xf9, 0xc1, 0x6a, 0xf0, 0x66, 0xf5, 0xed, 0x0f,
+	0xcf, 0x4d, 0x2b, 0xa0, 0x1e, 0xcb, 0x52, 0x4b, 0x7a, 0xdc, 0xd6, 0xde, 0xc2, 0xfc, 0x73, 0x2b,
+	0xf4, 0x8f, 0x12, 0x2b, 0xbd, 0x0b, 0x45, 0x3e, 0x8f, 0xe8, 0x43, 0x99, 0xd4, 0x44, 0x22, 0x1e,
+	0x79, 0x02, 0x95, 0xc0, 0x69, 0x46, 0x8b, 0x8e, 0xee, 0xaf, 0x87, 0x94, 0x52, 0x0e, 0x9c, 0xe8,
+	0xd9, 0xd7, 0x36, 0x40, 0xd9, 0xa5, 0x16, 0x0d, 0xe8, 0x6c, 0x9b, 0xad, 0x3d, 0x82, 0x6a, 0x23,
+	0x70, 0xdc, 0x19, 0xa5, 0x7f, 0x97, 0x85, 0xe5, 0x37, 0x6e, 0x87, 0xfb, 0x42, 0x7e, 0xd4, 0xa6,
+	0xf7, 0x1a, 0x9c, 0xd5, 0xec, 0x4c, 0x67, 0x35, 0x97, 0x3a, 0xab, 0xff, 0x17, 0x50, 0xfe, 0x90,
+	0xb7, 0x2b, 0xce, 0xe0, 0xed, 0xe4, 0xe9, 0xd0, 0x58, 0xe9, 0x4c, 0x68, 0x0c, 0x26, 0x3b, 0x43,
+	0xed, 0x9f, 0xb2, 0x50, 0x7d, 0x41, 0x83, 0x03, 0xa7, 0xe7, 0x5f, 0x20, 0xe0, 0x4c, 0xda, 0x8a,
+	0x48, 0x19, 0x5d, 0x66, 0x99, 0xbc, 0xf2, 0x2e, 0x71, 0x65, 0x70, 0x63, 0xf5, 0x07, 0xf7, 0xeb,
+	0x85, 0xb3, 0xee, 0xd7, 0xd9, 0x97, 0x42, 0x3e, 0x5a, 0x3a, 0x3f, 0x01, 0xa2, 0x85, 0xf4, 0xae,
+	0x63, 0x59, 0xce, 0x3b, 0xf1, 0x11, 0x8d, 0x68, 0xb1, 0x2b, 0x24, 0xc3, 0xb4, 0x84, 0xce, 0xd8,
+	0x33, 0xb9, 0x0f, 0x4a, 0xe8, 0xd3, 0xa6, 0xe5, 0x1c, 0x9b, 0xcd, 0x96, 0xd1, 0x3e, 0xa6, 0x76,
+	0x47, 0x7c, 0x62, 0x53, 0x0d, 0x7d, 0x7a, 0xe0, 0x1c, 0x9b, 0xdb, 0x9c, 0x4a, 0x1e, 0x43, 0xde,
+	0x37, 0xed, 0x36, 0x55, 0x61, 0x5a, 0x32, 0xc8, 0xe5, 0xb8, 0xa7, 0xd5, 0x7e, 0x93, 0x05, 0x38,
+	0x70, 0x7a, 0xdf, 0x51, 0xdf, 0xc7, 0xcf, 0xe4, 0x6e, 0x27, 0xa2, 0x7f, 0x02, 0x12, 0x89, 0x43,
+	0xfd, 0x4b, 0x84, 0x58, 0x06, 0x97, 0x8f, 0xb9, 0x33, 0x2e, 0x1f, 0x53, 0x37, 0x99, 0xc5, 0x89,
+	0x37, 0x99, 0xf7, 0x40, 0xe6, 0x59, 0xa1, 0xc9, 0x57, 0x56, 0xda, 0x2e, 0x7f, 0x78, 0xbf, 0x56,
+	0xe4, 0x1f, 0x32, 0xec, 0xea, 0x45, 0xc6, 0xdc, 0xef, 0x24, 0xb4, 0x09, 0x29, 0x6d, 0x46, 0xf7,
+	0x9c, 0xd2, 0x84, 0x7b, 0xce, 0xe8, 0x63, 0x47, 0x99, 0x7b, 0x22, 0x7c, 0x26, 0x0f, 0x21, 0x1b,
+	0x5f, 0x61, 0x4e, 0x0a, 0x50, 0xd9, 0xc0, 0xc7, 0xc3, 0xd5, 0xe7, 0x0a, 0x12, 0x4e, 0x2b, 0x6a,
+	0x6a, 0xaf, 0x61, 0x51, 0xe7, 0xe7, 0x8c, 0x6f, 0xfd, 0x0c, 0xc7, 0x7c, 0xd8, 0xb6, 0xb2, 0x23,
+	0xb6, 0xa5, 0xfd, 0x3f, 0x58, 0x14, 0xb1, 0x28, 0x35, 0xea, 0xd4, 0x4f, 0x3a, 0xb4, 0x3f, 0xcd,
+	0x80, 0x82, 0xc1, 0x62, 0xe6, 0xc9, 0xc4, 0x95, 0x9d, 0x74, 0x56, 0x65, 0x87, 0xb9, 0xb3, 0xd1,
+	0x13, 0x45, 0x14, 0xbf, 0xc7, 0x94, 0x91, 0xc0, 0x0a, 0x28, 0xf6, 0x5d, 0x8b, 0xf8, 0xa8, 0x32,
+	0xa7, 0xb3, 0x67, 0x6d, 0x1b, 0x4a, 0x71, 0xcd, 0x93, 0xb8, 0x02, 0xcd, 0x24, 0xaf, 0x40, 0xd1,
+	0x05, 0xe0, 0x80, 0xe2, 0xb2, 0x9c, 0x0f, 0x5b, 0x42, 0x0a, 0xbf, 0x1a, 0xff, 0x97, 0x0c, 0x54,
+	0xd3, 0xe9, 0x3e, 0xa9, 0xc3, 0x9c, 0xed, 0x74, 0x68, 0xd3, 0xa7, 0x16, 0x6d, 0x07, 0x8e, 0x27,
+	0x02, 0xc2, 0xdd, 0x31, 0xa5, 0xc1, 0xc6, 0x4b, 0xa7, 0x43, 0x1b, 0x42, 0x8e, 0x57, 0xfb, 0x15,
+	0x3b, 0x41, 0x22, 0x1b, 0xb0, 0x18, 0xa7, 0xe3, 0x6d, 0xcb, 0xf0, 0x7d, 0x6e, 0xeb, 0xfc, 0x5a,
+	0x78, 0x21, 0x62, 0xed, 0x20, 0x07, 0x0d, 0xbe, 0xf6, 0x0d, 0x2c, 0x8c, 0x0c, 0x79, 0xae, 0x8f,
+	0x20, 0xbf, 0xc2, 0x6f, 0x43, 0x06, 0x79, 0x3d, 0x4a, 0x5a, 0xf4, 0x84, 0x5a, 0xd1, 0x17, 0x2d,
+	0xac, 0x81, 0xca, 0x7a, 0x47, 0xcd, 0xde, 0x51, 0x10, 0xdd, 0x17, 0xf3, 0x96, 0xf6, 0xdf, 0x00,
+	0xcb, 0x3c, 0xb5, 0x8e, 0xdd, 0xd8, 0xf9, 0x33, 0x81, 0x01, 0x6a, 0x75, 0x7b, 0x06, 0xd4, 0xea,
+	0x7c, 0x88, 0xd8, 0x38, 0x8c, 0xab, 0x78, 0x31, 0x8c, 0xab, 0x74, 0x36, 0xc6, 0xb5, 0x02, 0x85,
+	0x90, 0x05, 0xd5, 0xc8, 0x9f, 0xf2, 0xd6, 0x28, 0x12, 0x03, 0x63, 0x90, 0x98, 0x41, 0x95, 0x77,
+	0x27, 0x59, 0xe5, 0x8d, 0x05, 0x68, 0x2a, 0x97, 0x02, 0x68, 0x56, 0x7e, 0x06, 0x80, 0xe6, 0xf1,
+	0x45, 0x01, 0x9a, 0xb9, 0x19, 0x01, 0x9a, 0xea, 0x34, 0x80, 0x46, 0x99, 0x06, 0xd0, 0x2c, 0x8c,
+	0x02, 0x34, 0x37, 0xa0, 0xe4, 0x51, 0x91, 0x66, 0xb0, 0xdb, 0x3e, 0x59, 0x1f, 0x10, 0xc6, 0x40,
+	0x32, 0x4b, 0x93, 0x21, 0x99, 0xe5, 0x99, 0x20, 0x99, 0x5b, 0xb3, 0x41, 0x32, 0x57, 0xcf, 0x0d,
+	0xc9, 0xa8, 0x97, 0x82, 0x64, 0xae, 0x9d, 0x07, 0x92, 0x89, 0x90, 0xad, 0x5a, 0x02, 0xd9, 0x4a,
+	0xe0, 0x28, 0xd7, 0x27, 0xe2, 0x28, 0x37, 0x66, 0xc1, 0x51, 0x6e, 0x5e, 0x0c, 0x47, 0x59, 0x9d,
+	0x80, 0xa3, 0xac, 0x0f, 0xe1, 0x28, 0x43, 0x30, 0x91, 0x36, 0x19, 0x26, 0x4a, 0xc2, 0x2b, 0x1b,
+	0xe7, 0x82, 0x57, 0x9e, 0xcc, 0x00, 0xaf, 0x7c, 0x3a, 0x13, 0xbc, 0x32, 0x54, 0x72, 0xf2, 0x72,
+	0x92, 0x17, 0x8f, 0x8b, 0xca, 0x92, 0xb6, 0x03, 0x2b, 0x22, 0x0a, 0x5f, 0xdc, 0xed, 0x6a, 0x7f,
+	0x9b, 0x81, 0x45, 0x8c, 0xc8, 0x97, 0xf0, 0xdc, 0x89, 0x0a, 0x2b, 0x9b, 0xae, 0xb0, 0x1e, 0x80,
+	0x62, 0x60, 0xea, 0xd8, 0x34, 0xed, 0xb6, 0xd3, 0x77, 0xb1, 0x9e, 0x11, 0x9f, 0xb6, 0xce, 0x33,
+	0xfa, 0x7e, 0x4c, 0x4e, 0x15, 0x5e, 0xd2, 0x50, 0xe1, 0xf5, 0x17, 0x19, 0x58, 0xe6, 0xd5, 0xd0,
+	0x25, 0x66, 0xa9, 0x40, 0xce, 0x88, 0x4b, 0x57, 0x7c, 0xc4, 0x20, 0xd7, 0x75, 0xbc, 0x76, 0xe4,
+	0xae, 0x79, 0x03, 0x6d, 0xe8, 0x98, 0x52, 0x97, 0x7f, 0x12, 0xc0, 0x3f, 0xc6, 0x96, 0x91, 0xa0,
+	0x53, 0xd7, 0xa9, 0x4b, 0x72, 0x56, 0xc9, 0x89, 0x8f, 0xab, 0xb6, 0x60, 0xa9, 0x81, 0x89, 0xd5,
+	0x25, 0x94, 0xff, 0x2d, 0x2c, 0x62, 0xd5, 0x76, 0x89, 0x11, 0xfe, 0x3a, 0x03, 0x44, 0x0f, 0xed,
+	0x4b, 0xe8, 0xe5, 0x73, 0x00, 0xd7, 0x73, 0x4e, 0xa8, 0x6d, 0xd8, 0xec, 0xa7, 0x05, 0x98, 0xb4,
+	0x2c, 0x27, 0x4e, 0xc5, 0x61, 0xcc, 0xd4, 0x13, 0x82, 0x89, 0x1c, 0x5b, 0x1a, 0x9f, 0x63, 0x0b,
+	0x2d, 0x7d, 0x09, 0x55, 0x3d, 0xb4, 0xf1, 0x0b, 0xeb, 0x0b, 0xac, 0xee, 0x01, 0x2c, 0xf2, 0xbc,
+	0x82, 0xff, 0x18, 0x29, 0x1a, 0x01, 0x0b, 0x77, 0xd3, 0xe2, 0xbd, 0x2b, 0x3a, 0x7b, 0xd6, 0x9e,
+	0xc1, 0x22, 0x37, 0x91, 0xb4, 0xe8, 0x6d, 0x28, 0xf0, 0x1f, 0x38, 0x0d, 0xbe, 0xc4, 0x8e, 0x7f,
+	0x16, 0xa5, 0x0b, 0x96, 0xf6, 0x25, 0x2c, 0x89, 0x83, 0x74, 0x81, 0xce, 0x37, 0xa0, 0xc0, 0x29,
+	0x63, 0x6f, 0x69, 0xff, 0x2c, 0x03, 0xc0, 0xd9, 0xec, 0x96, 0x70, 0x96, 0x11, 0xe3, 0x4f, 0xf5,
+	0xb2, 0x89, 0x4f, 0xf5, 0xf6, 0x81, 0xb0, 0x1b, 0x31, 0xd3, 0xb1, 0x9b, 0xf1, 0xcf, 0xe5, 0xd4,
+	0xdc, 0xd4, 0xea, 0x60, 0x21, 0xea, 0x15, 0x93, 0xb4, 0x6f, 0xa0, 0x3c, 0x98, 0x11, 0x62, 0x13,
+	0x65, 0xfe, 0xde, 0x24, 0x9a, 0x3a, 0x9f, 0x98, 0x17, 0x8a, 0xe9, 0xe0, 0xc7, 0xcf, 0xda, 0x33,
+	0x58, 0x7e, 0x61, 0x78, 0x2d, 0xa3, 0x47, 0x77, 0x1c, 0x0b, 0x33, 0xce, 0x48, 0x5f, 0xb7, 0xa0,
+	0xc2, 0x3f, 0x59, 0x14, 0x69, 0x33, 0x4f, 0x1e, 0xcb, 0x9c, 0xc6, 0x13, 0x67, 0x15, 0x56, 0x86,
+	0xfb, 0xfa, 0xae, 0x63, 0xfb, 0x54, 0x5b, 0x86, 0xc5, 0xad, 0x76, 0x60, 0x9e, 0x18, 0x01, 0xdd,
+	0x0a, 0x83, 0x23, 0x31, 0xa6, 0xb6, 0x02, 0x4b, 0x69, 0x32, 0x17, 0x7f, 0xe8, 0xb1, 0x4f, 0xf0,
+	0x39, 0x2c, 0xa5, 0x40, 0xa5, 0xfe, 0x6a, 0xbb, 0xd9, 0x78, 0xbd, 0xa5, 0xbf, 0xde, 0x7f, 0xf9,
+	0x42, 0xb9, 0x42, 0xe6, 0xa1, 0x8c, 0x14, 0xfd, 0xcd, 0xcb, 0x97, 0x48, 0xc8, 0x44, 0x84, 0xe7,
+	0x5b, 0xfb, 0x07, 0x6f, 0xf4, 0x3d, 0x25, 0x1b, 0x11, 0x1a, 0x6f, 0x76, 0x76, 0xf6, 0x1a, 0x0d,
+	0x25, 0x47, 0xaa, 0x00, 0x48, 0xf8, 0xd5, 0xfe, 0xc1, 0xc1, 0xde, 0xae, 0x22, 0x91, 0x05, 0x98,
+	0xc3, 0xf6, 0xde, 0x0b, 0x7d, 0xaf, 0xd1, 0xc0, 0x41, 0x0a, 0x0f, 0x5f, 0x01, 0x0c, 0x3e, 0x3f,
+	0x27, 0x00, 0x05, 0x1c, 0x6e, 0x6f, 0x57, 0xb9, 0x42, 0xca, 0x50, 0x8c, 0x46, 0xca, 0xb0, 0xc6,
+	0xaf, 0xf6, 0x0f, 0x0f, 0xf7, 0x76, 0x95, 0x2c, 0xa9, 0x80, 0x1c, 0xcf, 0x2b, 0x47, 0xe6, 0xa0,
+	0xa4, 0xef, 0xed, 0xbc, 0xfa, 0x7e, 0x4f, 0xc7, 0x77, 0x3c, 0xfc, 0x06, 0xca, 0x89, 0x9b, 0x7f,
+	0x9c, 0xd3, 0xe1, 0xab, 0xdd, 0x78, 0xd6, 0x57, 0x22, 0xc2, 0x60, 0xe8, 0x2a, 0x00, 0x12, 0xc4,
+	0x7b, 0xb3, 0x0f, 0xff, 0x2e, 0x33, 0x80, 0xc7, 0xf9, 0x18, 0xcb, 0xb0, 0x70, 0xb8, 0x7f, 0xb8,
+	0x77, 0xb0, 0xff, 0x72, 0x2f, 0xa9, 0x90, 0x25, 0x50, 0x62, 0xf2, 0x40, 0x2b, 0x57, 0x61, 0x71,
+	0x40, 0xdd, 0x8b, 0xc5, 0xb3, 0x29, 0xf1, 0x48, 0x67, 0x39, 0xb2, 0x08, 0xf3, 0x31, 0xf5, 0x70,
+	0xeb, 0x4d, 0x83, 0xe9, 0x29, 0x29, 0xda, 0x78, 0xbd, 0xf5, 0x72, 0x77, 0xfb, 0x8f, 0x94, 0x7c,
+	0x6a, 0x1a, 0x3b, 0xfa, 0x56, 0xe3, 0x0f, 0x98, 0x06, 0x37, 0xff, 0xa6, 0x02, 0xb9, 0xad, 0xc3,
+	0x7d, 0xb2, 0x01, 0x25, 0x7e, 0xb0, 0x31, 0x97, 0x5f, 0x16, 0x3f, 0xc2, 0x48, 0x63, 0xf3, 0xb5,
+	0xb8, 0x12, 0xd4, 0xae, 0x90, 0xcf, 0x00, 0x06, 0xe0, 0x27, 0x59, 0x11, 0xe9, 0xe3, 0x10, 0x1a,
+	0x5a, 0xab, 0x44, 0x3d, 0x98, 0x99, 0x5e, 0x21, 0x4f, 0xa0, 0x28, 0x90, 0x49, 0xc2, 0x33, 0x8b,
+	0x34, 0x4e, 0x39, 0x2c, 0xff, 0x24, 0x43, 0x36, 0x41, 0x8e, 0x20, 0x3e, 0xc2, 0x4b, 0x83, 0x21,
+	0xc4, 0x6f, 0x4c, 0x9f, 0xaf, 0xa0, 0x14, 0x43, 0x75, 0x62, 0x2d, 0xc3, 0xd0, 0x5d, 0x6d, 0x65,
+	0xe4, 0x88, 0xee, 0xe1, 0x0f, 0x93, 0xb4, 0x2b, 0xe4, 0x17, 0x50, 0x14, 0xc0, 0x9d, 0x98, 0x63,
+	0x1a, 0xc6, 0x9b, 0xd0, 0xf3, 0x19, 0x54, 0x92, 0x45, 0x38, 0x51, 0x93, 0x5a, 0x49, 0x16, 0xd8,
+	0xb5, 0xea, 0xa0, 0x10, 0x17, 0x9a, 0xf9, 0x02, 0x4a, 0x71, 0x19, 0x2e, 0xe6, 0x3c, 0x5c, 0x96,
+	0x8f, 0xf6, 0x7a, 0x92, 0x21, 0xdb, 0xec, 0x23, 0xe6, 0x18, 0x4e, 0x10, 0xef, 0x1c, 0x83, 0x30,
+	0x4c, 0x98, 0xf7, 0x73, 0xa8, 0xa6, 0x8b, 0x45, 0x52, 0x4b, 0x18, 0xc0, 0x50, 0x24, 0x9b, 0x30,
+	0xce, 0x0e, 0xcc, 0x0f, 0xa5, 0x3f, 0xe4, 0x7a, 0x52, 0x05, 0xc3, 0x23, 0x8d, 0xde, 0x10, 0x69,
+	0x57, 0xc8, 0xd7, 0x50, 0x49, 0x66, 0x3f, 0x62, 0x41, 0x63, 0x12, 0xa2, 0x1a, 0x19, 0xe9, 0xee,
+	0xf3, 0xc5, 0xa4, 0x33, 0x13, 0xb1, 0x98, 0xb1, 0xe9, 0xca, 0x84, 0xc5, 0xec, 0xc2, 0x5c, 0x2a,
+	0x99, 0x20, 0xd7, 0x84, 0x31, 0x8c, 0x26, 0x18, 0x13, 0x46, 0xd9, 0x86, 0x4a, 0x32, 0x9f, 0x10,
+	0xab, 0x19, 0x93, 0x62, 0x4c, 0x18, 0xe3, 0x5b, 0x28, 0x27, 0x12, 0x0a, 0xc2, 0x7f, 0x76, 0x3c,
+	0x9a, 0x62, 0x4c, 0x36, 0x69, 0x11, 0xf2, 0x85, 0x49, 0xa7, 0x13, 0x80, 0xc9, 0xf3, 0x4f, 0xc6,
+	0x7b, 0x31, 0xff, 0x31, 0x29, 0xc0, 0xe4, 0x31, 0x92, 0x89, 0x80, 0x18, 0x63, 0x4c, 0x6e, 0x30,
+	0x71, 0x05, 0x80, 0x26, 0x20, 0x46, 0x38, 0x43, 0xae, 0xa6, 0x0c, 0x05, 0x49, 0xb4, 0x87, 0xff,
+	0x0f, 0x73, 0xa9, 0x54, 0x42, 0xec, 0xe3, 0xb8, 0xf4, 0xa2, 0x36, 0x1c, 0x64, 0x59, 0x77, 0xe1,
+	0x4b, 0xb6, 0x2c, 0xeb, 0xcc, 0xf7, 0x9e, 0x3d, 0xef, 0xa7, 0x50, 0x14, 0x78, 0xb3, 0xd0, 0x7c,
+	0x1a, 0x7d, 0x16, 0x6f, 0x1c, 0xc0, 0xa9, 0xec, 0x4c, 0xef, 0x41, 0x25, 0x19, 0x61, 0x85, 0xc2,
+	0xc6, 0xc4, 0xe2, 0xda, 0xb5, 0x31, 0x1c, 0x11, 0xbd, 0xd9, 0x49, 0x48, 0x5f, 0x29, 0x88, 0x93,
+	0x30, 0xf6, 0x9e, 0xe1, 0xec, 0x35, 0x6c, 0x7f, 0xf9, 0xdb, 0x0f, 0xab, 0x99, 0x7f, 0xfd, 0xb0,
+	0x9a, 0xf9, 0xf7, 0x0f, 0xab, 0x99, 0x3f, 0xfe, 0x04, 0xef, 0xf9, 0xc3, 0xd6, 0x46, 0xdb, 0xe9,
+	0x3f, 0x76, 0x8d, 0xf6, 0xd1, 0x69, 0x87, 0x7a, 0xc9, 0x27, 0xdf, 0x6b, 0x3f, 0x1e, 0xfc, 0x1b,
+	0x82, 0x56, 0x81, 0x0d, 0xf7, 0xf4, 0x7f, 0x07, 0x00, 0x0f, 0x74, 0x75, 0x4d, 0x9b, 0x40, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -9305,6 +9439,32 @@ func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PrioritySpec != nil {
+		{
+			size, err := m.PrioritySpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Speculative {
+		i--
+		if m.Speculative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.SidecarResourceLimits != nil {
 		{
 			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
@@ -10676,6 +10836,28 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Page != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Job != nil {
 		{
 			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
@@ -10781,6 +10963,43 @@ func (m *SchedulingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PrioritySpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrioritySpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrioritySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Weight != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Level != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10805,6 +11024,32 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PrioritySpec != nil {
+		{
+			size, err := m.PrioritySpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Speculative {
+		i--
+		if m.Speculative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.SidecarResourceLimits != nil {
 		{
 			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
@@ -13061,6 +13306,13 @@ func (m *PipelineInfo) Size() (n int) {
 		l = m.SidecarResourceLimits.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.Speculative {
+		n += 3
+	}
+	if m.PrioritySpec != nil {
+		l = m.PrioritySpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13451,6 +13703,16 @@ func (m *ListDatumRequest) Size() (n int) {
 		l = m.Job.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovPps(uint64(m.PageSize))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPps(uint64(m.Page))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13499,6 +13761,24 @@ func (m *SchedulingSpec) Size() (n int) {
 	return n
 }
 
+func (m *PrioritySpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovPps(uint64(m.Level))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovPps(uint64(m.Weight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CreatePipelineRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13618,6 +13898,13 @@ func (m *CreatePipelineRequest) Size() (n int) {
 		l = m.SidecarResourceLimits.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.Speculative {
+		n += 3
+	}
+	if m.PrioritySpec != nil {
+		l = m.PrioritySpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -22185,6 +22472,62 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Speculative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Speculative = bool(v != 0)
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrioritySpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrioritySpec == nil {
+				m.PrioritySpec = &PrioritySpec{}
+			}
+			if err := m.PrioritySpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24594,6 +24937,80 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24915,6 +25332,95 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PrioritySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrioritySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrioritySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25886,6 +26392,62 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Speculative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Speculative = bool(v != 0)
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrioritySpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrioritySpec == nil {
+				m.PrioritySpec = &PrioritySpec{}
+			}
+			if err := m.PrioritySpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -11,6 +11,10 @@ import (
 	"gopkg.in/src-d/go-git.v4"
 )
 
+// ListDatumTotalKey is the key of the grpc trailer that ListDatum sets to the
+// total number of datums, in all pages.
+const ListDatumTotalKey = "pach-list-datum-total"
+
 var (
 	// format strings for state name parsing errors
 	errInvalidJobStateName      string
@@ -362,6 +362,9 @@ func (c *pfsBuilderClient) SubscribeCommit(ctx context.Context, req *pfs.Subscri
 func (c *pfsBuilderClient) ClearCommit(ctx context.Context, req *pfs.ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("ClearCommit")
 }
+func (c *pfsBuilderClient) BuildCommit(ctx context.Context, req *pfs.BuildCommitRequest, opts ...grpc.CallOption) (*pfs.Commit, error) {
+	return nil, unsupportedError("BuildCommit")
+}
 func (c *pfsBuilderClient) InspectBranch(ctx context.Context, req *pfs.InspectBranchRequest, opts ...grpc.CallOption) (*pfs.BranchInfo, error) {
 	return nil, unsupportedError("InspectBranch")
 }
@@ -398,6 +401,18 @@ func (c *pfsBuilderClient) DeleteAll(ctx context.Context, req *types.Empty, opts
 func (c *pfsBuilderClient) Fsck(ctx context.Context, req *pfs.FsckRequest, opts ...grpc.CallOption) (pfs.API_FsckClient, error) {
 	return nil, unsupportedError("Fsck")
 }
+func (c *pfsBuilderClient) GarbageCollect(ctx context.Context, req *pfs.GarbageCollectRequest, opts ...grpc.CallOption) (*pfs.GarbageCollectResponse, error) {
+	return nil, unsupportedError("GarbageCollect")
+}
+func (c *pfsBuilderClient) RewrapDataKeys(ctx context.Context, req *pfs.RewrapDataKeysRequest, opts ...grpc.CallOption) (*pfs.RewrapDataKeysResponse, error) {
+	return nil, unsupportedError("RewrapDataKeys")
+}
+func (c *pfsBuilderClient) ReconcileStorage(ctx context.Context, req *pfs.ReconcileStorageRequest, opts ...grpc.CallOption) (pfs.API_ReconcileStorageClient, error) {
+	return nil, unsupportedError("ReconcileStorage")
+}
+func (c *pfsBuilderClient) MigrateV1(ctx context.Context, req *pfs.MigrateV1Request, opts ...grpc.CallOption) (pfs.API_MigrateV1Client, error) {
+	return nil, unsupportedError("MigrateV1")
+}
 func (c *pfsBuilderClient) CreateFileset(ctx context.Context, opts ...grpc.CallOption) (pfs.API_CreateFilesetClient, error) {
 	return nil, unsupportedError("CreateFileset")
 }
@@ -647,6 +662,12 @@ func (c *versionBuilderClient) GetVersion(ctx context.Context, req *types.Empty,
 func (c *adminBuilderClient) InspectCluster(ctx context.Context, req *types.Empty, opts ...grpc.CallOption) (*admin.ClusterInfo, error) {
 	return nil, unsupportedError("InspectCluster")
 }
+func (c *adminBuilderClient) Extract(ctx context.Context, req *admin.ExtractRequest, opts ...grpc.CallOption) (admin.API_ExtractClient, error) {
+	return nil, unsupportedError("Extract")
+}
+func (c *adminBuilderClient) Restore(ctx context.Context, opts ...grpc.CallOption) (admin.API_RestoreClient, error) {
+	return nil, unsupportedError("Restore")
+}
 
 func (c *transactionBuilderClient) BatchTransaction(ctx context.Context, req *transaction.BatchTransactionRequest, opts ...grpc.CallOption) (*transaction.TransactionInfo, error) {
 	return nil, unsupportedError("BatchTransaction")
@@ -1,7 +1,10 @@
 package cmds
 
 import (
+	"bufio"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
@@ -32,5 +35,85 @@ func Cmds() []*cobra.Command {
 	}
 	commands = append(commands, cmdutil.CreateAlias(inspectCluster, "inspect cluster"))
 
+	var outputPath string
+	var url string
+	var noAuth bool
+	extract := &cobra.Command{
+		Short: "Extract Pachyderm state to stdout or an object store bucket.",
+		Long: `Extract Pachyderm state to stdout or an object store bucket.
+
+The extracted ops recreate the repos, commits, branches and pipelines of the
+cluster, along with its identity and auth state unless --no-auth is set. The
+contents of the files in the cluster are included in the ops. If --url is set,
+the chunk objects are also copied to the object store at the url, as a copy of
+the cluster's object storage. Restoring the ops doesn't use the copied chunks.`,
+		Example: `
+# Extract into a local file:
+$ {{alias}} > backup
+
+# Extract to a file and copy the chunks to s3:
+$ {{alias}} -o backup -u s3://bucket/path`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var w io.Writer = os.Stdout
+			if outputPath != "" {
+				f, err := os.Create(outputPath)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+			bw := bufio.NewWriter(w)
+			if err := c.ExtractWriter(url, noAuth, bw); err != nil {
+				return err
+			}
+			return bw.Flush()
+		}),
+	}
+	extract.Flags().StringVarP(&outputPath, "output", "o", "", "The path to write the extracted ops to, stdout if it is not set.")
+	extract.Flags().StringVarP(&url, "url", "u", "", "An object storage url (i.e. s3://...) to copy the chunk objects to.")
+	extract.Flags().BoolVar(&noAuth, "no-auth", false, "Don't extract the identity and auth state.")
+	commands = append(commands, cmdutil.CreateAlias(extract, "extract"))
+
+	var inputPath string
+	restore := &cobra.Command{
+		Short: "Restore Pachyderm state from stdin or a file.",
+		Long: `Restore Pachyderm state from stdin or a file.
+
+The ops written by extract are restored into the cluster, which should be
+empty. Auth must be activated in the cluster before restoring ops that were
+extracted with the identity and auth state.`,
+		Example: `
+# Restore from a local file:
+$ {{alias}} < backup
+
+# Restore from a local file, by path:
+$ {{alias}} -i backup`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var r io.Reader = os.Stdin
+			if inputPath != "" {
+				f, err := os.Open(inputPath)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+			return c.RestoreReader(bufio.NewReader(r))
+		}),
+	}
+	restore.Flags().StringVarP(&inputPath, "input", "i", "", "The path to read the ops from, stdin if it is not set.")
+	commands = append(commands, cmdutil.CreateAlias(restore, "restore"))
+
 	return commands
 }
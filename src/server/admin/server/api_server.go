@@ -4,12 +4,14 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
 
 	"golang.org/x/net/context"
 )
 
 type apiServer struct {
 	log.Logger
+	env         *serviceenv.ServiceEnv
 	clusterInfo *admin.ClusterInfo
 }
 
@@ -0,0 +1,401 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	globlib "github.com/pachyderm/ohmyglob"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/identity"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs/server"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
+	"github.com/sirupsen/logrus"
+)
+
+// Extract implements the protobuf admin.Extract RPC. The ops are sent in the
+// order that they need to be restored in: repos, commits (with the changes to
+// their files), branches, pipelines and then, unless request.NoAuth is set,
+// the identity and auth state. Commits that are still open are extracted
+// without their files.
+func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.API_ExtractServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	var sent int
+	defer func(start time.Time) {
+		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
+	}(time.Now())
+	pachClient := a.env.GetPachClient(extractServer.Context())
+	if request.URL != "" {
+		if err := a.copyChunks(pachClient.Ctx(), request.URL); err != nil {
+			return err
+		}
+	}
+	e := &extractor{
+		pachClient: pachClient,
+		send: func(op *admin.Op) error {
+			sent++
+			return extractServer.Send(op)
+		},
+	}
+	repoInfos, err := pachClient.ListRepo()
+	if err != nil {
+		return err
+	}
+	repos := []string{ppsconsts.SpecRepo}
+	for _, repoInfo := range repoInfos {
+		if err := e.send(&admin.Op{Repo: &pfs.CreateRepoRequest{
+			Repo:        repoInfo.Repo,
+			Description: repoInfo.Description,
+		}}); err != nil {
+			return err
+		}
+		repos = append(repos, repoInfo.Repo.Name)
+	}
+	if err := e.extractCommits(repos); err != nil {
+		return err
+	}
+	if err := e.extractBranches(repos); err != nil {
+		return err
+	}
+	if err := e.extractPipelines(); err != nil {
+		return err
+	}
+	if request.NoAuth {
+		return nil
+	}
+	return e.extractAuth(repos[1:])
+}
+
+// copyChunks copies the chunk objects to the object storage at url, which is
+// accessed with the credentials of this cluster's object storage.
+func (a *apiServer) copyChunks(ctx context.Context, urlStr string) error {
+	url, err := obj.ParseURL(urlStr)
+	if err != nil {
+		return err
+	}
+	dst, err := obj.NewClientFromURLAndSecret(url)
+	if err != nil {
+		return err
+	}
+	src, err := pfsserver.NewObjClient(a.env.Configuration)
+	if err != nil {
+		return err
+	}
+	var copied int
+	defer func() {
+		logrus.Infof("copied %d chunks to %s", copied, urlStr)
+	}()
+	return chunk.CopyObjects(ctx, src, dst, url.Object, func(string) error {
+		copied++
+		return nil
+	})
+}
+
+type extractor struct {
+	pachClient *client.APIClient
+	send       func(*admin.Op) error
+}
+
+func commitKey(commit *pfs.Commit) string {
+	return commit.Repo.Name + "@" + commit.ID
+}
+
+func branchKey(branch *pfs.Branch) string {
+	return branch.Repo.Name + "@" + branch.Name
+}
+
+func (e *extractor) extractCommits(repos []string) error {
+	var commitInfos []*pfs.CommitInfo
+	for _, repo := range repos {
+		if err := e.pachClient.ListCommitF(repo, "", "", 0, true, func(ci *pfs.CommitInfo) error {
+			commitInfos = append(commitInfos, ci)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	// Commits are restored after their parents and the commits in their
+	// provenance.
+	byKey := make(map[string]*pfs.CommitInfo)
+	for _, ci := range commitInfos {
+		byKey[commitKey(ci.Commit)] = ci
+	}
+	visited := make(map[string]bool)
+	var visit func(ci *pfs.CommitInfo) error
+	visit = func(ci *pfs.CommitInfo) error {
+		key := commitKey(ci.Commit)
+		if visited[key] {
+			return nil
+		}
+		visited[key] = true
+		if ci.ParentCommit != nil {
+			if parent, ok := byKey[commitKey(ci.ParentCommit)]; ok {
+				if err := visit(parent); err != nil {
+					return err
+				}
+			}
+		}
+		for _, prov := range ci.Provenance {
+			if provCi, ok := byKey[commitKey(prov.Commit)]; ok {
+				if err := visit(provCi); err != nil {
+					return err
+				}
+			}
+		}
+		return e.extractCommit(ci)
+	}
+	for _, ci := range commitInfos {
+		if err := visit(ci); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *extractor) extractCommit(ci *pfs.CommitInfo) error {
+	if ci.Finished != nil {
+		if err := e.extractFiles(ci.Commit); err != nil {
+			return err
+		}
+	}
+	var parentID string
+	if ci.ParentCommit != nil {
+		parentID = ci.ParentCommit.ID
+	}
+	req := &pfs.BuildCommitRequest{
+		Parent:      client.NewCommit(ci.Commit.Repo.Name, parentID),
+		Origin:      ci.Origin,
+		Provenance:  ci.Provenance,
+		ID:          ci.Commit.ID,
+		Description: ci.Description,
+		Started:     ci.Started,
+		Finished:    ci.Finished,
+	}
+	if ci.Branch != nil {
+		req.Branch = ci.Branch.Name
+	}
+	return e.send(&admin.Op{Commit: req})
+}
+
+// extractFiles sends the changes to the files in commit relative to its
+// parent.
+func (e *extractor) extractFiles(commit *pfs.Commit) error {
+	return e.pachClient.DiffFile(commit.Repo.Name, commit.ID, "/", "", "", "", false, func(newFi, oldFi *pfs.FileInfo) error {
+		if newFi != nil {
+			if newFi.FileType == pfs.FileType_DIR {
+				return nil
+			}
+			return e.extractFile(commit, newFi.File.Path)
+		}
+		if oldFi.FileType == pfs.FileType_DIR {
+			return nil
+		}
+		return e.send(&admin.Op{ModifyFile: &pfs.ModifyFileRequest{
+			Modification: &pfs.ModifyFileRequest_DeleteFile{
+				DeleteFile: &pfs.DeleteFile{File: oldFi.File.Path},
+			},
+		}})
+	})
+}
+
+func (e *extractor) extractFile(commit *pfs.Commit, path string) error {
+	if err := e.send(rawFileOp(&pfs.RawFileSource{Path: path})); err != nil {
+		return err
+	}
+	if err := e.pachClient.GetFile(commit.Repo.Name, commit.ID, globlib.QuoteMeta(path), &rawFileWriter{send: e.send}); err != nil {
+		return err
+	}
+	return e.send(rawFileOp(&pfs.RawFileSource{EOF: true}))
+}
+
+func rawFileOp(src *pfs.RawFileSource) *admin.Op {
+	return &admin.Op{ModifyFile: &pfs.ModifyFileRequest{
+		Modification: &pfs.ModifyFileRequest_AppendFile{
+			AppendFile: &pfs.AppendFile{
+				Overwrite: true,
+				Source:    &pfs.AppendFile_RawFileSource{RawFileSource: src},
+			},
+		},
+	}}
+}
+
+// rawFileWriter sends the data written to it as raw file source ops.
+type rawFileWriter struct {
+	send func(*admin.Op) error
+}
+
+func (w *rawFileWriter) Write(data []byte) (int, error) {
+	for _, c := range grpcutil.Chunk(data) {
+		if err := w.send(rawFileOp(&pfs.RawFileSource{Data: c})); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+func (e *extractor) extractBranches(repos []string) error {
+	var branchInfos []*pfs.BranchInfo
+	for _, repo := range repos {
+		bis, err := e.pachClient.ListBranch(repo)
+		if err != nil {
+			return err
+		}
+		branchInfos = append(branchInfos, bis...)
+	}
+	// Branches are restored after the branches in their provenance.
+	byKey := make(map[string]*pfs.BranchInfo)
+	for _, bi := range branchInfos {
+		byKey[branchKey(bi.Branch)] = bi
+	}
+	visited := make(map[string]bool)
+	var visit func(bi *pfs.BranchInfo) error
+	visit = func(bi *pfs.BranchInfo) error {
+		key := branchKey(bi.Branch)
+		if visited[key] {
+			return nil
+		}
+		visited[key] = true
+		for _, prov := range bi.DirectProvenance {
+			if provBi, ok := byKey[branchKey(prov)]; ok {
+				if err := visit(provBi); err != nil {
+					return err
+				}
+			}
+		}
+		return e.send(&admin.Op{Branch: &pfs.CreateBranchRequest{
+			Head:       bi.Head,
+			Branch:     bi.Branch,
+			Provenance: bi.DirectProvenance,
+			Trigger:    bi.Trigger,
+		}})
+	}
+	for _, bi := range branchInfos {
+		if err := visit(bi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *extractor) extractPipelines() error {
+	pipelineInfos, err := e.pachClient.ListPipeline()
+	if err != nil {
+		return err
+	}
+	for _, pipelineInfo := range pipelineInfos {
+		req := ppsutil.PipelineReqFromInfo(pipelineInfo)
+		req.SpecCommit = pipelineInfo.SpecCommit
+		if err := e.send(&admin.Op{Pipeline: req}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractAuth sends the identity and auth state, it sends nothing if auth is
+// not activated.
+func (e *extractor) extractAuth(repos []string) error {
+	ctx := e.pachClient.Ctx()
+	idConfig, err := e.pachClient.GetIdentityServerConfig(ctx, &identity.GetIdentityServerConfigRequest{})
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return nil
+		}
+		return err
+	}
+	if idConfig.Config != nil && idConfig.Config.Issuer != "" {
+		if err := e.send(&admin.Op{IdentityConfig: &identity.SetIdentityServerConfigRequest{
+			Config: idConfig.Config,
+		}}); err != nil {
+			return err
+		}
+	}
+	connectors, err := e.pachClient.ListIDPConnectors(ctx, &identity.ListIDPConnectorsRequest{})
+	if err != nil {
+		return err
+	}
+	for _, connector := range connectors.Connectors {
+		if err := e.send(&admin.Op{IdpConnector: &identity.CreateIDPConnectorRequest{
+			Connector: connector,
+		}}); err != nil {
+			return err
+		}
+	}
+	oidcClients, err := e.pachClient.ListOIDCClients(ctx, &identity.ListOIDCClientsRequest{})
+	if err != nil {
+		return err
+	}
+	for _, oidcClient := range oidcClients.Clients {
+		if err := e.send(&admin.Op{OidcClient: &identity.CreateOIDCClientRequest{
+			Client: oidcClient,
+		}}); err != nil {
+			return err
+		}
+	}
+	authConfig, err := e.pachClient.GetConfiguration(ctx, &auth.GetConfigurationRequest{})
+	if err != nil {
+		return err
+	}
+	if authConfig.Configuration != nil {
+		if err := e.send(&admin.Op{AuthConfig: &auth.SetConfigurationRequest{
+			Configuration: authConfig.Configuration,
+		}}); err != nil {
+			return err
+		}
+	}
+	bindings, err := e.pachClient.GetClusterRoleBindings(ctx, &auth.GetClusterRoleBindingsRequest{})
+	if err != nil {
+		return err
+	}
+	var principals []string
+	for principal := range bindings.Bindings {
+		// The root user's roles cannot be modified.
+		if principal != auth.RootUser {
+			principals = append(principals, principal)
+		}
+	}
+	sort.Strings(principals)
+	for _, principal := range principals {
+		if err := e.send(&admin.Op{ClusterRoleBinding: &auth.ModifyClusterRoleBindingRequest{
+			Principal: principal,
+			Roles:     bindings.Bindings[principal],
+		}}); err != nil {
+			return err
+		}
+	}
+	for _, repo := range repos {
+		acl, err := e.pachClient.GetACL(ctx, &auth.GetACLRequest{Repo: repo})
+		if err != nil {
+			return err
+		}
+		sort.Slice(acl.Entries, func(i, j int) bool {
+			return acl.Entries[i].Username < acl.Entries[j].Username
+		})
+		if err := e.send(&admin.Op{Acl: &auth.SetACLRequest{
+			Repo:    repo,
+			Entries: acl.Entries,
+		}}); err != nil {
+			return err
+		}
+	}
+	tokens, err := e.pachClient.ExtractAuthTokens(ctx, &auth.ExtractAuthTokensRequest{})
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens.Tokens {
+		if err := e.send(&admin.Op{AuthToken: &auth.RestoreAuthTokenRequest{
+			Token: token,
+		}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+// Restore implements the protobuf admin.Restore RPC. The ops are applied in
+// the order that they are received, so restoring the ops sent by Extract
+// recreates the extracted cluster.
+func (a *apiServer) Restore(restoreServer admin.API_RestoreServer) (retErr error) {
+	func() { a.Log(nil, nil, nil, 0) }()
+	var received int
+	defer func(start time.Time) {
+		a.Log(fmt.Sprintf("request stream with %d objects", received), nil, retErr, time.Since(start))
+	}(time.Now())
+	r := &restorer{pachClient: a.env.GetPachClient(restoreServer.Context())}
+	for {
+		req, err := restoreServer.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		received++
+		if err := r.restore(req.Op); err != nil {
+			return errors.Wrapf(err, "error restoring op %d", received)
+		}
+	}
+	if r.fileset != nil {
+		return errors.Errorf("file changes were not followed by a commit")
+	}
+	return restoreServer.SendAndClose(&types.Empty{})
+}
+
+type restorer struct {
+	pachClient *client.APIClient
+	// fileset receives the file changes for the next commit op.
+	fileset pfs.API_CreateFilesetClient
+}
+
+func (r *restorer) restore(op *admin.Op) error {
+	if op.ModifyFile != nil {
+		if r.fileset == nil {
+			var err error
+			if r.fileset, err = r.pachClient.PfsAPIClient.CreateFileset(r.pachClient.Ctx()); err != nil {
+				return err
+			}
+		}
+		return r.fileset.Send(op.ModifyFile)
+	}
+	if op.Commit != nil {
+		if r.fileset != nil {
+			resp, err := r.fileset.CloseAndRecv()
+			r.fileset = nil
+			if err != nil {
+				return err
+			}
+			op.Commit.FilesetId = resp.FilesetId
+		}
+		_, err := r.pachClient.PfsAPIClient.BuildCommit(r.pachClient.Ctx(), op.Commit)
+		return err
+	}
+	if r.fileset != nil {
+		return errors.Errorf("file changes were not followed by a commit")
+	}
+	ctx := r.pachClient.Ctx()
+	var err error
+	switch {
+	case op.Repo != nil:
+		_, err = r.pachClient.PfsAPIClient.CreateRepo(ctx, op.Repo)
+	case op.Branch != nil:
+		_, err = r.pachClient.PfsAPIClient.CreateBranch(ctx, op.Branch)
+	case op.Pipeline != nil:
+		_, err = r.pachClient.PpsAPIClient.CreatePipeline(ctx, op.Pipeline)
+	case op.IdentityConfig != nil:
+		_, err = r.pachClient.SetIdentityServerConfig(ctx, op.IdentityConfig)
+	case op.IdpConnector != nil:
+		_, err = r.pachClient.CreateIDPConnector(ctx, op.IdpConnector)
+	case op.OidcClient != nil:
+		_, err = r.pachClient.CreateOIDCClient(ctx, op.OidcClient)
+	case op.AuthConfig != nil:
+		_, err = r.pachClient.SetConfiguration(ctx, op.AuthConfig)
+	case op.ClusterRoleBinding != nil:
+		_, err = r.pachClient.ModifyClusterRoleBinding(ctx, op.ClusterRoleBinding)
+	case op.Acl != nil:
+		_, err = r.pachClient.SetACL(ctx, op.Acl)
+	case op.AuthToken != nil:
+		_, err = r.pachClient.RestoreAuthToken(ctx, op.AuthToken)
+	default:
+		err = errors.Errorf("empty op")
+	}
+	return err
+}
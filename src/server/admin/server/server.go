@@ -3,6 +3,7 @@ package server
 import (
 	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
 )
 
 // APIServer represents and APIServer
@@ -11,9 +12,10 @@ type APIServer interface {
 }
 
 // NewAPIServer returns a new admin.APIServer
-func NewAPIServer(clusterInfo *admin.ClusterInfo) APIServer {
+func NewAPIServer(env *serviceenv.ServiceEnv, clusterInfo *admin.ClusterInfo) APIServer {
 	return &apiServer{
 		Logger:      log.NewLogger("admin.API"),
+		env:         env,
 		clusterInfo: clusterInfo,
 	}
 }
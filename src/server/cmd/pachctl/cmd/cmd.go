@@ -897,6 +897,8 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"extract",
 			"restore",
 			"garbage-collect",
+			"reconcile-storage",
+			"migrate-v1",
 			"update-dash",
 			"auth",
 			"enterprise",
@@ -1,6 +1,7 @@
 package main
 
 import (
+	gotls "crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -8,7 +9,9 @@ import (
 	"path"
 	"runtime/debug"
 	"runtime/pprof"
+	"strings"
 
+	"github.com/pachyderm/pachyderm/src/client"
 	adminclient "github.com/pachyderm/pachyderm/src/client/admin"
 	authclient "github.com/pachyderm/pachyderm/src/client/auth"
 	debugclient "github.com/pachyderm/pachyderm/src/client/debug"
@@ -18,6 +21,7 @@ import (
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tls"
 	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	transactionclient "github.com/pachyderm/pachyderm/src/client/transaction"
@@ -28,7 +32,9 @@ import (
 	debugserver "github.com/pachyderm/pachyderm/src/server/debug/server"
 	eprsserver "github.com/pachyderm/pachyderm/src/server/enterprise/server"
 	"github.com/pachyderm/pachyderm/src/server/health"
+	pach_http "github.com/pachyderm/pachyderm/src/server/http"
 	identity_server "github.com/pachyderm/pachyderm/src/server/identity/server"
+	"github.com/pachyderm/pachyderm/src/server/pfs/s3"
 	pfs_server "github.com/pachyderm/pachyderm/src/server/pfs/server"
 	"github.com/pachyderm/pachyderm/src/server/pkg/auth"
 	"github.com/pachyderm/pachyderm/src/server/pkg/clusterstate"
@@ -429,7 +435,7 @@ func doFullMode(config interface{}) (retErr error) {
 			return err
 		}
 		if err := logGRPCServerSetup("Admin API", func() error {
-			adminclient.RegisterAPIServer(externalServer.Server, adminserver.NewAPIServer(&adminclient.ClusterInfo{
+			adminclient.RegisterAPIServer(externalServer.Server, adminserver.NewAPIServer(env, &adminclient.ClusterInfo{
 				ID:           clusterID,
 				DeploymentID: env.DeploymentID,
 			}))
@@ -597,7 +603,7 @@ func doFullMode(config interface{}) (retErr error) {
 			return err
 		}
 		if err := logGRPCServerSetup("Admin API", func() error {
-			adminclient.RegisterAPIServer(internalServer.Server, adminserver.NewAPIServer(&adminclient.ClusterInfo{
+			adminclient.RegisterAPIServer(internalServer.Server, adminserver.NewAPIServer(env, &adminclient.ClusterInfo{
 				ID:           clusterID,
 				DeploymentID: env.DeploymentID,
 			}))
@@ -624,58 +630,60 @@ func doFullMode(config interface{}) (retErr error) {
 	go waitForError("Internal Pachd GRPC Server", errChan, true, func() error {
 		return internalServer.Wait()
 	})
-	// TODO: Make http server work with V2.
-	//go waitForError("HTTP Server", errChan, requireNoncriticalServers, func() error {
-	//	httpServer, err := pach_http.NewHTTPServer(address)
-	//	if err != nil {
-	//		return err
-	//	}
-	//	server := http.Server{
-	//		Addr:    fmt.Sprintf(":%v", env.HTTPPort),
-	//		Handler: httpServer,
-	//	}
+	go waitForError("HTTP Server", errChan, requireNoncriticalServers, func() error {
+		var allowedOrigins []string
+		if env.HTTPAllowedOrigins != "" {
+			allowedOrigins = strings.Split(env.HTTPAllowedOrigins, ",")
+		}
+		httpServer, err := pach_http.NewHTTPServer(address, allowedOrigins)
+		if err != nil {
+			return err
+		}
+		server := http.Server{
+			Addr:    fmt.Sprintf(":%v", env.HTTPPort),
+			Handler: httpServer,
+		}
 
-	//	certPath, keyPath, err := tls.GetCertPaths()
-	//	if err != nil {
-	//		log.Warnf("pfs-over-HTTP - TLS disabled: %v", err)
-	//		return server.ListenAndServe()
-	//	}
+		certPath, keyPath, err := tls.GetCertPaths()
+		if err != nil {
+			log.Warnf("pfs-over-HTTP - TLS disabled: %v", err)
+			return server.ListenAndServe()
+		}
 
-	//	cLoader := tls.NewCertLoader(certPath, keyPath, tls.CertCheckFrequency)
-	//	err = cLoader.LoadAndStart()
-	//	if err != nil {
-	//		return errors.Wrapf(err, "couldn't load TLS cert for pfs-over-http: %v", err)
-	//	}
+		cLoader := tls.NewCertLoader(certPath, keyPath, tls.CertCheckFrequency)
+		err = cLoader.LoadAndStart()
+		if err != nil {
+			return errors.Wrapf(err, "couldn't load TLS cert for pfs-over-http: %v", err)
+		}
 
-	//	server.TLSConfig = &gotls.Config{GetCertificate: cLoader.GetCertificate}
+		server.TLSConfig = &gotls.Config{GetCertificate: cLoader.GetCertificate}
 
-	//	return server.ListenAndServeTLS(certPath, keyPath)
-	//})
+		return server.ListenAndServeTLS(certPath, keyPath)
+	})
 	go waitForError("Githook Server", errChan, requireNoncriticalServers, func() error {
 		return githook.RunGitHookServer(address, etcdAddress, path.Join(env.EtcdPrefix, env.PPSEtcdPrefix))
 	})
-	// TODO: Make s3 server work with V2.
-	//go waitForError("S3 Server", errChan, requireNoncriticalServers, func() error {
-	//	server, err := s3.Server(env.S3GatewayPort, s3.NewMasterDriver(), func() (*client.APIClient, error) {
-	//		return client.NewFromAddress(fmt.Sprintf("localhost:%d", env.PeerPort))
-	//	})
-	//	if err != nil {
-	//		return err
-	//	}
-	//	certPath, keyPath, err := tls.GetCertPaths()
-	//	if err != nil {
-	//		log.Warnf("s3gateway TLS disabled: %v", err)
-	//		return server.ListenAndServe()
-	//	}
-	//	cLoader := tls.NewCertLoader(certPath, keyPath, tls.CertCheckFrequency)
-	//	// Read TLS cert and key
-	//	err = cLoader.LoadAndStart()
-	//	if err != nil {
-	//		return errors.Wrapf(err, "couldn't load TLS cert for s3gateway: %v", err)
-	//	}
-	//	server.TLSConfig = &gotls.Config{GetCertificate: cLoader.GetCertificate}
-	//	return server.ListenAndServeTLS(certPath, keyPath)
-	//})
+	go waitForError("S3 Server", errChan, requireNoncriticalServers, func() error {
+		server, err := s3.Server(env.S3GatewayPort, s3.NewMasterDriver(), func() (*client.APIClient, error) {
+			return client.NewFromAddress(fmt.Sprintf("localhost:%d", env.PeerPort))
+		})
+		if err != nil {
+			return err
+		}
+		certPath, keyPath, err := tls.GetCertPaths()
+		if err != nil {
+			log.Warnf("s3gateway TLS disabled: %v", err)
+			return server.ListenAndServe()
+		}
+		cLoader := tls.NewCertLoader(certPath, keyPath, tls.CertCheckFrequency)
+		// Read TLS cert and key
+		err = cLoader.LoadAndStart()
+		if err != nil {
+			return errors.Wrapf(err, "couldn't load TLS cert for s3gateway: %v", err)
+		}
+		server.TLSConfig = &gotls.Config{GetCertificate: cLoader.GetCertificate}
+		return server.ListenAndServeTLS(certPath, keyPath)
+	})
 	go waitForError("Prometheus Server", errChan, requireNoncriticalServers, func() error {
 		http.Handle("/metrics", promhttp.Handler())
 		return http.ListenAndServe(fmt.Sprintf(":%v", assets.PrometheusPort), nil)
@@ -20,10 +20,12 @@ import (
 	logutil "github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+	"github.com/pachyderm/pachyderm/src/server/pkg/work"
 	"github.com/pachyderm/pachyderm/src/server/worker"
 	workerserver "github.com/pachyderm/pachyderm/src/server/worker/server"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/jmoiron/sqlx"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -87,7 +89,15 @@ func do(config interface{}) error {
 
 	// Construct worker API server.
 	workerRcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
-	workerInstance, err := worker.NewWorker(pachClient, env.GetEtcdClient(), env.PPSEtcdPrefix, pipelineInfo, env.PodName, env.Namespace, "/")
+	// Task queues are stored in etcd unless postgres is configured.
+	if err := work.ValidateBackend(env.WorkBackend); err != nil {
+		return err
+	}
+	var db *sqlx.DB
+	if env.WorkBackend == work.PostgresBackend {
+		db = env.GetDBClient()
+	}
+	workerInstance, err := worker.NewWorker(pachClient, env.GetEtcdClient(), env.PPSEtcdPrefix, db, pipelineInfo, env.PodName, env.Namespace, "/")
 	if err != nil {
 		return err
 	}
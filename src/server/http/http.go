@@ -1,144 +1,357 @@
+// Package http implements pachd's HTTP server. It serves the files in PFS at
+// /<repo>/<commit or branch>/<path>, proxies requests to PPS services, and
+// sets the auth token cookie used by browsers.
 package http
 
-// TODO: Make http server work with V2.
-//// HTTPPort specifies the port the server will listen on
-//const apiVersion = "v1"
-//
-//func versionPath(p string) string {
-//	return path.Join("/", apiVersion, p)
-//}
-//
-//var (
-//	getFilePath = versionPath("pfs/repos/:repoName/commits/:commitID/files/*filePath")
-//	servicePath = versionPath("pps/services/:serviceName/*path")
-//	loginPath   = versionPath("auth/login")
-//	logoutPath  = versionPath("auth/logout")
-//)
-//
-//type router = *httprouter.Router
-//
-//type server struct {
-//	router
-//	address        string
-//	pachClient     *client.APIClient
-//	pachClientOnce sync.Once
-//	httpClient     *http.Client
-//}
-//
-//// NewHTTPServer returns a Pachyderm HTTP server.
-//func NewHTTPServer(address string) (http.Handler, error) {
-//	router := httprouter.New()
-//	s := &server{
-//		router:     router,
-//		address:    address,
-//		httpClient: &http.Client{},
-//	}
-//
-//	router.GET(getFilePath, s.getFileHandler)
-//	router.GET(servicePath, s.serviceHandler)
-//
-//	router.POST(loginPath, s.authLoginHandler)
-//	router.POST(logoutPath, s.authLogoutHandler)
-//	router.POST(servicePath, s.serviceHandler)
-//
-//	router.NotFound = http.HandlerFunc(notFound)
-//	return s, nil
-//}
-//
-//func (s *server) getFileHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-//	filePaths := strings.Split(ps.ByName("filePath"), "/")
-//	fileName := filePaths[len(filePaths)-1]
-//	ctx := context.Background()
-//	for _, cookie := range r.Cookies() {
-//		if cookie.Name == auth.ContextTokenKey {
-//			ctx = metadata.NewIncomingContext(
-//				ctx,
-//				metadata.Pairs(auth.ContextTokenKey, cookie.Value),
-//			)
-//		}
-//	}
-//	downloadValues := r.URL.Query()["download"]
-//	if len(downloadValues) == 1 && downloadValues[0] == "true" {
-//		w.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=\"%v\"", fileName))
-//	}
-//	c := s.getPachClient().WithCtx(ctx)
-//	commitInfo, err := c.InspectCommit(ps.ByName("repoName"), ps.ByName("commitID"))
-//	if err != nil {
-//		httpError(w, err)
-//		return
-//	}
-//	content, err := c.GetFileReadSeeker(ps.ByName("repoName"), ps.ByName("commitID"), ps.ByName("filePath"))
-//	if err != nil {
-//		httpError(w, err)
-//		return
-//	}
-//	modtime, err := types.TimestampFromProto(commitInfo.Finished)
-//	if err != nil {
-//		httpError(w, err)
-//		return
-//	}
-//	http.ServeContent(w, r, fileName, modtime, content)
-//}
-//
-//func (s *server) serviceHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-//	c := s.getPachClient()
-//	serviceName := ps.ByName("serviceName")
-//	pipelineInfo, err := c.InspectPipeline(serviceName)
-//	if err != nil {
-//		httpError(w, err)
-//		return
-//	}
-//	URL, err := url.Parse(fmt.Sprintf("http://%s:%d", pipelineInfo.Service.IP, pipelineInfo.Service.ExternalPort))
-//	if err != nil {
-//		http.Error(w, err.Error(), http.StatusInternalServerError)
-//		return
-//	}
-//	proxy := httputil.NewSingleHostReverseProxy(URL)
-//	director := proxy.Director
-//	proxy.Director = func(req *http.Request) {
-//		director(req)
-//		req.URL.Path = strings.TrimPrefix(req.URL.Path, path.Join(path.Dir(path.Dir(servicePath)), serviceName))
-//	}
-//	proxy.ServeHTTP(w, r)
-//}
-//
-//func (s *server) authLoginHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-//	token := r.FormValue("Token")
-//	if token == "" {
-//		http.Error(w, "empty token provided", http.StatusInternalServerError)
-//		return
-//	}
-//	w.Header().Add("Set-Cookie", fmt.Sprintf("%v=%v;path=/", auth.ContextTokenKey,
-//		token))
-//	w.Header().Add("Access-Control-Allow-Origin", "*")
-//	w.WriteHeader(http.StatusOK)
-//}
-//
-//func (s *server) authLogoutHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-//	w.Header().Add("Set-Cookie", fmt.Sprintf("%v=;path=/", auth.ContextTokenKey))
-//	w.Header().Add("Access-Control-Allow-Origin", "*")
-//	w.WriteHeader(http.StatusOK)
-//}
-//
-//func notFound(w http.ResponseWriter, r *http.Request) {
-//	http.Error(w, "route not found", http.StatusNotFound)
-//}
-//
-//func httpError(w http.ResponseWriter, err error) {
-//	if errutil.IsNotFoundError(err) {
-//		http.Error(w, err.Error(), http.StatusNotFound)
-//	} else {
-//		http.Error(w, err.Error(), http.StatusInternalServerError)
-//	}
-//}
-//
-//func (s *server) getPachClient() *client.APIClient {
-//	s.pachClientOnce.Do(func() {
-//		var err error
-//		s.pachClient, err = client.NewFromAddress(s.address)
-//		if err != nil {
-//			panic(fmt.Sprintf("http server failed to initialize pach client: %v", err))
-//		}
-//	})
-//	return s.pachClient
-//}
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+// The auth and service routes predate the file routes, and take precedence
+// over them, so they shadow the "auth" and "pps" branches of a repo named
+// "v1". The commits on those branches can still be read by their IDs.
+const (
+	loginPath     = "/v1/auth/login"
+	logoutPath    = "/v1/auth/logout"
+	servicePrefix = "/v1/pps/services/"
+)
+
+type server struct {
+	address        string
+	allowedOrigins map[string]bool
+	pachClient     *client.APIClient
+	pachClientOnce sync.Once
+}
+
+// NewHTTPServer returns a Pachyderm HTTP server. Cross-origin requests to the
+// auth routes are only allowed from allowedOrigins, since they set the auth
+// token cookie.
+func NewHTTPServer(address string, allowedOrigins []string) (http.Handler, error) {
+	s := &server{
+		address:        address,
+		allowedOrigins: make(map[string]bool),
+	}
+	for _, origin := range allowedOrigins {
+		s.allowedOrigins[origin] = true
+	}
+	return s, nil
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == loginPath:
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		s.authLoginHandler(w, r)
+	case r.URL.Path == logoutPath:
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		s.authLogoutHandler(w, r)
+	case strings.HasPrefix(r.URL.Path, servicePrefix):
+		if r.Method != http.MethodGet && r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		s.serviceHandler(w, r)
+	default:
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			methodNotAllowed(w)
+			return
+		}
+		s.getFileHandler(w, r)
+	}
+}
+
+// getFileHandler serves the file or directory at /<repo>/<commit>/<path>.
+// Files are served with support for Range and conditional requests.
+// Directories are listed as a JSON array of FileInfos, or downloaded as a
+// tar file when the "format" query parameter is "tar".
+func (s *server) getFileHandler(w http.ResponseWriter, r *http.Request) {
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		notFound(w, r)
+		return
+	}
+	repo, commit, p := parts[0], parts[1], "/"
+	if len(parts) == 3 {
+		p = path.Clean("/" + parts[2])
+	}
+	pc := s.getPachClient(r)
+	commitInfo, err := readCommit(pc, repo, commit)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	modtime, err := types.TimestampFromProto(commitInfo.Finished)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	commit = commitInfo.Commit.ID
+	// Every version of a file is in a different commit, so the commit is
+	// included in the response.
+	w.Header().Set("X-Pachyderm-Commit", commit)
+	if p != "/" {
+		fi, err := pc.InspectFile(repo, commit, p)
+		if err != nil {
+			httpError(w, err)
+			return
+		}
+		if fi.FileType == pfs.FileType_FILE {
+			serveFile(w, r, pc, repo, commit, fi, modtime)
+			return
+		}
+	}
+	if r.URL.Query().Get("format") == "tar" {
+		serveTar(w, r, pc, repo, commit, p)
+		return
+	}
+	serveDirectory(w, r, pc, repo, commit, p)
+}
+
+func serveFile(w http.ResponseWriter, r *http.Request, pc *client.APIClient, repo, commit string, fi *pfs.FileInfo, modtime time.Time) {
+	name := path.Base(fi.File.Path)
+	if r.URL.Query().Get("download") == "true" {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	}
+	if len(fi.Hash) > 0 {
+		w.Header().Set("ETag", fmt.Sprintf("%q", hex.EncodeToString(fi.Hash)))
+	}
+	content, err := pc.GetFileReadSeeker(repo, commit, fi.File.Path)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	http.ServeContent(w, r, name, modtime, content)
+}
+
+func serveDirectory(w http.ResponseWriter, r *http.Request, pc *client.APIClient, repo, commit, p string) {
+	// ListFile also returns the siblings of p which have its name as a
+	// prefix, so those are filtered out.
+	dirPrefix := strings.TrimSuffix(p, "/") + "/"
+	var fileInfos []*pfs.FileInfo
+	if err := pc.ListFile(repo, commit, p, func(fi *pfs.FileInfo) error {
+		if strings.HasPrefix(fi.File.Path, dirPrefix) && fi.File.Path != dirPrefix {
+			fileInfos = append(fileInfos, fi)
+		}
+		return nil
+	}); err != nil {
+		httpError(w, err)
+		return
+	}
+	marshaler := &jsonpb.Marshaler{}
+	buf := &bytes.Buffer{}
+	buf.WriteString("[")
+	for i, fi := range fileInfos {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		if err := marshaler.Marshal(buf, fi); err != nil {
+			httpError(w, err)
+			return
+		}
+	}
+	buf.WriteString("]")
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodHead {
+		return
+	}
+	w.Write(buf.Bytes())
+}
+
+// serveTar writes the files under the directory p as a tar stream, with paths
+// relative to p.
+func serveTar(w http.ResponseWriter, r *http.Request, pc *client.APIClient, repo, commit, p string) {
+	dirPrefix := strings.TrimSuffix(p, "/") + "/"
+	name := path.Base(p)
+	if p == "/" {
+		name = repo
+	}
+	rc, err := pc.GetTarFile(repo, commit, client.GlobPattern(path.Join(p, "**")))
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-tar")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".tar"))
+	if r.Method == http.MethodHead {
+		return
+	}
+	// Headers have been sent once the first entry is written, so errors after
+	// that can only be reported by truncating the tar stream.
+	tr := tar.NewReader(rc)
+	tw := tar.NewWriter(w)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				tw.Close()
+			}
+			return
+		}
+		p := path.Join("/", hdr.Name)
+		if hdr.Typeflag == tar.TypeDir {
+			p += "/"
+		}
+		if !strings.HasPrefix(p, dirPrefix) || p == dirPrefix {
+			continue
+		}
+		hdr.Name = strings.TrimPrefix(p, dirPrefix)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return
+		}
+	}
+}
+
+// serviceHandler proxies requests to /v1/pps/services/<pipeline>/<path> to
+// the service of a service pipeline.
+func (s *server) serviceHandler(w http.ResponseWriter, r *http.Request) {
+	serviceName := strings.SplitN(strings.TrimPrefix(r.URL.Path, servicePrefix), "/", 2)[0]
+	pipelineInfo, err := s.getPachClient(r).InspectPipeline(serviceName)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	if pipelineInfo.Service == nil {
+		http.Error(w, fmt.Sprintf("pipeline %q is not a service", serviceName), http.StatusNotFound)
+		return
+	}
+	URL, err := url.Parse(fmt.Sprintf("http://%s:%d", pipelineInfo.Service.IP, pipelineInfo.Service.ExternalPort))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	proxy := httputil.NewSingleHostReverseProxy(URL)
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		req.URL.Path = strings.TrimPrefix(req.URL.Path, path.Join(servicePrefix, serviceName))
+	}
+	proxy.ServeHTTP(w, r)
+}
+
+func (s *server) authLoginHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.FormValue("Token")
+	if token == "" {
+		http.Error(w, "empty token provided", http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:  auth.ContextTokenKey,
+		Value: token,
+		Path:  "/",
+	})
+	s.allowOrigin(w, r)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *server) authLogoutHandler(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:   auth.ContextTokenKey,
+		Path:   "/",
+		MaxAge: -1,
+	})
+	s.allowOrigin(w, r)
+	w.WriteHeader(http.StatusOK)
+}
+
+// allowOrigin allows the request's origin to make credentialed cross-origin
+// requests, if it is one of the server's allowed origins.
+func (s *server) allowOrigin(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Origin")
+	origin := r.Header.Get("Origin")
+	if !s.allowedOrigins[origin] {
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	w.Header().Set("Access-Control-Allow-Credentials", "true")
+}
+
+func notFound(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, "route not found", http.StatusNotFound)
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+}
+
+func httpError(w http.ResponseWriter, err error) {
+	switch {
+	case auth.IsErrNotSignedIn(err), auth.IsErrBadToken(err), auth.IsErrExpiredToken(err):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case auth.IsErrNotAuthorized(err):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errutil.IsNotFoundError(err), pfsserver.IsNoHeadErr(err):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// getPachClient returns a client for the request, authenticated with the
+// token in the request's auth token header or cookie.
+func (s *server) getPachClient(r *http.Request) *client.APIClient {
+	s.pachClientOnce.Do(func() {
+		var err error
+		s.pachClient, err = client.NewFromAddress(s.address)
+		if err != nil {
+			panic(fmt.Sprintf("http server failed to initialize pach client: %v", err))
+		}
+	})
+	// WithCtx copies the client, so setting the auth token does not affect
+	// other requests.
+	pc := s.pachClient.WithCtx(r.Context())
+	token := r.Header.Get(auth.ContextTokenKey)
+	if token == "" {
+		if cookie, err := r.Cookie(auth.ContextTokenKey); err == nil {
+			token = cookie.Value
+		}
+	}
+	pc.SetAuthToken(token)
+	return pc
+}
+
+// readCommit returns the finished commit that reads from commit should see.
+// If commit is a branch, this is the most recent finished commit on the
+// branch.
+func readCommit(pc *client.APIClient, repo, commit string) (*pfs.CommitInfo, error) {
+	commitInfo, err := pc.InspectReadCommit(repo, commit)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo == nil {
+		return nil, pfsserver.ErrNoHead{Branch: client.NewBranch(repo, commit)}
+	}
+	if commitInfo.Finished == nil {
+		return nil, pfsserver.ErrCommitNotFinished{Commit: commitInfo.Commit}
+	}
+	return commitInfo, nil
+}
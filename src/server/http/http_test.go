@@ -0,0 +1,148 @@
+package http
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+)
+
+func withHTTP(t *testing.T, cb func(env *testpachd.RealEnv, url string)) {
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		handler, err := NewHTTPServer(env.MockPachd.Addr.String(), nil)
+		require.NoError(t, err)
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		cb(env, server.URL)
+		return nil
+	}))
+}
+
+func get(t *testing.T, url string, header http.Header) (*http.Response, string) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(data)
+}
+
+func TestGetFile(t *testing.T) {
+	withHTTP(t, func(env *testpachd.RealEnv, url string) {
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		require.NoError(t, env.PachClient.PutFile("repo", "master", "dir/file", strings.NewReader("0123456789")))
+
+		resp, body := get(t, url+"/repo/master/dir/file", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "0123456789", body)
+		etag := resp.Header.Get("ETag")
+		require.NotEqual(t, "", etag)
+
+		resp, body = get(t, url+"/repo/master/dir/file", http.Header{"Range": {"bytes=2-5"}})
+		require.Equal(t, http.StatusPartialContent, resp.StatusCode)
+		require.Equal(t, "2345", body)
+
+		resp, _ = get(t, url+"/repo/master/dir/file", http.Header{"If-None-Match": {etag}})
+		require.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+		// Files can also be read from a specific commit.
+		commitInfo, err := env.PachClient.InspectCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile("repo", "master", "dir/file", strings.NewReader("new")))
+		_, body = get(t, url+"/repo/"+commitInfo.Commit.ID+"/dir/file", nil)
+		require.Equal(t, "0123456789", body)
+
+		resp, _ = get(t, url+"/repo/master/missing", nil)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+		resp, _ = get(t, url+"/missing/master/dir/file", nil)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestGetDirectory(t *testing.T) {
+	withHTTP(t, func(env *testpachd.RealEnv, url string) {
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		require.NoError(t, env.PachClient.WithModifyFileClient("repo", "master", func(mfc *client.ModifyFileClient) error {
+			for _, p := range []string{"dir/a", "dir/b/c", "dir-x"} {
+				if err := mfc.AppendFile(p, true, strings.NewReader(p)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+
+		resp, body := get(t, url+"/repo/master/dir", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var listing []struct {
+			File struct {
+				Path string `json:"path"`
+			} `json:"file"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(body), &listing))
+		var paths []string
+		for _, entry := range listing {
+			paths = append(paths, entry.File.Path)
+		}
+		require.Equal(t, []string{"/dir/a", "/dir/b/"}, paths)
+
+		resp, body = get(t, url+"/repo/master/dir?format=tar", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		files := make(map[string]string)
+		tr := tar.NewReader(strings.NewReader(body))
+		for {
+			hdr, err := tr.Next()
+			if err != nil {
+				break
+			}
+			if hdr.Typeflag == tar.TypeDir {
+				continue
+			}
+			data, err := ioutil.ReadAll(tr)
+			require.NoError(t, err)
+			files[hdr.Name] = string(data)
+		}
+		require.Equal(t, map[string]string{"a": "dir/a", "b/c": "dir/b/c"}, files)
+	})
+}
+
+func TestAuthLogin(t *testing.T) {
+	h, err := NewHTTPServer("", []string{"https://dash.example.com"})
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, loginPath+"?Token=abc", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	cookies := w.Result().Cookies()
+	require.Equal(t, 1, len(cookies))
+	require.Equal(t, "abc", cookies[0].Value)
+
+	// Only the allowed origins can log in from another origin.
+	w = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, loginPath+"?Token=abc", nil)
+	req.Header.Set("Origin", "https://dash.example.com")
+	h.ServeHTTP(w, req)
+	require.Equal(t, "https://dash.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, loginPath+"?Token=abc", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	h.ServeHTTP(w, req)
+	require.Equal(t, "", w.Header().Get("Access-Control-Allow-Origin"))
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, loginPath, nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
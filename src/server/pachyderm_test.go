@@ -8322,6 +8322,62 @@ func TestListDatumDuringJob(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(dis))
 }
+
+func TestListDatumInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo1 := tu.UniqueString("TestListDatumInput_data1")
+	require.NoError(t, c.CreateRepo(dataRepo1))
+	dataRepo2 := tu.UniqueString("TestListDatumInput_data2")
+	require.NoError(t, c.CreateRepo(dataRepo2))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, c.PutFile(dataRepo1, "master", fmt.Sprintf("file-%d", i), strings.NewReader("foo")))
+		require.NoError(t, c.PutFile(dataRepo2, "master", fmt.Sprintf("file-%d", i), strings.NewReader("bar")))
+	}
+
+	input := client.NewCrossInput(
+		client.NewPFSInput(dataRepo1, "/*"),
+		client.NewPFSInput(dataRepo2, "/*"),
+	)
+	dis, total, err := c.ListDatumInputAll(input, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), total)
+	require.Equal(t, 9, len(dis))
+	for _, di := range dis {
+		require.Equal(t, 2, len(di.Data))
+		require.Equal(t, pps.DatumState_STARTING, di.State)
+	}
+
+	// The pages are in the order of the datums, the last page is partial, and
+	// each page has the total of all pages.
+	var paged []*pps.DatumInfo
+	for page := int64(0); page < 3; page++ {
+		pageDis, total, err := c.ListDatumInputAll(input, 4, page)
+		require.NoError(t, err)
+		require.Equal(t, int64(9), total)
+		paged = append(paged, pageDis...)
+	}
+	require.Equal(t, 9, len(paged))
+	for i, di := range paged {
+		require.Equal(t, dis[i].Datum.ID, di.Datum.ID)
+	}
+	dis, total, err = c.ListDatumInputAll(input, 4, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), total)
+	require.Equal(t, 0, len(dis))
+
+	// No job was created.
+	jobInfos, err := c.ListJob("", nil, nil, -1, true)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(jobInfos))
+}
+
 func TestPipelineWithDatumTimeoutControl(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
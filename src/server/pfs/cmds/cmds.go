@@ -9,11 +9,14 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	prompt "github.com/c-bata/go-prompt"
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
@@ -745,6 +748,8 @@ from commits with 'get file'.`,
 	var parallelism int
 	var overwrite bool
 	var compress bool
+	var split string
+	var targetFileDatums, targetFileBytes, headerRecords int64
 	putFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/to/file>]",
 		Short: "Put a file into the filesystem.",
@@ -787,12 +792,33 @@ $ {{alias}} repo@branch -i file
 # Put several files or URLs that are listed at URL.
 # NOTE this URL can reference local files, so it could cause you to put sensitive
 # files into your Pachyderm cluster.
-$ {{alias}} repo@branch -i http://host/path`,
+$ {{alias}} repo@branch -i http://host/path
+
+# Split a file of newline delimited records into the directory repo/branch/path,
+# with up to 100 records per file:
+$ {{alias}} repo@branch:/path -f file --split line --target-file-datums 100
+
+# Split a CSV file with a header row into the directory repo/branch/path, with
+# the header row at the beginning of every file:
+$ {{alias}} repo@branch:/path -f file.csv --split csv --header-records 1`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
 			file, err := cmdutil.ParseFile(args[0])
 			if err != nil {
 				return err
 			}
+			delimiter, err := parseDelimiter(split)
+			if err != nil {
+				return err
+			}
+			if delimiter == pfsclient.Delimiter_NONE && (targetFileDatums != 0 || targetFileBytes != 0 || headerRecords != 0) {
+				return errors.Errorf("--target-file-datums, --target-file-bytes and --header-records require --split")
+			}
+			sp := &splitOptions{
+				delimiter:        delimiter,
+				targetFileDatums: targetFileDatums,
+				targetFileBytes:  targetFileBytes,
+				headerRecords:    headerRecords,
+			}
 			opts := []client.Option{client.WithMaxConcurrentStreams(parallelism)}
 			if compress {
 				opts = append(opts, client.WithGZIPCompression())
@@ -865,19 +891,19 @@ $ {{alias}} repo@branch -i http://host/path`,
 						return errors.Errorf("must specify filename when reading data from stdin")
 					}
 					eg.Go(func() error {
-						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths("", source), source, recursive, overwrite, limiter)
+						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths("", source), source, recursive, overwrite, sp, limiter)
 					})
 				} else if len(sources) == 1 {
 					// We have a single source and the user has specified a path,
 					// we use the path and ignore source (in terms of naming the file).
 					eg.Go(func() error {
-						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, file.Path, source, recursive, overwrite, limiter)
+						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, file.Path, source, recursive, overwrite, sp, limiter)
 					})
 				} else {
 					// We have multiple sources and the user has specified a path,
 					// we use that path as a prefix for the filepaths.
 					eg.Go(func() error {
-						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths(file.Path, source), source, recursive, overwrite, limiter)
+						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths(file.Path, source), source, recursive, overwrite, sp, limiter)
 					})
 				}
 			}
@@ -890,6 +916,10 @@ $ {{alias}} repo@branch -i http://host/path`,
 	putFile.Flags().BoolVarP(&compress, "compress", "", false, "Compress data during upload. This parameter might help you upload your uncompressed data, such as CSV files, to Pachyderm faster. Use 'compress' with caution, because if your data is already compressed, this parameter might slow down the upload speed instead of increasing.")
 	putFile.Flags().IntVarP(&parallelism, "parallelism", "p", DefaultParallelism, "The maximum number of files that can be uploaded in parallel.")
 	putFile.Flags().BoolVarP(&overwrite, "overwrite", "o", false, "Overwrite the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
+	putFile.Flags().StringVar(&split, "split", "", "Split the input into records and write them to a directory of numbered files. Valid options are 'json', 'line', 'csv' and 'sql'.")
+	putFile.Flags().Int64Var(&targetFileDatums, "target-file-datums", 0, "The maximum number of records in each file written by --split. If neither this nor --target-file-bytes is set, each file contains a single record.")
+	putFile.Flags().Int64Var(&targetFileBytes, "target-file-bytes", 0, "The target size in bytes of each file written by --split. Files may exceed this by up to one record.")
+	putFile.Flags().Int64Var(&headerRecords, "header-records", 0, "The number of records at the beginning of the input that are written at the beginning of every file written by --split, such as the header row of a CSV file.")
 	shell.RegisterCompletionFunc(putFile,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
 			if flag == "-f" || flag == "--file" || flag == "-i" || flag == "input-file" {
@@ -934,6 +964,7 @@ $ {{alias}} repo@branch -i http://host/path`,
 	commands = append(commands, cmdutil.CreateAlias(copyFile, "copy file"))
 
 	var outputPath string
+	var offsetBytes, sizeBytes int64
 	getFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
 		Short: "Return the contents of a file.",
@@ -948,7 +979,10 @@ $ {{alias}} foo@master^:XXX
 
 # get file "XXX" in the grandparent of the current head of branch "master"
 # in repo "foo"
-$ {{alias}} foo@master^2:XXX`,
+$ {{alias}} foo@master^2:XXX
+
+# get the first 4KB of file "XXX" on branch "master" in repo "foo"
+$ {{alias}} foo@master:XXX --size 4096`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			file, err := cmdutil.ParseFile(args[0])
 			if err != nil {
@@ -971,27 +1005,46 @@ $ {{alias}} foo@master^2:XXX`,
 				defer f.Close()
 				w = f
 			}
-			return c.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, w)
+			return c.GetFileRange(file.Commit.Repo.Name, file.Commit.ID, file.Path, offsetBytes, sizeBytes, w)
 		}),
 	}
 	getFile.Flags().StringVarP(&outputPath, "output", "o", "", "The path where data will be downloaded.")
+	getFile.Flags().Int64Var(&offsetBytes, "offset", 0, "The number of bytes to skip at the beginning of the file.")
+	getFile.Flags().Int64Var(&sizeBytes, "size", 0, "The maximum number of bytes to return from the file, or 0 for the rest of the file.")
 	shell.RegisterCompletionFunc(getFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(getFile, "get file"))
 
+	var history string
 	inspectFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
 		Short: "Return info about a file.",
 		Long:  "Return info about a file.",
+		Example: `
+# inspect file "XXX" on branch "master" in repo "foo"
+$ {{alias}} foo@master:XXX
+
+# inspect all versions of file "XXX" on branch "master" in repo "foo"
+$ {{alias}} foo@master:XXX --history
+
+# inspect the last n versions of file "XXX" on branch "master" in repo "foo"
+$ {{alias}} foo@master:XXX --history n`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			file, err := cmdutil.ParseFile(args[0])
 			if err != nil {
 				return err
 			}
+			history, err := cmdutil.ParseHistory(history)
+			if err != nil {
+				return errors.Wrapf(err, "error parsing history flag")
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer c.Close()
+			if history != 0 {
+				return inspectFileHistory(c, file, history, raw, fullTimestamps, marshaller)
+			}
 			fileInfo, err := c.InspectFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
 			if err != nil {
 				return err
@@ -1006,10 +1059,12 @@ $ {{alias}} foo@master^2:XXX`,
 		}),
 	}
 	inspectFile.Flags().AddFlagSet(rawFlags)
+	inspectFile.Flags().AddFlagSet(fullTimestampsFlags)
+	inspectFile.Flags().StringVar(&history, "history", "none", "Return revision history for the file.")
+	inspectFile.Flags().Lookup("history").NoOptDefVal = "all"
 	shell.RegisterCompletionFunc(inspectFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectFile, "inspect file"))
 
-	var history string
 	listFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/in/pfs>]",
 		Short: "Return the files in a directory.",
@@ -1049,7 +1104,7 @@ $ {{alias}} foo@master --history all`,
 			}
 			defer c.Close()
 			if raw {
-				return c.ListFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, func(fi *pfsclient.FileInfo) error {
+				return c.ListFileF(file.Commit.Repo.Name, file.Commit.ID, file.Path, history, func(fi *pfsclient.FileInfo) error {
 					return marshaller.Marshal(os.Stdout, fi)
 				})
 			}
@@ -1058,7 +1113,7 @@ $ {{alias}} foo@master --history all`,
 				header = pretty.FileHeaderWithCommit
 			}
 			writer := tabwriter.NewWriter(os.Stdout, header)
-			if err := c.ListFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, func(fi *pfsclient.FileInfo) error {
+			if err := c.ListFileF(file.Commit.Repo.Name, file.Commit.ID, file.Path, history, func(fi *pfsclient.FileInfo) error {
 				pretty.PrintFileInfo(writer, fi, fullTimestamps, history != 0)
 				return nil
 			}); err != nil {
@@ -1291,18 +1346,22 @@ Tags are a low-level resource and should not be accessed directly by most users.
 	commands = append(commands, cmdutil.CreateAlias(getTag, "get tag"))
 
 	var fix bool
+	var storage, verifyContent bool
 	fsck := &cobra.Command{
 		Use:   "{{alias}}",
 		Short: "Run a file system consistency check on pfs.",
-		Long:  "Run a file system consistency check on the pachyderm file system, ensuring the correct provenance relationships are satisfied.",
+		Long:  "Run a file system consistency check on the pachyderm file system, ensuring the correct provenance relationships are satisfied. With --storage, also check that the data referenced by every commit exists in object storage; with --fix, files that reference missing or corrupted data are quarantined.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if verifyContent && !storage {
+				return errors.Errorf("--verify-content requires --storage")
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer c.Close()
 			errors := false
-			if err = c.Fsck(fix, func(resp *pfsclient.FsckResponse) error {
+			cb := func(resp *pfsclient.FsckResponse) error {
 				if resp.Error != "" {
 					errors = true
 					fmt.Printf("Error: %s\n", resp.Error)
@@ -1310,7 +1369,13 @@ Tags are a low-level resource and should not be accessed directly by most users.
 					fmt.Printf("Fix applied: %v", resp.Fix)
 				}
 				return nil
-			}); err != nil {
+			}
+			if storage {
+				err = c.FsckStorage(verifyContent, fix, cb)
+			} else {
+				err = c.Fsck(fix, cb)
+			}
+			if err != nil {
 				return err
 			}
 			if !errors {
@@ -1320,8 +1385,111 @@ Tags are a low-level resource and should not be accessed directly by most users.
 		}),
 	}
 	fsck.Flags().BoolVarP(&fix, "fix", "f", false, "Attempt to fix as many issues as possible.")
+	fsck.Flags().BoolVar(&storage, "storage", false, "Also check that the data referenced by every commit exists in object storage and is tracked.")
+	fsck.Flags().BoolVar(&verifyContent, "verify-content", false, "With --storage, also download the data and verify that it hashes correctly.")
 	commands = append(commands, cmdutil.CreateAlias(fsck, "fsck"))
 
+	var dryRun, jsonOutput bool
+	garbageCollect := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Delete the storage objects that are no longer referenced.",
+		Long:  "Delete the storage objects (chunks, file sets and temporary file sets) that are no longer referenced, and report the number of objects and bytes reclaimed. With --dry-run, nothing is deleted, and the report is of what would be reclaimed.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.GarbageCollect(dryRun)
+			if err != nil {
+				return err
+			}
+			if jsonOutput {
+				return marshaller.Marshal(os.Stdout, resp)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.GarbageCollectHeader)
+			pretty.PrintGarbageCollectResponse(writer, resp)
+			return writer.Flush()
+		}),
+	}
+	garbageCollect.Flags().BoolVar(&dryRun, "dry-run", false, "Report what would be reclaimed without deleting anything.")
+	garbageCollect.Flags().BoolVar(&jsonOutput, "json", false, "Print the report as json.")
+	commands = append(commands, cmdutil.CreateAlias(garbageCollect, "garbage-collect"))
+
+	rewrapDataKeys := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Re-wrap the data keys with the current master key.",
+		Long:  "Re-wrap the data keys that chunks are encrypted with, which are wrapped with one of the previous master keys, with the current master key. Once the data keys are re-wrapped, the previous master keys can be retired.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			n, err := c.RewrapDataKeys()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Re-wrapped %d data keys.\n", n)
+			return nil
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(rewrapDataKeys, "rewrap-data-keys"))
+
+	var gracePeriod time.Duration
+	var fixOrphans bool
+	reconcileStorage := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Find the chunks that are in object storage or the tracker, but not both.",
+		Long:  "Find the chunks that are in object storage, but not tracked, and the tracked chunks that are missing from object storage. Missing chunks are only reported once they have been tracked for longer than the grace period. With --fix, the untracked chunks are garbage collected once the grace period has passed, and the missing chunks that are no longer referenced are deleted from the tracker.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			found := false
+			if err := c.ReconcileStorage(gracePeriod, fixOrphans, func(resp *pfsclient.ReconcileStorageResponse) error {
+				found = true
+				fmt.Printf("%s: %s", strings.ToLower(strings.Replace(resp.Type.String(), "_", " ", -1)), resp.Chunk)
+				if resp.Fixed {
+					fmt.Print(" (fixed)")
+				}
+				fmt.Println()
+				return nil
+			}); err != nil {
+				return err
+			}
+			if !found {
+				fmt.Println("No orphaned chunks found.")
+			}
+			return nil
+		}),
+	}
+	reconcileStorage.Flags().DurationVar(&gracePeriod, "grace-period", time.Hour, "How long a chunk must have been tracked before it is reported as missing from object storage, and how long untracked chunks are kept after being fixed.")
+	reconcileStorage.Flags().BoolVarP(&fixOrphans, "fix", "f", false, "Fix the orphaned chunks.")
+	commands = append(commands, cmdutil.CreateAlias(reconcileStorage, "reconcile-storage"))
+
+	var v1StorageRoot string
+	migrateV1 := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Migrate the data written by Pachyderm 1.x to the current storage layer.",
+		Long:  "Migrate the data written by Pachyderm 1.x to the current storage layer. The hash trees of the finished commits written by 1.x are converted into file sets, keeping their commit IDs, provenance and branch heads, and the file sets are then verified against the 1.x data. The migration is resumable, commits that have already been migrated are skipped.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.MigrateV1(v1StorageRoot, func(resp *pfsclient.MigrateV1Response) error {
+				fmt.Printf("%s %s@%s (%d files, %s)\n", strings.ToLower(resp.State.String()), resp.Commit.Repo.Name, resp.Commit.ID, resp.Files, units.BytesSize(float64(resp.SizeBytes)))
+				return nil
+			})
+		}),
+	}
+	migrateV1.Flags().StringVar(&v1StorageRoot, "v1-storage-root", "", "The root that Pachyderm 1.x stored its data under, defaults to the storage root.")
+	commands = append(commands, cmdutil.CreateAlias(migrateV1, "migrate-v1"))
+
 	// Add the mount commands (which aren't available on Windows, so they're in
 	// their own file)
 	commands = append(commands, mountCmds()...)
@@ -1329,7 +1497,65 @@ Tags are a low-level resource and should not be accessed directly by most users.
 	return commands
 }
 
-func putFileHelper(c *client.APIClient, pfc client.PutFileClient, repo, commit, path, source string, recursive, overwrite bool, limiter limit.ConcurrencyLimiter) (retErr error) {
+// inspectFileHistory prints the versions of a single file. Versions are only
+// returned by ListFile, so the file's directory is listed and everything but
+// the file is filtered out.
+func inspectFileHistory(c *client.APIClient, file *pfsclient.File, history int64, raw, fullTimestamps bool, marshaller *jsonpb.Marshaler) error {
+	p := path.Clean("/" + file.Path)
+	if p == "/" {
+		return errors.Errorf("cannot inspect the history of the root directory")
+	}
+	var fileInfos []*pfsclient.FileInfo
+	if err := c.ListFileF(file.Commit.Repo.Name, file.Commit.ID, path.Dir(p), history, func(fi *pfsclient.FileInfo) error {
+		if path.Clean(fi.File.Path) == p {
+			fileInfos = append(fileInfos, fi)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(fileInfos) == 0 {
+		return errors.Errorf("file %s not found", file.Path)
+	}
+	if raw {
+		for _, fi := range fileInfos {
+			if err := marshaller.Marshal(os.Stdout, fi); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	writer := tabwriter.NewWriter(os.Stdout, pretty.FileHeaderWithCommit)
+	for _, fi := range fileInfos {
+		pretty.PrintFileInfo(writer, fi, fullTimestamps, true)
+	}
+	return writer.Flush()
+}
+
+// splitOptions are the options for splitting data with 'put file --split'.
+type splitOptions struct {
+	delimiter                                        pfsclient.Delimiter
+	targetFileDatums, targetFileBytes, headerRecords int64
+}
+
+func parseDelimiter(split string) (pfsclient.Delimiter, error) {
+	switch split {
+	case "":
+		return pfsclient.Delimiter_NONE, nil
+	case "json":
+		return pfsclient.Delimiter_JSON, nil
+	case "line":
+		return pfsclient.Delimiter_LINE, nil
+	case "csv":
+		return pfsclient.Delimiter_CSV, nil
+	case "sql":
+		return pfsclient.Delimiter_SQL, nil
+	default:
+		return pfsclient.Delimiter_NONE, errors.Errorf("unrecognized delimiter '%s'; only accept one of 'json', 'line', 'csv' or 'sql'", split)
+	}
+}
+
+func putFileHelper(c *client.APIClient, pfc client.PutFileClient, repo, commit, path, source string, recursive, overwrite bool, sp *splitOptions, limiter limit.ConcurrencyLimiter) (retErr error) {
 	// Resolve the path, then trim any prefixed '../' to avoid sending bad paths
 	// to the server, and convert to unix path in case we're on windows.
 	path = filepath.ToSlash(filepath.Clean(path))
@@ -1337,6 +1563,9 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient, repo, commit,
 		path = strings.TrimPrefix(path, "../")
 	}
 	putFile := func(r io.Reader) error {
+		if sp.delimiter != pfsclient.Delimiter_NONE {
+			return pfc.PutFileSplit(repo, commit, path, sp.delimiter, sp.targetFileDatums, sp.targetFileBytes, sp.headerRecords, overwrite, r)
+		}
 		if overwrite {
 			return pfc.PutFileOverwrite(repo, commit, path, r)
 		}
@@ -1356,6 +1585,9 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient, repo, commit,
 	if url, err := url.Parse(source); err == nil && url.Scheme != "" {
 		limiter.Acquire()
 		defer limiter.Release()
+		if sp.delimiter != pfsclient.Delimiter_NONE {
+			return pfc.PutFileURLSplit(repo, commit, path, url.String(), recursive, sp.delimiter, sp.targetFileDatums, sp.targetFileBytes, sp.headerRecords, overwrite)
+		}
 		return pfc.PutFileURL(repo, commit, path, url.String(), recursive, overwrite)
 	}
 	if recursive {
@@ -1373,7 +1605,7 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient, repo, commit,
 				// don't do a second recursive 'put file', just put the one file at
 				// filePath into childDest, and then this walk loop will go on to the
 				// next one
-				return putFileHelper(c, pfc, repo, commit, childDest, filePath, false, overwrite, limiter)
+				return putFileHelper(c, pfc, repo, commit, childDest, filePath, false, overwrite, sp, limiter)
 			})
 			return nil
 		}); err != nil {
@@ -28,6 +28,8 @@ const (
 	FileHeaderWithCommit = "COMMIT\tNAME\tTYPE\tCOMMITTED\tSIZE\t\n"
 	// DiffFileHeader is the header for files produced by diff file.
 	DiffFileHeader = "OP\t" + FileHeader
+	// GarbageCollectHeader is the header for garbage collection reports.
+	GarbageCollectHeader = "TYPE\tOBJECTS\tSIZE\t\n"
 )
 
 // PrintRepoInfo pretty-prints repo info.
@@ -253,6 +255,19 @@ Size: {{prettySize .SizeBytes}}
 	return template.Execute(os.Stdout, fileInfo)
 }
 
+// PrintGarbageCollectResponse pretty-prints a garbage collection report.
+func PrintGarbageCollectResponse(w io.Writer, resp *pfs.GarbageCollectResponse) {
+	printStats := func(name string, stats *pfs.GarbageCollectStats) {
+		fmt.Fprintf(w, "%s\t", name)
+		fmt.Fprintf(w, "%d\t", stats.GetObjects())
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(stats.GetBytes())))
+		fmt.Fprintln(w)
+	}
+	printStats("chunks", resp.Chunks)
+	printStats("file sets", resp.FileSets)
+	printStats("temporary file sets", resp.TmpFileSets)
+}
+
 func fileType(fileType pfs.FileType) string {
 	if fileType == pfs.FileType_FILE {
 		return "file"
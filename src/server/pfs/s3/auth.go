@@ -0,0 +1,301 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signV4Algorithm = "AWS4-HMAC-SHA256"
+	iso8601Format   = "20060102T150405Z"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	// maxClockSkew is how far the time a request was signed at can be from
+	// the server's time, which limits how long a signed request can be
+	// replayed.
+	maxClockSkew = 15 * time.Minute
+)
+
+// authenticate checks the request's signature and returns the access key it
+// was signed with, which is the pachyderm auth token for the request. As with
+// the rest of pachyderm's S3 APIs, the secret key must be the same as the
+// access key. An empty access key is returned for anonymous requests.
+// Request payloads are not verified.
+func authenticate(r *http.Request) (string, error) {
+	query := r.URL.Query()
+	authHeader := r.Header.Get("Authorization")
+	switch {
+	case strings.HasPrefix(authHeader, signV4Algorithm+" "):
+		return authenticateV4(r, strings.TrimPrefix(authHeader, signV4Algorithm+" "))
+	case strings.HasPrefix(authHeader, "AWS "):
+		return authenticateV2(r, strings.TrimPrefix(authHeader, "AWS "))
+	case authHeader != "":
+		return "", invalidArgumentError("unsupported authorization type")
+	case query.Get("X-Amz-Algorithm") != "":
+		return authenticatePresignedV4(r, query)
+	case query.Get("AWSAccessKeyId") != "":
+		return authenticatePresignedV2(r, query)
+	}
+	return "", nil
+}
+
+func authenticateV4(r *http.Request, authHeader string) (string, error) {
+	fields := make(map[string]string)
+	for _, field := range strings.Split(authHeader, ",") {
+		kv := strings.SplitN(strings.TrimSpace(field), "=", 2)
+		if len(kv) != 2 {
+			return "", invalidArgumentError("malformed authorization header")
+		}
+		fields[kv[0]] = kv[1]
+	}
+	accessKey, scope, err := parseCredential(fields["Credential"])
+	if err != nil {
+		return "", err
+	}
+	date := r.Header.Get("X-Amz-Date")
+	signedAt, err := time.Parse(iso8601Format, date)
+	if date == "" {
+		date = r.Header.Get("Date")
+		signedAt, err = http.ParseTime(date)
+	}
+	if err != nil {
+		return "", invalidArgumentError("invalid X-Amz-Date")
+	}
+	if skew := time.Since(signedAt); skew > maxClockSkew || skew < -maxClockSkew {
+		return "", requestTimeTooSkewedError()
+	}
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	if payloadHash == "" {
+		payloadHash = unsignedPayload
+	}
+	signedHeaders := strings.Split(fields["SignedHeaders"], ";")
+	canonicalRequest := canonicalRequestV4(r, r.URL.Query(), signedHeaders, payloadHash)
+	signature := signV4(accessKey, date, scope, canonicalRequest)
+	if !signaturesEqual(signature, fields["Signature"]) {
+		return "", signatureDoesNotMatchError()
+	}
+	return accessKey, nil
+}
+
+func authenticatePresignedV4(r *http.Request, query url.Values) (string, error) {
+	if query.Get("X-Amz-Algorithm") != signV4Algorithm {
+		return "", invalidArgumentError("unsupported signature algorithm")
+	}
+	accessKey, scope, err := parseCredential(query.Get("X-Amz-Credential"))
+	if err != nil {
+		return "", err
+	}
+	date := query.Get("X-Amz-Date")
+	signedAt, err := time.Parse(iso8601Format, date)
+	if err != nil {
+		return "", invalidArgumentError("invalid X-Amz-Date")
+	}
+	expires, err := strconv.ParseInt(query.Get("X-Amz-Expires"), 10, 64)
+	if err != nil {
+		return "", invalidArgumentError("invalid X-Amz-Expires")
+	}
+	if time.Now().After(signedAt.Add(time.Duration(expires) * time.Second)) {
+		return "", accessDeniedError()
+	}
+	signature := query.Get("X-Amz-Signature")
+	query.Del("X-Amz-Signature")
+	signedHeaders := strings.Split(query.Get("X-Amz-SignedHeaders"), ";")
+	canonicalRequest := canonicalRequestV4(r, query, signedHeaders, unsignedPayload)
+	if !signaturesEqual(signV4(accessKey, date, scope, canonicalRequest), signature) {
+		return "", signatureDoesNotMatchError()
+	}
+	return accessKey, nil
+}
+
+// parseCredential splits a V4 credential into the access key and the scope
+// ("<date>/<region>/<service>/aws4_request").
+func parseCredential(credential string) (string, string, error) {
+	parts := strings.SplitN(credential, "/", 2)
+	if len(parts) != 2 || len(strings.Split(parts[1], "/")) != 4 {
+		return "", "", invalidArgumentError("malformed credential")
+	}
+	return parts[0], parts[1], nil
+}
+
+func canonicalRequestV4(r *http.Request, query url.Values, signedHeaders []string, payloadHash string) string {
+	var headers []string
+	for _, name := range signedHeaders {
+		var values []string
+		switch name {
+		case "host":
+			values = []string{r.Host}
+		case "content-length":
+			values = []string{strconv.FormatInt(r.ContentLength, 10)}
+		default:
+			values = r.Header[http.CanonicalHeaderKey(name)]
+		}
+		for i, value := range values {
+			values[i] = strings.Join(strings.Fields(value), " ")
+		}
+		headers = append(headers, name+":"+strings.Join(values, ","))
+	}
+	return strings.Join([]string{
+		r.Method,
+		encodePath(r.URL.Path),
+		canonicalQuery(query),
+		strings.Join(headers, "\n") + "\n",
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+}
+
+func signV4(secretKey, date, scope, canonicalRequest string) string {
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		signV4Algorithm,
+		date,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+	key := []byte("AWS4" + secretKey)
+	for _, part := range strings.Split(scope, "/") {
+		key = hmacSHA256(key, part)
+	}
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func authenticateV2(r *http.Request, authHeader string) (string, error) {
+	i := strings.LastIndex(authHeader, ":")
+	if i < 0 {
+		return "", invalidArgumentError("malformed authorization header")
+	}
+	accessKey, signature := authHeader[:i], authHeader[i+1:]
+	date := r.Header.Get("Date")
+	if r.Header.Get("X-Amz-Date") != "" {
+		date = ""
+	}
+	if !signaturesEqual(signV2(accessKey, r, date), signature) {
+		return "", signatureDoesNotMatchError()
+	}
+	return accessKey, nil
+}
+
+func authenticatePresignedV2(r *http.Request, query url.Values) (string, error) {
+	expires, err := strconv.ParseInt(query.Get("Expires"), 10, 64)
+	if err != nil {
+		return "", invalidArgumentError("invalid Expires")
+	}
+	if time.Now().Unix() > expires {
+		return "", accessDeniedError()
+	}
+	accessKey := query.Get("AWSAccessKeyId")
+	if !signaturesEqual(signV2(accessKey, r, query.Get("Expires")), query.Get("Signature")) {
+		return "", signatureDoesNotMatchError()
+	}
+	return accessKey, nil
+}
+
+// v2SubResources are the query parameters included in V2 signatures.
+var v2SubResources = map[string]bool{
+	"acl": true, "delete": true, "lifecycle": true, "location": true,
+	"logging": true, "notification": true, "partNumber": true, "policy": true,
+	"requestPayment": true, "torrent": true, "uploadId": true, "uploads": true,
+	"versionId": true, "versioning": true, "versions": true, "website": true,
+	"response-cache-control": true, "response-content-disposition": true,
+	"response-content-encoding": true, "response-content-language": true,
+	"response-content-type": true, "response-expires": true,
+}
+
+func signV2(secretKey string, r *http.Request, date string) string {
+	var amzHeaders []string
+	for name, values := range r.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-amz-") {
+			amzHeaders = append(amzHeaders, name+":"+strings.Join(values, ","))
+		}
+	}
+	sort.Strings(amzHeaders)
+	var subResources []string
+	for name, values := range r.URL.Query() {
+		if !v2SubResources[name] {
+			continue
+		}
+		if len(values) == 0 || values[0] == "" {
+			subResources = append(subResources, name)
+		} else {
+			subResources = append(subResources, name+"="+values[0])
+		}
+	}
+	sort.Strings(subResources)
+	resource := encodePath(r.URL.Path)
+	if len(subResources) > 0 {
+		resource += "?" + strings.Join(subResources, "&")
+	}
+	var stringToSign strings.Builder
+	stringToSign.WriteString(r.Method + "\n")
+	stringToSign.WriteString(r.Header.Get("Content-Md5") + "\n")
+	stringToSign.WriteString(r.Header.Get("Content-Type") + "\n")
+	stringToSign.WriteString(date + "\n")
+	for _, header := range amzHeaders {
+		stringToSign.WriteString(header + "\n")
+	}
+	stringToSign.WriteString(resource)
+	h := hmac.New(sha1.New, []byte(secretKey))
+	h.Write([]byte(stringToSign.String()))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func signaturesEqual(expected, actual string) bool {
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) == 1
+}
+
+// encodePath URI encodes a path in the form used for signatures, which
+// encodes every byte other than the unreserved characters and '/'.
+func encodePath(p string) string {
+	return encode(p, false)
+}
+
+func canonicalQuery(query url.Values) string {
+	var params [][2]string
+	for name, values := range query {
+		for _, value := range values {
+			params = append(params, [2]string{encode(name, true), encode(value, true)})
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i][0] != params[j][0] {
+			return params[i][0] < params[j][0]
+		}
+		return params[i][1] < params[j][1]
+	})
+	var encoded []string
+	for _, param := range params {
+		encoded = append(encoded, param[0]+"="+param[1])
+	}
+	return strings.Join(encoded, "&")
+}
+
+func encode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			b.WriteString("%" + strings.ToUpper(hex.EncodeToString([]byte{c})))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,104 @@
+package s3
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v6/pkg/signer"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+const testAccessKey = "token"
+
+func newTestRequest(t *testing.T, method, url string, body []byte) *http.Request {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	return req
+}
+
+func TestAuthenticateAnonymous(t *testing.T) {
+	req := newTestRequest(t, "GET", "http://localhost:30600/master.repo/file", nil)
+	accessKey, err := authenticate(req)
+	require.NoError(t, err)
+	require.Equal(t, "", accessKey)
+}
+
+func TestAuthenticateV4(t *testing.T) {
+	req := newTestRequest(t, "GET", "http://localhost:30600/master.repo/dir/file%20name?list-type=2&prefix=a%2Fb&delimiter=%2F", nil)
+	req = signer.SignV4(*req, testAccessKey, testAccessKey, "", "us-east-1")
+	accessKey, err := authenticate(req)
+	require.NoError(t, err)
+	require.Equal(t, testAccessKey, accessKey)
+
+	// A signature made with a different secret key is rejected.
+	req = newTestRequest(t, "GET", "http://localhost:30600/master.repo/file", nil)
+	req = signer.SignV4(*req, testAccessKey, "wrong", "", "us-east-1")
+	_, err = authenticate(req)
+	require.YesError(t, err)
+	require.Equal(t, "SignatureDoesNotMatch", toS3Error(err).Code)
+
+	// A request signed too long ago is rejected, so that it can't be
+	// replayed.
+	req = newTestRequest(t, "GET", "http://localhost:30600/master.repo/file", nil)
+	req = signer.SignV4(*req, testAccessKey, testAccessKey, "", "us-east-1")
+	req.Header.Set("X-Amz-Date", time.Now().Add(-time.Hour).UTC().Format(iso8601Format))
+	_, err = authenticate(req)
+	require.YesError(t, err)
+	require.Equal(t, "RequestTimeTooSkewed", toS3Error(err).Code)
+}
+
+func TestAuthenticateV2(t *testing.T) {
+	req := newTestRequest(t, "PUT", "http://localhost:30600/master.repo/file?uploadId=abc&partNumber=1", []byte("data"))
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("X-Amz-Meta-Foo", "bar")
+	req = signer.SignV2(*req, testAccessKey, testAccessKey, false)
+	accessKey, err := authenticate(req)
+	require.NoError(t, err)
+	require.Equal(t, testAccessKey, accessKey)
+
+	req = newTestRequest(t, "GET", "http://localhost:30600/master.repo/file", nil)
+	req = signer.SignV2(*req, testAccessKey, "wrong", false)
+	_, err = authenticate(req)
+	require.YesError(t, err)
+}
+
+func TestAuthenticatePresigned(t *testing.T) {
+	req := newTestRequest(t, "GET", "http://localhost:30600/master.repo/file", nil)
+	req = signer.PreSignV4(*req, testAccessKey, testAccessKey, "", "us-east-1", 60)
+	accessKey, err := authenticate(req)
+	require.NoError(t, err)
+	require.Equal(t, testAccessKey, accessKey)
+
+	req = newTestRequest(t, "GET", "http://localhost:30600/master.repo/file", nil)
+	req = signer.PreSignV2(*req, testAccessKey, testAccessKey, 60, false)
+	accessKey, err = authenticate(req)
+	require.NoError(t, err)
+	require.Equal(t, testAccessKey, accessKey)
+
+	// Expired URLs are rejected.
+	req = newTestRequest(t, "GET", "http://localhost:30600/master.repo/file", nil)
+	req = signer.PreSignV2(*req, testAccessKey, testAccessKey, -60, false)
+	_, err = authenticate(req)
+	require.YesError(t, err)
+	require.Equal(t, "AccessDenied", toS3Error(err).Code)
+}
+
+func TestChunkedReader(t *testing.T) {
+	data := bytes.Repeat([]byte("pachyderm"), 100*1024)
+	req := newTestRequest(t, "PUT", "http://localhost:30600/master.repo/file", data)
+	req = signer.StreamingSignV4(req, testAccessKey, testAccessKey, "", "us-east-1", int64(len(data)), time.Now().UTC())
+	accessKey, err := authenticate(req)
+	require.NoError(t, err)
+	require.Equal(t, testAccessKey, accessKey)
+	actual, err := ioutil.ReadAll(requestBody(req))
+	require.NoError(t, err)
+	require.Equal(t, data, actual)
+
+	// Truncated bodies are an error.
+	_, err = ioutil.ReadAll(newChunkedReader(bytes.NewReader([]byte("10;chunk-signature=abc\r\nshort"))))
+	require.YesError(t, err)
+}
@@ -0,0 +1,359 @@
+package s3
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+const (
+	defaultMaxKeys = 1000
+	// globalLocation is the location reported for every bucket.
+	globalLocation = "PACHYDERM"
+)
+
+// owner is the owner reported for buckets and objects, since PFS does not
+// track this.
+var owner = Owner{ID: "00000000000000000000000000000000", DisplayName: "pachyderm"}
+
+// Owner is the owner of a bucket or object.
+type Owner struct {
+	ID          string `xml:"ID"`
+	DisplayName string `xml:"DisplayName"`
+}
+
+// ListAllMyBucketsResult is the response to ListBuckets.
+type ListAllMyBucketsResult struct {
+	XMLName xml.Name      `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListAllMyBucketsResult"`
+	Owner   Owner         `xml:"Owner"`
+	Buckets []BucketEntry `xml:"Buckets>Bucket"`
+}
+
+// BucketEntry is a bucket in a ListAllMyBucketsResult.
+type BucketEntry struct {
+	Name         string    `xml:"Name"`
+	CreationDate time.Time `xml:"CreationDate"`
+}
+
+// LocationConstraint is the response to GetBucketLocation.
+type LocationConstraint struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LocationConstraint"`
+	Location string   `xml:",chardata"`
+}
+
+// VersioningConfiguration is the response to GetBucketVersioning.
+type VersioningConfiguration struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ VersioningConfiguration"`
+	Status  string   `xml:"Status"`
+}
+
+// ListBucketResult is the response to ListObjects and ListObjectsV2.
+type ListBucketResult struct {
+	XMLName               xml.Name       `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Name                  string         `xml:"Name"`
+	Prefix                string         `xml:"Prefix"`
+	Marker                *string        `xml:"Marker,omitempty"`
+	NextMarker            string         `xml:"NextMarker,omitempty"`
+	StartAfter            string         `xml:"StartAfter,omitempty"`
+	ContinuationToken     string         `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string         `xml:"NextContinuationToken,omitempty"`
+	KeyCount              *int           `xml:"KeyCount,omitempty"`
+	MaxKeys               int            `xml:"MaxKeys"`
+	Delimiter             string         `xml:"Delimiter,omitempty"`
+	EncodingType          string         `xml:"EncodingType,omitempty"`
+	IsTruncated           bool           `xml:"IsTruncated"`
+	Contents              []Contents     `xml:"Contents"`
+	CommonPrefixes        []CommonPrefix `xml:"CommonPrefixes"`
+}
+
+// Contents is an object in a ListBucketResult.
+type Contents struct {
+	Key          string    `xml:"Key"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+	Size         uint64    `xml:"Size"`
+	StorageClass string    `xml:"StorageClass"`
+	Owner        *Owner    `xml:"Owner,omitempty"`
+}
+
+// CommonPrefix is a group of objects in a ListBucketResult.
+type CommonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+func (c *controller) listBuckets(pc *client.APIClient, w http.ResponseWriter) error {
+	buckets, err := c.driver.listBuckets(pc)
+	if err != nil {
+		return err
+	}
+	result := &ListAllMyBucketsResult{Owner: owner}
+	for _, bucket := range buckets {
+		result.Buckets = append(result.Buckets, BucketEntry{
+			Name:         bucket.Name,
+			CreationDate: timestamp(bucket.Created),
+		})
+	}
+	writeXML(w, http.StatusOK, result)
+	return nil
+}
+
+func (c *controller) getBucketLocation(pc *client.APIClient, w http.ResponseWriter, bucketName string) error {
+	if _, _, err := c.bucketAndCapabilities(pc, bucketName); err != nil {
+		return err
+	}
+	writeXML(w, http.StatusOK, &LocationConstraint{Location: globalLocation})
+	return nil
+}
+
+// getBucketVersioning reports that versioning is enabled, since every commit
+// of a branch is a version of its objects.
+func (c *controller) getBucketVersioning(pc *client.APIClient, w http.ResponseWriter, bucketName string) error {
+	if _, _, err := c.bucketAndCapabilities(pc, bucketName); err != nil {
+		return err
+	}
+	writeXML(w, http.StatusOK, &VersioningConfiguration{Status: "Enabled"})
+	return nil
+}
+
+func (c *controller) createBucket(pc *client.APIClient, w http.ResponseWriter, bucketName string) error {
+	if !c.driver.canModifyBuckets() {
+		return methodNotAllowedError()
+	}
+	bucket, err := c.driver.bucket(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	if err := pc.CreateRepo(bucket.Repo); err != nil && !errutil.IsAlreadyExistError(err) {
+		return err
+	}
+	if _, err := pc.InspectBranch(bucket.Repo, bucket.Commit); err == nil {
+		return bucketAlreadyOwnedByYouError()
+	} else if !pfsserver.IsBranchNotFoundErr(err) {
+		return err
+	}
+	if err := pc.CreateBranch(bucket.Repo, bucket.Commit, "", nil); err != nil {
+		return err
+	}
+	w.Header().Set("Location", "/"+bucketName)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// deleteBucket deletes the bucket's branch, and deletes the repo as well if
+// it has no other branches.
+func (c *controller) deleteBucket(pc *client.APIClient, w http.ResponseWriter, bucketName string) error {
+	if !c.driver.canModifyBuckets() {
+		return methodNotAllowedError()
+	}
+	bucket, _, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	if err := pc.DeleteBranch(bucket.Repo, bucket.Commit, false); err != nil {
+		return err
+	}
+	branchInfos, err := pc.ListBranch(bucket.Repo)
+	if err != nil {
+		return err
+	}
+	if len(branchInfos) == 0 {
+		if err := pc.DeleteRepo(bucket.Repo, false); err != nil {
+			return err
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (c *controller) listObjects(pc *client.APIClient, w http.ResponseWriter, r *http.Request, bucketName string) error {
+	bucket, capabilities, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	if !capabilities.readable {
+		return accessDeniedError()
+	}
+	query := r.URL.Query()
+	v2 := query.Get("list-type") == "2"
+	maxKeys := defaultMaxKeys
+	if s := query.Get("max-keys"); s != "" {
+		maxKeys, err = strconv.Atoi(s)
+		if err != nil || maxKeys < 0 {
+			return invalidArgumentError("invalid max-keys")
+		}
+		if maxKeys > defaultMaxKeys {
+			maxKeys = defaultMaxKeys
+		}
+	}
+	encodingType := query.Get("encoding-type")
+	if encodingType != "" && encodingType != "url" {
+		return invalidArgumentError("invalid encoding-type")
+	}
+	result := &ListBucketResult{
+		Name:         bucketName,
+		Prefix:       query.Get("prefix"),
+		MaxKeys:      maxKeys,
+		Delimiter:    query.Get("delimiter"),
+		EncodingType: encodingType,
+	}
+	// Entries are listed after the marker, which is the last key or common
+	// prefix that was returned by the previous page.
+	var marker string
+	if v2 {
+		result.StartAfter = query.Get("start-after")
+		result.ContinuationToken = query.Get("continuation-token")
+		marker = result.StartAfter
+		if result.ContinuationToken != "" {
+			marker, err = decodeContinuationToken(result.ContinuationToken)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		marker = query.Get("marker")
+		result.Marker = &marker
+	}
+	commit, err := readCommit(pc, bucket)
+	if err != nil {
+		return err
+	}
+	if commit != "" && maxKeys > 0 {
+		commitInfo, err := pc.InspectCommit(bucket.Repo, commit)
+		if err != nil {
+			return err
+		}
+		lastModified := timestamp(commitInfo.Finished)
+		var last string
+		if err := listEntries(pc, bucket.Repo, commit, result.Prefix, result.Delimiter, marker, func(key string, fi *pfs.FileInfo) error {
+			if len(result.Contents)+len(result.CommonPrefixes) == maxKeys {
+				result.IsTruncated = true
+				return errutil.ErrBreak
+			}
+			if fi == nil {
+				result.CommonPrefixes = append(result.CommonPrefixes, CommonPrefix{Prefix: key})
+			} else {
+				result.Contents = append(result.Contents, Contents{
+					Key:          key,
+					LastModified: lastModified,
+					ETag:         etag(fi),
+					Size:         fi.SizeBytes,
+					StorageClass: "STANDARD",
+					Owner:        &owner,
+				})
+			}
+			last = key
+			return nil
+		}); err != nil {
+			return err
+		}
+		if result.IsTruncated {
+			if v2 {
+				result.NextContinuationToken = encodeContinuationToken(last)
+			} else if result.Delimiter != "" {
+				result.NextMarker = last
+			}
+		}
+	}
+	if v2 {
+		keyCount := len(result.Contents) + len(result.CommonPrefixes)
+		result.KeyCount = &keyCount
+	}
+	if encodingType == "url" {
+		result.encodeKeys()
+	}
+	writeXML(w, http.StatusOK, result)
+	return nil
+}
+
+// listEntries calls cb with each object key (and its file info) or common
+// prefix (with a nil file info) after marker, in lexicographic order. The
+// directory that contains prefix is walked in a single WalkFile call, and
+// keys which share a common prefix are collapsed into it.
+func listEntries(pc *client.APIClient, repo, commit, prefix, delimiter, marker string, cb func(string, *pfs.FileInfo) error) error {
+	var lastPrefix string
+	if err := pc.WalkFile(repo, commit, path.Dir("/"+prefix), func(fi *pfs.FileInfo) error {
+		key := strings.TrimPrefix(fi.File.Path, "/")
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		if delimiter != "" {
+			// With a '/' delimiter, directories (whose paths end in '/') are
+			// common prefixes.
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				commonPrefix := key[:len(prefix)+i+len(delimiter)]
+				if commonPrefix == lastPrefix || commonPrefix <= marker {
+					return nil
+				}
+				lastPrefix = commonPrefix
+				return cb(commonPrefix, nil)
+			}
+		}
+		// Directories are not objects.
+		if fi.FileType == pfs.FileType_DIR || key <= marker {
+			return nil
+		}
+		return cb(key, fi)
+	}); err != nil {
+		if pfsserver.IsFileNotFoundErr(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// encodeKeys URL encodes the keys in the result, for clients which request
+// encoding-type=url.
+func (result *ListBucketResult) encodeKeys() {
+	result.Prefix = url.QueryEscape(result.Prefix)
+	result.Delimiter = url.QueryEscape(result.Delimiter)
+	result.StartAfter = url.QueryEscape(result.StartAfter)
+	result.NextMarker = url.QueryEscape(result.NextMarker)
+	if result.Marker != nil {
+		marker := url.QueryEscape(*result.Marker)
+		result.Marker = &marker
+	}
+	for i := range result.Contents {
+		result.Contents[i].Key = url.QueryEscape(result.Contents[i].Key)
+	}
+	for i := range result.CommonPrefixes {
+		result.CommonPrefixes[i].Prefix = url.QueryEscape(result.CommonPrefixes[i].Prefix)
+	}
+}
+
+// Continuation tokens are the last key listed, encoded so that clients do not
+// depend on their contents.
+func encodeContinuationToken(key string) string {
+	return base64.URLEncoding.EncodeToString([]byte(key))
+}
+
+func decodeContinuationToken(token string) (string, error) {
+	key, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return "", invalidArgumentError("invalid continuation-token")
+	}
+	return string(key), nil
+}
+
+func timestamp(ts *types.Timestamp) time.Time {
+	if ts == nil {
+		return time.Unix(0, 0).UTC()
+	}
+	t, err := types.TimestampFromProto(ts)
+	if err != nil {
+		return time.Unix(0, 0).UTC()
+	}
+	return t.UTC()
+}
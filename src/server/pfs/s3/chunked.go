@@ -0,0 +1,100 @@
+package s3
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+const streamingPayloadPrefix = "STREAMING-"
+
+// chunkedReader decodes a request body sent with the aws-chunked content
+// encoding, which is used by clients that sign each chunk of the payload.
+// Each chunk is formatted as:
+//
+//	<hex size>;chunk-signature=<signature>\r\n<data>\r\n
+//
+// and the body ends with a chunk of size 0. Chunk signatures are not
+// verified.
+type chunkedReader struct {
+	r         *bufio.Reader
+	remaining int64
+	done      bool
+}
+
+func newChunkedReader(r io.Reader) *chunkedReader {
+	return &chunkedReader{r: bufio.NewReader(r)}
+}
+
+func (cr *chunkedReader) Read(data []byte) (int, error) {
+	if cr.done {
+		return 0, io.EOF
+	}
+	if cr.remaining == 0 {
+		if err := cr.nextChunk(); err != nil {
+			return 0, err
+		}
+		if cr.done {
+			return 0, io.EOF
+		}
+	}
+	if int64(len(data)) > cr.remaining {
+		data = data[:cr.remaining]
+	}
+	n, err := cr.r.Read(data)
+	cr.remaining -= int64(n)
+	if cr.remaining == 0 && err == nil {
+		err = cr.readCRLF()
+	}
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (cr *chunkedReader) nextChunk() error {
+	line, err := cr.r.ReadString('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+	if i := strings.Index(line, ";"); i >= 0 {
+		line = line[:i]
+	}
+	size, err := strconv.ParseInt(line, 16, 64)
+	if err != nil || size < 0 {
+		return invalidArgumentError("malformed aws-chunked encoding")
+	}
+	if size == 0 {
+		cr.done = true
+		return nil
+	}
+	cr.remaining = size
+	return nil
+}
+
+func (cr *chunkedReader) readCRLF() error {
+	crlf := make([]byte, 2)
+	if _, err := io.ReadFull(cr.r, crlf); err != nil {
+		return err
+	}
+	if string(crlf) != "\r\n" {
+		return invalidArgumentError("malformed aws-chunked encoding")
+	}
+	return nil
+}
+
+// requestBody returns the decoded payload of the request.
+func requestBody(r *http.Request) io.Reader {
+	if strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), streamingPayloadPrefix) {
+		return newChunkedReader(r.Body)
+	}
+	return r.Body
+}
@@ -0,0 +1,196 @@
+package s3
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+)
+
+// Bucket is a PFS commit (or the head of a PFS branch) exposed as an S3
+// bucket.
+type Bucket struct {
+	// Name is the name of the bucket in the S3 API.
+	Name string
+	// Repo is the PFS repo the bucket reads from and writes to.
+	Repo string
+	// Commit is either a branch name or a commit ID. Buckets backed by a
+	// commit ID can only be written to while the commit is open.
+	Commit string
+	// Created is when the bucket was created, if known.
+	Created *types.Timestamp
+}
+
+type bucketCapabilities struct {
+	readable bool
+	writable bool
+}
+
+// Driver maps S3 bucket names to PFS repos and commits.
+type Driver interface {
+	// listBuckets lists all of the buckets that can be accessed by the
+	// client.
+	listBuckets(pc *client.APIClient) ([]*Bucket, error)
+	// bucket resolves the name of a bucket. It does not check that the
+	// bucket exists.
+	bucket(pc *client.APIClient, name string) (*Bucket, error)
+	// bucketCapabilities checks that the bucket exists and returns the
+	// operations that can be performed on it.
+	bucketCapabilities(pc *client.APIClient, bucket *Bucket) (bucketCapabilities, error)
+	// canModifyBuckets returns whether buckets can be created and deleted.
+	canModifyBuckets() bool
+}
+
+// MasterDriver is the driver for the s3 gateway running in pachd. It exposes
+// every branch of every repo as a bucket named "<branch>.<repo>".
+type MasterDriver struct{}
+
+// NewMasterDriver constructs a new MasterDriver.
+func NewMasterDriver() *MasterDriver {
+	return &MasterDriver{}
+}
+
+func (d *MasterDriver) listBuckets(pc *client.APIClient) ([]*Bucket, error) {
+	repoInfos, err := pc.ListRepo()
+	if err != nil {
+		return nil, err
+	}
+	var buckets []*Bucket
+	for _, repoInfo := range repoInfos {
+		for _, branch := range repoInfo.Branches {
+			if branch.Name == multipartBranch {
+				continue
+			}
+			buckets = append(buckets, &Bucket{
+				Name:    branch.Name + "." + repoInfo.Repo.Name,
+				Repo:    repoInfo.Repo.Name,
+				Commit:  branch.Name,
+				Created: repoInfo.Created,
+			})
+		}
+	}
+	return buckets, nil
+}
+
+func (d *MasterDriver) bucket(pc *client.APIClient, name string) (*Bucket, error) {
+	// Repo names cannot contain '.', so everything before the last '.' is the
+	// branch name.
+	i := strings.LastIndex(name, ".")
+	if i <= 0 || i == len(name)-1 {
+		return nil, invalidBucketNameError()
+	}
+	return &Bucket{
+		Name:   name,
+		Repo:   name[i+1:],
+		Commit: name[:i],
+	}, nil
+}
+
+func (d *MasterDriver) bucketCapabilities(pc *client.APIClient, bucket *Bucket) (bucketCapabilities, error) {
+	if bucket.Commit == multipartBranch {
+		return bucketCapabilities{}, noSuchBucketError()
+	}
+	if _, err := pc.InspectBranch(bucket.Repo, bucket.Commit); err != nil {
+		if pfsserver.IsRepoNotFoundErr(err) || pfsserver.IsBranchNotFoundErr(err) {
+			return bucketCapabilities{}, noSuchBucketError()
+		}
+		return bucketCapabilities{}, err
+	}
+	return bucketCapabilities{
+		readable: true,
+		writable: true,
+	}, nil
+}
+
+func (d *MasterDriver) canModifyBuckets() bool {
+	return true
+}
+
+// WorkerDriver is the driver for the s3 gateway running in a worker's
+// sidecar. It exposes a fixed set of buckets for a single job: a read-only
+// bucket for each of the job's s3 inputs, and optionally a write-only bucket
+// for the job's output commit.
+type WorkerDriver struct {
+	inputBuckets []*Bucket
+	outputBucket *Bucket
+	namesMap     map[string]*Bucket
+}
+
+// NewWorkerDriver constructs a new WorkerDriver. outputBucket may be nil if
+// the job's pipeline does not write its output through the s3 gateway.
+func NewWorkerDriver(inputBuckets []*Bucket, outputBucket *Bucket) *WorkerDriver {
+	namesMap := make(map[string]*Bucket)
+	for _, bucket := range inputBuckets {
+		namesMap[bucket.Name] = bucket
+	}
+	if outputBucket != nil {
+		namesMap[outputBucket.Name] = outputBucket
+	}
+	return &WorkerDriver{
+		inputBuckets: inputBuckets,
+		outputBucket: outputBucket,
+		namesMap:     namesMap,
+	}
+}
+
+func (d *WorkerDriver) listBuckets(pc *client.APIClient) ([]*Bucket, error) {
+	buckets := append([]*Bucket{}, d.inputBuckets...)
+	if d.outputBucket != nil {
+		buckets = append(buckets, d.outputBucket)
+	}
+	return buckets, nil
+}
+
+func (d *WorkerDriver) bucket(pc *client.APIClient, name string) (*Bucket, error) {
+	bucket, ok := d.namesMap[name]
+	if !ok {
+		return nil, noSuchBucketError()
+	}
+	return bucket, nil
+}
+
+func (d *WorkerDriver) bucketCapabilities(pc *client.APIClient, bucket *Bucket) (bucketCapabilities, error) {
+	if bucket == d.outputBucket {
+		return bucketCapabilities{
+			readable: false,
+			writable: true,
+		}, nil
+	}
+	return bucketCapabilities{
+		readable: true,
+		writable: false,
+	}, nil
+}
+
+func (d *WorkerDriver) canModifyBuckets() bool {
+	return false
+}
+
+// readCommit returns the ID of the commit that reads from the bucket should
+// see, which is the most recent finished commit on the bucket's branch (or
+// the bucket's commit itself). An empty string is returned if the branch does
+// not have any finished commits, or if the bucket's commit is not finished.
+func readCommit(pc *client.APIClient, bucket *Bucket) (string, error) {
+	commitInfo, err := pc.InspectReadCommit(bucket.Repo, bucket.Commit)
+	if err != nil {
+		if pfsserver.IsNoHeadErr(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if commitInfo == nil || commitInfo.Finished == nil {
+		return "", nil
+	}
+	return commitInfo.Commit.ID, nil
+}
+
+// writeBranch returns the branch (or open commit) that writes to the bucket
+// should go to. Only buckets which are writable can be written to.
+func writeBranch(bucket *Bucket, capabilities bucketCapabilities) (string, error) {
+	if !capabilities.writable {
+		return "", accessDeniedError()
+	}
+	return bucket.Commit, nil
+}
@@ -0,0 +1,121 @@
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+)
+
+// Error is an S3 error, which is returned to clients as an XML document.
+type Error struct {
+	XMLName    xml.Name `xml:"Error"`
+	Code       string   `xml:"Code"`
+	Message    string   `xml:"Message"`
+	Resource   string   `xml:"Resource"`
+	RequestID  string   `xml:"RequestId"`
+	HTTPStatus int      `xml:"-"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func newError(httpStatus int, code, message string) *Error {
+	return &Error{
+		Code:       code,
+		Message:    message,
+		HTTPStatus: httpStatus,
+	}
+}
+
+func accessDeniedError() *Error {
+	return newError(http.StatusForbidden, "AccessDenied", "Access Denied")
+}
+
+func badDigestError() *Error {
+	return newError(http.StatusBadRequest, "BadDigest", "The Content-MD5 you specified did not match what was received.")
+}
+
+func bucketAlreadyOwnedByYouError() *Error {
+	return newError(http.StatusConflict, "BucketAlreadyOwnedByYou", "The bucket you tried to create already exists, and you own it.")
+}
+
+func internalError(err error) *Error {
+	return newError(http.StatusInternalServerError, "InternalError", err.Error())
+}
+
+func invalidArgumentError(message string) *Error {
+	return newError(http.StatusBadRequest, "InvalidArgument", message)
+}
+
+func invalidBucketNameError() *Error {
+	return newError(http.StatusBadRequest, "InvalidBucketName", "The specified bucket is not valid.")
+}
+
+func invalidPartError() *Error {
+	return newError(http.StatusBadRequest, "InvalidPart", "One or more of the specified parts could not be found. The part might not have been uploaded, or the specified entity tag might not have matched the part's entity tag.")
+}
+
+func invalidPartOrderError() *Error {
+	return newError(http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order. Parts must be ordered by part number.")
+}
+
+func invalidRangeError() *Error {
+	return newError(http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "The requested range is not satisfiable.")
+}
+
+func malformedXMLError() *Error {
+	return newError(http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema.")
+}
+
+func methodNotAllowedError() *Error {
+	return newError(http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+}
+
+func noSuchBucketError() *Error {
+	return newError(http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist.")
+}
+
+func noSuchKeyError() *Error {
+	return newError(http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+}
+
+func noSuchUploadError() *Error {
+	return newError(http.StatusNotFound, "NoSuchUpload", "The specified multipart upload does not exist. The upload ID might be invalid, or the multipart upload might have been aborted or completed.")
+}
+
+func notImplementedError() *Error {
+	return newError(http.StatusNotImplemented, "NotImplemented", "This functionality is not implemented by the pachyderm s3 gateway.")
+}
+
+func requestTimeTooSkewedError() *Error {
+	return newError(http.StatusForbidden, "RequestTimeTooSkewed", "The difference between the request time and the server's time is too large.")
+}
+
+func signatureDoesNotMatchError() *Error {
+	return newError(http.StatusForbidden, "SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided. Check your key and signing method.")
+}
+
+// toS3Error converts an error returned by a handler or by pachd into an S3
+// error.
+func toS3Error(err error) *Error {
+	var s3Err *Error
+	if errors.As(err, &s3Err) {
+		return s3Err
+	}
+	err = grpcutil.ScrubGRPC(err)
+	switch {
+	case auth.IsErrNotAuthorized(err), auth.IsErrNotSignedIn(err), auth.IsErrBadToken(err), auth.IsErrExpiredToken(err):
+		return accessDeniedError()
+	case pfsserver.IsRepoNotFoundErr(err), pfsserver.IsBranchNotFoundErr(err):
+		return noSuchBucketError()
+	case pfsserver.IsFileNotFoundErr(err), pfsserver.IsCommitNotFoundErr(err):
+		return noSuchKeyError()
+	}
+	return internalError(err)
+}
@@ -0,0 +1,602 @@
+package s3
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+const (
+	maxPartNumber     = 10000
+	defaultMaxParts   = 1000
+	defaultMaxUploads = 1000
+	// multipartUploadTimeout is how long after a multipart upload is
+	// initiated that it is aborted.
+	multipartUploadTimeout = 24 * time.Hour
+	// multipartBranch is the branch of a bucket's repo which stores the
+	// bucket's in-progress multipart uploads. It is hidden from the bucket
+	// list.
+	multipartBranch = "_s3gateway_multipart"
+)
+
+// InitiateMultipartUploadResult is the response to CreateMultipartUpload.
+type InitiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ InitiateMultipartUploadResult"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadID string   `xml:"UploadId"`
+}
+
+// CompleteMultipartUpload is the request body of CompleteMultipartUpload.
+type CompleteMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []CompletedPart `xml:"Part"`
+}
+
+// CompletedPart is a part in a CompleteMultipartUpload request.
+type CompletedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// CompleteMultipartUploadResult is the response to CompleteMultipartUpload.
+type CompleteMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CompleteMultipartUploadResult"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+// ListPartsResult is the response to ListParts.
+type ListPartsResult struct {
+	XMLName              xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListPartsResult"`
+	Bucket               string   `xml:"Bucket"`
+	Key                  string   `xml:"Key"`
+	UploadID             string   `xml:"UploadId"`
+	Initiator            Owner    `xml:"Initiator"`
+	Owner                Owner    `xml:"Owner"`
+	StorageClass         string   `xml:"StorageClass"`
+	PartNumberMarker     int      `xml:"PartNumberMarker"`
+	NextPartNumberMarker int      `xml:"NextPartNumberMarker"`
+	MaxParts             int      `xml:"MaxParts"`
+	IsTruncated          bool     `xml:"IsTruncated"`
+	Parts                []Part   `xml:"Part"`
+}
+
+// Part is a part in a ListPartsResult.
+type Part struct {
+	PartNumber   int       `xml:"PartNumber"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+	Size         int64     `xml:"Size"`
+}
+
+// ListMultipartUploadsResult is the response to ListMultipartUploads.
+type ListMultipartUploadsResult struct {
+	XMLName            xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListMultipartUploadsResult"`
+	Bucket             string   `xml:"Bucket"`
+	KeyMarker          string   `xml:"KeyMarker"`
+	UploadIDMarker     string   `xml:"UploadIdMarker"`
+	NextKeyMarker      string   `xml:"NextKeyMarker"`
+	NextUploadIDMarker string   `xml:"NextUploadIdMarker"`
+	Prefix             string   `xml:"Prefix"`
+	MaxUploads         int      `xml:"MaxUploads"`
+	IsTruncated        bool     `xml:"IsTruncated"`
+	Uploads            []Upload `xml:"Upload"`
+}
+
+// Upload is an in-progress upload in a ListMultipartUploadsResult.
+type Upload struct {
+	Key          string    `xml:"Key"`
+	UploadID     string    `xml:"UploadId"`
+	Initiator    Owner     `xml:"Initiator"`
+	Owner        Owner     `xml:"Owner"`
+	StorageClass string    `xml:"StorageClass"`
+	Initiated    time.Time `xml:"Initiated"`
+}
+
+// uploadInfo is the state of an in-progress multipart upload. Uploads are
+// stored on the multipart branch of the bucket's repo, so they survive pachd
+// restarts: the upload itself at /<bucket>/<upload ID>/upload, and each part
+// at /<bucket>/<upload ID>/parts/<part number>, with its metadata at
+// /<bucket>/<upload ID>/meta/<part number>. Once the upload is completed or
+// aborted, its files are deleted from the multipart branch.
+type uploadInfo struct {
+	Key       string    `json:"key"`
+	Initiated time.Time `json:"initiated"`
+}
+
+type partInfo struct {
+	ETag         string    `json:"etag"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+type multipartUpload struct {
+	id string
+	*uploadInfo
+}
+
+func uploadDir(bucketName, uploadID string) string {
+	return path.Join("/", bucketName, uploadID)
+}
+
+func uploadPath(bucketName, uploadID string) string {
+	return path.Join(uploadDir(bucketName, uploadID), "upload")
+}
+
+func partPath(bucketName, uploadID string, number int) string {
+	return path.Join(uploadDir(bucketName, uploadID), "parts", fmt.Sprintf("%05d", number))
+}
+
+func partMetaPath(bucketName, uploadID string, number int) string {
+	return path.Join(uploadDir(bucketName, uploadID), "meta", fmt.Sprintf("%05d", number))
+}
+
+// getUpload returns the upload with the given ID for the given object.
+func getUpload(pc *client.APIClient, repo, bucketName, key, uploadID string) (*uploadInfo, error) {
+	if !uuid.IsUUIDWithoutDashes(uploadID) {
+		return nil, noSuchUploadError()
+	}
+	// GetFile doesn't return an error for paths that don't match any files,
+	// so missing uploads are empty.
+	buf := &bytes.Buffer{}
+	if err := pc.GetFile(repo, multipartBranch, uploadPath(bucketName, uploadID), buf); err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil, noSuchUploadError()
+		}
+		return nil, err
+	}
+	if buf.Len() == 0 {
+		return nil, noSuchUploadError()
+	}
+	upload := &uploadInfo{}
+	if err := json.Unmarshal(buf.Bytes(), upload); err != nil {
+		return nil, err
+	}
+	if upload.Key != key {
+		return nil, noSuchUploadError()
+	}
+	return upload, nil
+}
+
+// listUploads returns the bucket's uploads, ordered by key and then ID.
+func listUploads(pc *client.APIClient, repo, bucketName string) ([]*multipartUpload, error) {
+	var uploads []*multipartUpload
+	if err := iterateJSON(pc, repo, uploadPath(bucketName, "*"), func(p string, data []byte) error {
+		upload := &uploadInfo{}
+		if err := json.Unmarshal(data, upload); err != nil {
+			return err
+		}
+		uploads = append(uploads, &multipartUpload{
+			id:         path.Base(path.Dir(p)),
+			uploadInfo: upload,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		if uploads[i].Key != uploads[j].Key {
+			return uploads[i].Key < uploads[j].Key
+		}
+		return uploads[i].id < uploads[j].id
+	})
+	return uploads, nil
+}
+
+// listParts returns the upload's parts, ordered by part number.
+func listParts(pc *client.APIClient, repo, bucketName, uploadID string) ([]int, map[int]*partInfo, error) {
+	parts := make(map[int]*partInfo)
+	var numbers []int
+	if err := iterateJSON(pc, repo, path.Join(uploadDir(bucketName, uploadID), "meta", "*"), func(p string, data []byte) error {
+		number, err := strconv.Atoi(path.Base(p))
+		if err != nil {
+			return err
+		}
+		part := &partInfo{}
+		if err := json.Unmarshal(data, part); err != nil {
+			return err
+		}
+		numbers = append(numbers, number)
+		parts[number] = part
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	sort.Ints(numbers)
+	return numbers, parts, nil
+}
+
+// iterateJSON calls cb with the path and contents of each file on the
+// multipart branch which matches the glob pattern.
+func iterateJSON(pc *client.APIClient, repo, pattern string, cb func(string, []byte) error) error {
+	r, err := pc.GetTarFile(repo, multipartBranch, pattern)
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if errutil.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		if hdr.Typeflag == tar.TypeDir {
+			continue
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		if err := cb(path.Join("/", hdr.Name), data); err != nil {
+			return err
+		}
+	}
+}
+
+// deleteUploads deletes the files of the given uploads from the multipart
+// branch.
+func deleteUploads(pc *client.APIClient, repo, bucketName string, uploadIDs ...string) error {
+	var paths []string
+	for _, uploadID := range uploadIDs {
+		numbers, _, err := listParts(pc, repo, bucketName, uploadID)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, uploadPath(bucketName, uploadID))
+		for _, number := range numbers {
+			paths = append(paths, partPath(bucketName, uploadID, number), partMetaPath(bucketName, uploadID, number))
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	return pc.WithModifyFileClient(repo, multipartBranch, func(mfc *client.ModifyFileClient) error {
+		for _, p := range paths {
+			if err := mfc.DeleteFile(p); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// expireUploads aborts the bucket's uploads which were initiated too long
+// ago.
+func expireUploads(pc *client.APIClient, repo, bucketName string) error {
+	uploads, err := listUploads(pc, repo, bucketName)
+	if err != nil {
+		return err
+	}
+	var expired []string
+	for _, upload := range uploads {
+		if time.Since(upload.Initiated) > multipartUploadTimeout {
+			expired = append(expired, upload.id)
+		}
+	}
+	return deleteUploads(pc, repo, bucketName, expired...)
+}
+
+func putJSON(mfc *client.ModifyFileClient, p string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return mfc.AppendFile(p, true, bytes.NewReader(data))
+}
+
+func (c *controller) initMultipartUpload(pc *client.APIClient, w http.ResponseWriter, bucketName, key string) error {
+	bucket, capabilities, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	if _, err := writeBranch(bucket, capabilities); err != nil {
+		return err
+	}
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	if err := expireUploads(pc, bucket.Repo, bucketName); err != nil {
+		return err
+	}
+	uploadID := uuid.NewWithoutDashes()
+	if err := pc.WithModifyFileClient(bucket.Repo, multipartBranch, func(mfc *client.ModifyFileClient) error {
+		return putJSON(mfc, uploadPath(bucketName, uploadID), &uploadInfo{
+			Key:       key,
+			Initiated: time.Now(),
+		})
+	}); err != nil {
+		return err
+	}
+	writeXML(w, http.StatusOK, &InitiateMultipartUploadResult{
+		Bucket:   bucketName,
+		Key:      key,
+		UploadID: uploadID,
+	})
+	return nil
+}
+
+func (c *controller) uploadPart(pc *client.APIClient, w http.ResponseWriter, r *http.Request, bucketName, key, uploadID string) error {
+	bucket, capabilities, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	if _, err := writeBranch(bucket, capabilities); err != nil {
+		return err
+	}
+	number, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || number < 1 || number > maxPartNumber {
+		return invalidArgumentError(fmt.Sprintf("part number must be an integer between 1 and %d", maxPartNumber))
+	}
+	if _, err := getUpload(pc, bucket.Repo, bucketName, key, uploadID); err != nil {
+		return err
+	}
+	var expectedMD5 []byte
+	if contentMD5 := r.Header.Get("Content-MD5"); contentMD5 != "" {
+		expectedMD5, err = base64.StdEncoding.DecodeString(contentMD5)
+		if err != nil {
+			return invalidArgumentError("invalid Content-MD5")
+		}
+	}
+	// Cancelling the context aborts the write if the callback returns an
+	// error. The part and its metadata are written in the same commit.
+	ctx, cancel := context.WithCancel(pc.Ctx())
+	defer cancel()
+	hash := md5.New()
+	counter := &countingReader{r: io.TeeReader(requestBody(r), hash)}
+	part := &partInfo{}
+	if err := pc.WithCtx(ctx).WithModifyFileClient(bucket.Repo, multipartBranch, func(mfc *client.ModifyFileClient) error {
+		if err := mfc.AppendFile(partPath(bucketName, uploadID, number), true, counter); err != nil {
+			return err
+		}
+		if expectedMD5 != nil && !bytes.Equal(hash.Sum(nil), expectedMD5) {
+			return badDigestError()
+		}
+		part.ETag = fmt.Sprintf("%q", hex.EncodeToString(hash.Sum(nil)))
+		part.Size = counter.n
+		part.LastModified = time.Now()
+		return putJSON(mfc, partMetaPath(bucketName, uploadID, number), part)
+	}); err != nil {
+		return err
+	}
+	w.Header().Set("ETag", part.ETag)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (c *controller) completeMultipartUpload(pc *client.APIClient, w http.ResponseWriter, r *http.Request, bucketName, key, uploadID string) error {
+	bucket, capabilities, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	branch, err := writeBranch(bucket, capabilities)
+	if err != nil {
+		return err
+	}
+	if _, err := getUpload(pc, bucket.Repo, bucketName, key, uploadID); err != nil {
+		return err
+	}
+	var req CompleteMultipartUpload
+	if err := readXML(r, &req); err != nil {
+		return err
+	}
+	if len(req.Parts) == 0 {
+		return malformedXMLError()
+	}
+	_, parts, err := listParts(pc, bucket.Repo, bucketName, uploadID)
+	if err != nil {
+		return err
+	}
+	for i, completed := range req.Parts {
+		if i > 0 && completed.PartNumber <= req.Parts[i-1].PartNumber {
+			return invalidPartOrderError()
+		}
+		part, ok := parts[completed.PartNumber]
+		if !ok || strings.Trim(completed.ETag, `"`) != strings.Trim(part.ETag, `"`) {
+			return invalidPartError()
+		}
+	}
+	// The parts are streamed out of the multipart branch one at a time.
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		pw.CloseWithError(func() error {
+			for _, completed := range req.Parts {
+				if err := pc.GetFile(bucket.Repo, multipartBranch, partPath(bucketName, uploadID, completed.PartNumber), pw); err != nil {
+					return err
+				}
+			}
+			return nil
+		}())
+	}()
+	if err := writeObject(pc, bucket.Repo, branch, key, pr, nil); err != nil {
+		return err
+	}
+	if err := deleteUploads(pc, bucket.Repo, bucketName, uploadID); err != nil {
+		return err
+	}
+	result := &CompleteMultipartUploadResult{
+		Location: "/" + bucketName + "/" + key,
+		Bucket:   bucketName,
+		Key:      key,
+	}
+	if fi, err := pc.InspectFile(bucket.Repo, branch, key); err == nil {
+		result.ETag = etag(fi)
+		w.Header().Set("x-amz-version-id", fi.File.Commit.ID)
+	}
+	writeXML(w, http.StatusOK, result)
+	return nil
+}
+
+func (c *controller) abortMultipartUpload(pc *client.APIClient, w http.ResponseWriter, bucketName, key, uploadID string) error {
+	bucket, capabilities, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	if _, err := writeBranch(bucket, capabilities); err != nil {
+		return err
+	}
+	if _, err := getUpload(pc, bucket.Repo, bucketName, key, uploadID); err != nil {
+		return err
+	}
+	if err := deleteUploads(pc, bucket.Repo, bucketName, uploadID); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (c *controller) listParts(pc *client.APIClient, w http.ResponseWriter, r *http.Request, bucketName, key, uploadID string) error {
+	bucket, _, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	if _, err := getUpload(pc, bucket.Repo, bucketName, key, uploadID); err != nil {
+		return err
+	}
+	query := r.URL.Query()
+	maxParts, err := intParam(query.Get("max-parts"), defaultMaxParts)
+	if err != nil {
+		return err
+	}
+	marker, err := intParam(query.Get("part-number-marker"), 0)
+	if err != nil {
+		return err
+	}
+	result := &ListPartsResult{
+		Bucket:           bucketName,
+		Key:              key,
+		UploadID:         uploadID,
+		Initiator:        owner,
+		Owner:            owner,
+		StorageClass:     "STANDARD",
+		PartNumberMarker: marker,
+		MaxParts:         maxParts,
+	}
+	numbers, parts, err := listParts(pc, bucket.Repo, bucketName, uploadID)
+	if err != nil {
+		return err
+	}
+	for _, number := range numbers {
+		if number <= marker {
+			continue
+		}
+		if len(result.Parts) == maxParts {
+			result.IsTruncated = true
+			break
+		}
+		part := parts[number]
+		result.Parts = append(result.Parts, Part{
+			PartNumber:   number,
+			LastModified: part.LastModified.UTC(),
+			ETag:         part.ETag,
+			Size:         part.Size,
+		})
+		result.NextPartNumberMarker = number
+	}
+	writeXML(w, http.StatusOK, result)
+	return nil
+}
+
+func (c *controller) listMultipartUploads(pc *client.APIClient, w http.ResponseWriter, r *http.Request, bucketName string) error {
+	bucket, _, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	query := r.URL.Query()
+	maxUploads, err := intParam(query.Get("max-uploads"), defaultMaxUploads)
+	if err != nil {
+		return err
+	}
+	result := &ListMultipartUploadsResult{
+		Bucket:         bucketName,
+		KeyMarker:      query.Get("key-marker"),
+		UploadIDMarker: query.Get("upload-id-marker"),
+		Prefix:         query.Get("prefix"),
+		MaxUploads:     maxUploads,
+	}
+	uploads, err := listUploads(pc, bucket.Repo, bucketName)
+	if err != nil {
+		return err
+	}
+	for _, upload := range uploads {
+		if !strings.HasPrefix(upload.Key, result.Prefix) {
+			continue
+		}
+		if upload.Key < result.KeyMarker || (upload.Key == result.KeyMarker && upload.id <= result.UploadIDMarker) {
+			continue
+		}
+		if len(result.Uploads) == maxUploads {
+			result.IsTruncated = true
+			break
+		}
+		result.Uploads = append(result.Uploads, Upload{
+			Key:          upload.Key,
+			UploadID:     upload.id,
+			Initiator:    owner,
+			Owner:        owner,
+			StorageClass: "STANDARD",
+			Initiated:    upload.Initiated.UTC(),
+		})
+		result.NextKeyMarker = upload.Key
+		result.NextUploadIDMarker = upload.id
+	}
+	writeXML(w, http.StatusOK, result)
+	return nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (cr *countingReader) Read(data []byte) (int, error) {
+	n, err := cr.r.Read(data)
+	cr.n += int64(n)
+	return n, err
+}
+
+// intParam parses a non-negative integer query parameter, which defaults to
+// def and is capped at def.
+func intParam(s string, def int) (int, error) {
+	if s == "" {
+		return def, nil
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil || i < 0 {
+		return 0, invalidArgumentError(fmt.Sprintf("invalid integer %q", s))
+	}
+	if def > 0 && i > def {
+		return def, nil
+	}
+	return i, nil
+}
@@ -0,0 +1,463 @@
+package s3
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+)
+
+// CopyObjectResult is the response to CopyObject.
+type CopyObjectResult struct {
+	XMLName      xml.Name  `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CopyObjectResult"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+}
+
+// Delete is the request body of DeleteObjects.
+type Delete struct {
+	XMLName xml.Name           `xml:"Delete"`
+	Quiet   bool               `xml:"Quiet"`
+	Objects []ObjectIdentifier `xml:"Object"`
+}
+
+// ObjectIdentifier identifies an object to delete.
+type ObjectIdentifier struct {
+	Key       string `xml:"Key"`
+	VersionID string `xml:"VersionId"`
+}
+
+// DeleteResult is the response to DeleteObjects.
+type DeleteResult struct {
+	XMLName xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ DeleteResult"`
+	Deleted []DeletedObject `xml:"Deleted"`
+	Errors  []DeleteError   `xml:"Error"`
+}
+
+// DeletedObject is an object that was deleted by DeleteObjects.
+type DeletedObject struct {
+	Key string `xml:"Key"`
+}
+
+// DeleteError is an object that could not be deleted by DeleteObjects.
+type DeleteError struct {
+	Key     string `xml:"Key"`
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func (c *controller) getObject(pc *client.APIClient, w http.ResponseWriter, r *http.Request, bucketName, key string, includeBody bool) error {
+	bucket, capabilities, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	if !capabilities.readable {
+		return accessDeniedError()
+	}
+	// Every commit is a version of the bucket's objects.
+	commit := r.URL.Query().Get("versionId")
+	if commit == "" {
+		commit, err = readCommit(pc, bucket)
+		if err != nil {
+			return err
+		}
+		if commit == "" {
+			return noSuchKeyError()
+		}
+	}
+	fi, err := inspectObject(pc, bucket.Repo, commit, key)
+	if err != nil {
+		return err
+	}
+	commitInfo, err := pc.InspectCommit(bucket.Repo, commit)
+	if err != nil {
+		return err
+	}
+	status := http.StatusOK
+	offset, length := uint64(0), fi.SizeBytes
+	if rangeHeader := r.Header.Get("Range"); rangeHeader != "" {
+		offset, length, err = parseRange(rangeHeader, fi.SizeBytes)
+		if err != nil {
+			return err
+		}
+		status = http.StatusPartialContent
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, fi.SizeBytes))
+	}
+	var body io.ReadSeeker
+	if includeBody {
+		// The object is opened before any headers are written, so that
+		// errors can still be returned to the client. Only the requested
+		// range is read from pachd.
+		body, err = pc.GetFileReadSeeker(bucket.Repo, commit, key)
+		if err != nil {
+			return err
+		}
+		if _, err := body.Seek(int64(offset), io.SeekStart); err != nil {
+			return err
+		}
+	}
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.FormatUint(length, 10))
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.Header().Set("ETag", etag(fi))
+	w.Header().Set("Last-Modified", timestamp(commitInfo.Finished).Format(http.TimeFormat))
+	w.Header().Set("x-amz-version-id", commitInfo.Commit.ID)
+	w.WriteHeader(status)
+	if !includeBody {
+		return nil
+	}
+	// The status has already been written, so errors can only be logged.
+	if _, err := io.CopyN(w, body, int64(length)); err != nil {
+		c.logger.Errorf("could not write object %s/%s: %v", bucketName, key, err)
+	}
+	return nil
+}
+
+func (c *controller) putObject(pc *client.APIClient, w http.ResponseWriter, r *http.Request, bucketName, key string) error {
+	bucket, capabilities, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	branch, err := writeBranch(bucket, capabilities)
+	if err != nil {
+		return err
+	}
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	var expectedMD5 []byte
+	if contentMD5 := r.Header.Get("Content-MD5"); contentMD5 != "" {
+		expectedMD5, err = base64.StdEncoding.DecodeString(contentMD5)
+		if err != nil {
+			return invalidArgumentError("invalid Content-MD5")
+		}
+	}
+	if err := writeObject(pc, bucket.Repo, branch, key, requestBody(r), expectedMD5); err != nil {
+		return err
+	}
+	setWriteHeaders(pc, w, bucket.Repo, branch, key)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (c *controller) copyObject(pc *client.APIClient, w http.ResponseWriter, r *http.Request, bucketName, key string) error {
+	bucket, capabilities, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	branch, err := writeBranch(bucket, capabilities)
+	if err != nil {
+		return err
+	}
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	srcBucketName, srcKey, srcCommit, err := parseCopySource(r.Header.Get("X-Amz-Copy-Source"))
+	if err != nil {
+		return err
+	}
+	srcBucket, srcCapabilities, err := c.bucketAndCapabilities(pc, srcBucketName)
+	if err != nil {
+		return err
+	}
+	if !srcCapabilities.readable {
+		return accessDeniedError()
+	}
+	if srcCommit == "" {
+		srcCommit, err = readCommit(pc, srcBucket)
+		if err != nil {
+			return err
+		}
+		if srcCommit == "" {
+			return noSuchKeyError()
+		}
+	}
+	if _, err := inspectObject(pc, srcBucket.Repo, srcCommit, srcKey); err != nil {
+		return err
+	}
+	src, err := objectReader(pc, srcBucket.Repo, srcCommit, srcKey)
+	if err != nil {
+		return err
+	}
+	if err := writeObject(pc, bucket.Repo, branch, key, src, nil); err != nil {
+		return err
+	}
+	result := &CopyObjectResult{LastModified: time.Now().UTC()}
+	if fi, err := pc.InspectFile(bucket.Repo, branch, key); err == nil {
+		result.ETag = etag(fi)
+	}
+	writeXML(w, http.StatusOK, result)
+	return nil
+}
+
+func (c *controller) deleteObject(pc *client.APIClient, w http.ResponseWriter, r *http.Request, bucketName, key string) error {
+	bucket, capabilities, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	branch, err := writeBranch(bucket, capabilities)
+	if err != nil {
+		return err
+	}
+	if r.URL.Query().Get("versionId") != "" {
+		return notImplementedError()
+	}
+	// Deleting an object that doesn't exist succeeds, but doesn't create a
+	// commit. Write-only buckets can't be checked, so the delete always goes
+	// through.
+	exists := true
+	if capabilities.readable {
+		exists, err = objectExists(pc, bucket, key)
+		if err != nil {
+			return err
+		}
+	}
+	if exists {
+		if err := pc.WithModifyFileClient(bucket.Repo, branch, func(mfc *client.ModifyFileClient) error {
+			return mfc.DeleteFile(key)
+		}); err != nil {
+			return err
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (c *controller) deleteObjects(pc *client.APIClient, w http.ResponseWriter, r *http.Request, bucketName string) error {
+	bucket, capabilities, err := c.bucketAndCapabilities(pc, bucketName)
+	if err != nil {
+		return err
+	}
+	branch, err := writeBranch(bucket, capabilities)
+	if err != nil {
+		return err
+	}
+	var req Delete
+	if err := readXML(r, &req); err != nil {
+		return err
+	}
+	result := &DeleteResult{}
+	var keys []string
+	for _, object := range req.Objects {
+		if object.VersionID != "" {
+			result.Errors = append(result.Errors, DeleteError{
+				Key:     object.Key,
+				Code:    "NotImplemented",
+				Message: "Deleting versions of objects is not supported.",
+			})
+			continue
+		}
+		exists := true
+		if capabilities.readable {
+			exists, err = objectExists(pc, bucket, object.Key)
+			if err != nil {
+				return err
+			}
+		}
+		if exists {
+			keys = append(keys, object.Key)
+		}
+		if !req.Quiet {
+			result.Deleted = append(result.Deleted, DeletedObject{Key: object.Key})
+		}
+	}
+	// All of the objects are deleted in a single commit.
+	if len(keys) > 0 {
+		if err := pc.WithModifyFileClient(bucket.Repo, branch, func(mfc *client.ModifyFileClient) error {
+			for _, key := range keys {
+				if err := mfc.DeleteFile(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	writeXML(w, http.StatusOK, result)
+	return nil
+}
+
+// writeObject writes r to key in a new commit on branch. If expectedMD5 is
+// set and doesn't match the MD5 of r, nothing is written.
+func writeObject(pc *client.APIClient, repo, branch, key string, r io.Reader, expectedMD5 []byte) error {
+	// Cancelling the context aborts the write if the callback returns an
+	// error.
+	ctx, cancel := context.WithCancel(pc.Ctx())
+	defer cancel()
+	hash := md5.New()
+	return pc.WithCtx(ctx).WithModifyFileClient(repo, branch, func(mfc *client.ModifyFileClient) error {
+		if err := mfc.AppendFile(key, true, io.TeeReader(r, hash)); err != nil {
+			return err
+		}
+		if expectedMD5 != nil && !bytes.Equal(hash.Sum(nil), expectedMD5) {
+			return badDigestError()
+		}
+		return nil
+	})
+}
+
+// setWriteHeaders sets the ETag and version of an object that was just
+// written. These are omitted if the write is part of a commit that is still
+// open.
+func setWriteHeaders(pc *client.APIClient, w http.ResponseWriter, repo, branch, key string) {
+	fi, err := pc.InspectFile(repo, branch, key)
+	if err != nil {
+		return
+	}
+	w.Header().Set("ETag", etag(fi))
+	w.Header().Set("x-amz-version-id", fi.File.Commit.ID)
+}
+
+// inspectObject returns the file info for an object, which must be a file.
+func inspectObject(pc *client.APIClient, repo, commit, key string) (*pfs.FileInfo, error) {
+	fi, err := pc.InspectFile(repo, commit, key)
+	if err != nil {
+		if pfsserver.IsFileNotFoundErr(err) || pfsserver.IsCommitNotFoundErr(err) {
+			return nil, noSuchKeyError()
+		}
+		return nil, err
+	}
+	if fi.FileType != pfs.FileType_FILE {
+		return nil, noSuchKeyError()
+	}
+	return fi, nil
+}
+
+func objectExists(pc *client.APIClient, bucket *Bucket, key string) (bool, error) {
+	commit, err := readCommit(pc, bucket)
+	if err != nil {
+		return false, err
+	}
+	if commit == "" {
+		return false, nil
+	}
+	if _, err := inspectObject(pc, bucket.Repo, commit, key); err != nil {
+		var s3Err *Error
+		if errors.As(err, &s3Err) && s3Err.Code == "NoSuchKey" {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// objectReader returns a reader for the contents of an object. The object is
+// picked out of the files matched by its glob pattern (see
+// client.GlobPattern).
+func objectReader(pc *client.APIClient, repo, commit, key string) (io.Reader, error) {
+	p := "/" + key
+	r, err := pc.GetTarFile(repo, commit, client.GlobPattern(p))
+	if err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, noSuchKeyError()
+			}
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeDir && path.Join("/", hdr.Name) == p {
+			return tr, nil
+		}
+	}
+}
+
+// validateKey checks that key can be written. Keys ending in '/' would be
+// directories in PFS, so they can't be written as objects.
+func validateKey(key string) error {
+	if strings.HasSuffix(key, "/") {
+		return invalidArgumentError("object keys ending in '/' are not supported")
+	}
+	return nil
+}
+
+// parseCopySource parses the X-Amz-Copy-Source header, which is a URL encoded
+// "<bucket>/<key>", optionally followed by "?versionId=<version>".
+func parseCopySource(source string) (string, string, string, error) {
+	var versionID string
+	if i := strings.Index(source, "?"); i >= 0 {
+		query, err := url.ParseQuery(source[i+1:])
+		if err != nil {
+			return "", "", "", invalidArgumentError("invalid copy source")
+		}
+		versionID = query.Get("versionId")
+		source = source[:i]
+	}
+	source, err := url.PathUnescape(source)
+	if err != nil {
+		return "", "", "", invalidArgumentError("invalid copy source")
+	}
+	bucketName, key := splitPath(source)
+	if bucketName == "" || key == "" {
+		return "", "", "", invalidArgumentError("invalid copy source")
+	}
+	return bucketName, key, versionID, nil
+}
+
+// parseRange parses a Range header with a single byte range, and returns the
+// offset and length of the range in an object of the given size.
+func parseRange(header string, size uint64) (uint64, uint64, error) {
+	if !strings.HasPrefix(header, "bytes=") || strings.Contains(header, ",") {
+		return 0, 0, invalidRangeError()
+	}
+	parts := strings.SplitN(strings.TrimPrefix(header, "bytes="), "-", 2)
+	if len(parts) != 2 || (parts[0] == "" && parts[1] == "") {
+		return 0, 0, invalidRangeError()
+	}
+	if parts[0] == "" {
+		// A suffix range, which is the last n bytes of the object.
+		n, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil || n == 0 || size == 0 {
+			return 0, 0, invalidRangeError()
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, n, nil
+	}
+	start, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || start >= size {
+		return 0, 0, invalidRangeError()
+	}
+	end := size - 1
+	if parts[1] != "" {
+		end, err = strconv.ParseUint(parts[1], 10, 64)
+		if err != nil || end < start {
+			return 0, 0, invalidRangeError()
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	return start, end - start + 1, nil
+}
+
+// etag returns the entity tag of a file, which is its quoted hash.
+func etag(fi *pfs.FileInfo) string {
+	return fmt.Sprintf("%q", hex.EncodeToString(fi.Hash))
+}
@@ -0,0 +1,85 @@
+package s3
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestParseRange(t *testing.T) {
+	for _, c := range []struct {
+		header         string
+		offset, length uint64
+	}{
+		{"bytes=0-9", 0, 10},
+		{"bytes=10-", 10, 90},
+		{"bytes=90-200", 90, 10},
+		{"bytes=-10", 90, 10},
+		{"bytes=-200", 0, 100},
+	} {
+		offset, length, err := parseRange(c.header, 100)
+		require.NoError(t, err)
+		require.Equal(t, c.offset, offset, c.header)
+		require.Equal(t, c.length, length, c.header)
+	}
+	for _, header := range []string{"bytes=100-", "bytes=5-1", "bytes=-0", "bytes=-", "items=0-1", "bytes=0-1,3-4"} {
+		_, _, err := parseRange(header, 100)
+		require.YesError(t, err, header)
+		require.Equal(t, "InvalidRange", toS3Error(err).Code)
+	}
+}
+
+func TestParseCopySource(t *testing.T) {
+	bucket, key, version, err := parseCopySource("/master.repo/dir/file%20name?versionId=abc")
+	require.NoError(t, err)
+	require.Equal(t, "master.repo", bucket)
+	require.Equal(t, "dir/file name", key)
+	require.Equal(t, "abc", version)
+
+	_, _, _, err = parseCopySource("master.repo")
+	require.YesError(t, err)
+}
+
+func TestMasterDriverBucket(t *testing.T) {
+	d := NewMasterDriver()
+	bucket, err := d.bucket(nil, "feature.v2.repo")
+	require.NoError(t, err)
+	require.Equal(t, "repo", bucket.Repo)
+	require.Equal(t, "feature.v2", bucket.Commit)
+	for _, name := range []string{"repo", ".repo", "master."} {
+		_, err := d.bucket(nil, name)
+		require.YesError(t, err, name)
+	}
+}
+
+func TestWorkerDriverBuckets(t *testing.T) {
+	in := &Bucket{Name: "in", Repo: "input", Commit: "abc"}
+	out := &Bucket{Name: "out", Repo: "pipeline", Commit: "def"}
+	d := NewWorkerDriver([]*Bucket{in}, out)
+	buckets, err := d.listBuckets(nil)
+	require.NoError(t, err)
+	require.Equal(t, []*Bucket{in, out}, buckets)
+	require.False(t, d.canModifyBuckets())
+
+	// Inputs are read-only, and the output is write-only.
+	bucket, err := d.bucket(nil, "in")
+	require.NoError(t, err)
+	capabilities, err := d.bucketCapabilities(nil, bucket)
+	require.NoError(t, err)
+	require.True(t, capabilities.readable)
+	_, err = writeBranch(bucket, capabilities)
+	require.YesError(t, err)
+	require.Equal(t, "AccessDenied", toS3Error(err).Code)
+	bucket, err = d.bucket(nil, "out")
+	require.NoError(t, err)
+	capabilities, err = d.bucketCapabilities(nil, bucket)
+	require.NoError(t, err)
+	require.False(t, capabilities.readable)
+	commit, err := writeBranch(bucket, capabilities)
+	require.NoError(t, err)
+	require.Equal(t, "def", commit)
+
+	_, err = d.bucket(nil, "master.input")
+	require.YesError(t, err)
+	require.Equal(t, "NoSuchBucket", toS3Error(err).Code)
+}
@@ -0,0 +1,255 @@
+// Package s3 implements an S3-compatible gateway for PFS. Buckets are mapped
+// to PFS repos and commits by a Driver, and objects are files in the
+// bucket's commit. Only path-style requests are supported.
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+// ClientFactory creates the client that is used to make PFS requests on
+// behalf of the S3 gateway.
+type ClientFactory func() (*client.APIClient, error)
+
+// Server returns an HTTP server that serves an S3-like API for PFS on the
+// given port.
+func Server(port uint16, driver Driver, clientFactory ClientFactory) (*http.Server, error) {
+	return &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: Router(driver, clientFactory),
+	}, nil
+}
+
+// Router returns an HTTP handler that serves an S3-like API for PFS.
+func Router(driver Driver, clientFactory ClientFactory) http.Handler {
+	return &controller{
+		driver:        driver,
+		clientFactory: clientFactory,
+		logger:        log.WithField("source", "s3gateway"),
+	}
+}
+
+type controller struct {
+	driver        Driver
+	clientFactory ClientFactory
+	logger        *log.Entry
+
+	mu         sync.Mutex
+	pachClient *client.APIClient
+}
+
+// client returns a client for the request, authenticated with the request's
+// access key. The underlying connection is shared by all requests.
+func (c *controller) client(r *http.Request) (*client.APIClient, error) {
+	accessKey, err := authenticate(r)
+	if err != nil {
+		return nil, err
+	}
+	pc, err := func() (*client.APIClient, error) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.pachClient == nil {
+			pc, err := c.clientFactory()
+			if err != nil {
+				return nil, err
+			}
+			c.pachClient = pc
+		}
+		return c.pachClient, nil
+	}()
+	if err != nil {
+		return nil, err
+	}
+	// WithCtx copies the client, so setting the auth token does not affect
+	// other requests. Anonymous requests use the factory client's token.
+	pc = pc.WithCtx(r.Context())
+	if accessKey != "" {
+		pc.SetAuthToken(accessKey)
+	}
+	return pc, nil
+}
+
+func (c *controller) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	requestID := uuid.NewWithoutDashes()
+	w.Header().Set("x-amz-request-id", requestID)
+	w.Header().Set("Server", "Pachyderm")
+	if err := c.serve(w, r); err != nil {
+		s3Err := toS3Error(err)
+		if s3Err.HTTPStatus == http.StatusInternalServerError {
+			c.logger.Errorf("internal error serving %s %s: %v", r.Method, r.URL.Path, err)
+		}
+		s3Err.Resource = r.URL.Path
+		s3Err.RequestID = requestID
+		if r.Method == http.MethodHead {
+			w.WriteHeader(s3Err.HTTPStatus)
+			return
+		}
+		writeXML(w, s3Err.HTTPStatus, s3Err)
+	}
+}
+
+func (c *controller) serve(w http.ResponseWriter, r *http.Request) error {
+	pc, err := c.client(r)
+	if err != nil {
+		return err
+	}
+	bucketName, key := splitPath(r.URL.Path)
+	query := r.URL.Query()
+	_, uploads := query["uploads"]
+	uploadID := query.Get("uploadId")
+	switch {
+	case bucketName == "":
+		if r.Method != http.MethodGet {
+			return methodNotAllowedError()
+		}
+		return c.listBuckets(pc, w)
+	case key == "":
+		switch r.Method {
+		case http.MethodGet:
+			switch {
+			case has(query, "location"):
+				return c.getBucketLocation(pc, w, bucketName)
+			case has(query, "versioning"):
+				return c.getBucketVersioning(pc, w, bucketName)
+			case uploads:
+				return c.listMultipartUploads(pc, w, r, bucketName)
+			case hasUnsupportedSubresource(query):
+				return notImplementedError()
+			}
+			return c.listObjects(pc, w, r, bucketName)
+		case http.MethodHead:
+			_, _, err := c.bucketAndCapabilities(pc, bucketName)
+			return err
+		case http.MethodPut:
+			if hasUnsupportedSubresource(query) {
+				return notImplementedError()
+			}
+			return c.createBucket(pc, w, bucketName)
+		case http.MethodDelete:
+			if hasUnsupportedSubresource(query) {
+				return notImplementedError()
+			}
+			return c.deleteBucket(pc, w, bucketName)
+		case http.MethodPost:
+			if has(query, "delete") {
+				return c.deleteObjects(pc, w, r, bucketName)
+			}
+			return notImplementedError()
+		}
+	default:
+		switch r.Method {
+		case http.MethodGet:
+			if uploadID != "" {
+				return c.listParts(pc, w, r, bucketName, key, uploadID)
+			}
+			if hasUnsupportedSubresource(query) {
+				return notImplementedError()
+			}
+			return c.getObject(pc, w, r, bucketName, key, true)
+		case http.MethodHead:
+			return c.getObject(pc, w, r, bucketName, key, false)
+		case http.MethodPut:
+			if uploadID != "" {
+				return c.uploadPart(pc, w, r, bucketName, key, uploadID)
+			}
+			if hasUnsupportedSubresource(query) {
+				return notImplementedError()
+			}
+			if r.Header.Get("X-Amz-Copy-Source") != "" {
+				return c.copyObject(pc, w, r, bucketName, key)
+			}
+			return c.putObject(pc, w, r, bucketName, key)
+		case http.MethodPost:
+			switch {
+			case uploads:
+				return c.initMultipartUpload(pc, w, bucketName, key)
+			case uploadID != "":
+				return c.completeMultipartUpload(pc, w, r, bucketName, key, uploadID)
+			}
+			return notImplementedError()
+		case http.MethodDelete:
+			if uploadID != "" {
+				return c.abortMultipartUpload(pc, w, bucketName, key, uploadID)
+			}
+			return c.deleteObject(pc, w, r, bucketName, key)
+		}
+	}
+	return methodNotAllowedError()
+}
+
+func (c *controller) bucketAndCapabilities(pc *client.APIClient, bucketName string) (*Bucket, bucketCapabilities, error) {
+	bucket, err := c.driver.bucket(pc, bucketName)
+	if err != nil {
+		return nil, bucketCapabilities{}, err
+	}
+	capabilities, err := c.driver.bucketCapabilities(pc, bucket)
+	if err != nil {
+		return nil, bucketCapabilities{}, err
+	}
+	return bucket, capabilities, nil
+}
+
+// splitPath splits a path-style request path into the bucket name and the
+// object key.
+func splitPath(p string) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(p, "/"), "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+func has(query map[string][]string, name string) bool {
+	_, ok := query[name]
+	return ok
+}
+
+// unsupportedSubresources are the S3 subresources that are not supported by
+// the gateway. Requests for these are rejected rather than being treated as
+// requests for the bucket or object itself.
+var unsupportedSubresources = []string{
+	"accelerate", "acl", "analytics", "cors", "encryption", "inventory",
+	"lifecycle", "logging", "metrics", "notification", "object-lock",
+	"policy", "publicAccessBlock", "replication", "requestPayment", "restore",
+	"retention", "legal-hold", "tagging", "torrent", "versions", "website",
+	"select",
+}
+
+func hasUnsupportedSubresource(query map[string][]string) bool {
+	for _, name := range unsupportedSubresources {
+		if has(query, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeXML(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	// The status has already been written, so errors can only be logged.
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		log.Errorf("could not write s3gateway response: %v", err)
+		return
+	}
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		log.Errorf("could not write s3gateway response: %v", err)
+	}
+}
+
+func readXML(r *http.Request, v interface{}) error {
+	if err := xml.NewDecoder(requestBody(r)).Decode(v); err != nil {
+		return malformedXMLError()
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package s3
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	minio "github.com/minio/minio-go/v6"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+)
+
+// withS3 runs an s3 gateway backed by a real PFS, and calls cb with a client
+// for it.
+func withS3(t *testing.T, cb func(env *testpachd.RealEnv, c *minio.Client)) {
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		server := httptest.NewServer(Router(NewMasterDriver(), func() (*client.APIClient, error) {
+			return env.PachClient, nil
+		}))
+		defer server.Close()
+		u, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		c, err := minio.New(u.Host, "", "", false)
+		require.NoError(t, err)
+		cb(env, c)
+		return nil
+	}))
+}
+
+func getObject(t *testing.T, c *minio.Client, bucket, key string, opts minio.GetObjectOptions) string {
+	obj, err := c.GetObject(bucket, key, opts)
+	require.NoError(t, err)
+	defer obj.Close()
+	data, err := ioutil.ReadAll(obj)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestBuckets(t *testing.T) {
+	withS3(t, func(env *testpachd.RealEnv, c *minio.Client) {
+		require.NoError(t, c.MakeBucket("master.repo", ""))
+		require.YesError(t, c.MakeBucket("master.repo", ""))
+		require.NoError(t, c.MakeBucket("branch.repo", ""))
+		buckets, err := c.ListBuckets()
+		require.NoError(t, err)
+		var names []string
+		for _, bucket := range buckets {
+			names = append(names, bucket.Name)
+		}
+		require.ElementsEqual(t, []string{"master.repo", "branch.repo"}, names)
+		exists, err := c.BucketExists("master.repo")
+		require.NoError(t, err)
+		require.True(t, exists)
+		exists, err = c.BucketExists("other.repo")
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		// The repo is deleted with its last branch.
+		require.NoError(t, c.RemoveBucket("branch.repo"))
+		_, err = env.PachClient.InspectRepo("repo")
+		require.NoError(t, err)
+		require.NoError(t, c.RemoveBucket("master.repo"))
+		_, err = env.PachClient.InspectRepo("repo")
+		require.YesError(t, err)
+	})
+}
+
+func TestObjects(t *testing.T) {
+	withS3(t, func(env *testpachd.RealEnv, c *minio.Client) {
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		require.NoError(t, env.PachClient.PutFile("repo", "master", "pfsfile", strings.NewReader("from pfs")))
+		require.Equal(t, "from pfs", getObject(t, c, "master.repo", "pfsfile", minio.GetObjectOptions{}))
+
+		content := "0123456789"
+		_, err := c.PutObject("master.repo", "dir/file", strings.NewReader(content), int64(len(content)), minio.PutObjectOptions{})
+		require.NoError(t, err)
+		require.Equal(t, content, getObject(t, c, "master.repo", "dir/file", minio.GetObjectOptions{}))
+		info, err := c.StatObject("master.repo", "dir/file", minio.StatObjectOptions{})
+		require.NoError(t, err)
+		require.Equal(t, int64(len(content)), info.Size)
+
+		opts := minio.GetObjectOptions{}
+		require.NoError(t, opts.SetRange(2, 5))
+		require.Equal(t, "2345", getObject(t, c, "master.repo", "dir/file", opts))
+
+		// Every commit is a version of the object.
+		commitInfo, err := env.PachClient.InspectCommit("repo", "master")
+		require.NoError(t, err)
+		_, err = c.PutObject("master.repo", "dir/file", strings.NewReader("new"), 3, minio.PutObjectOptions{})
+		require.NoError(t, err)
+		require.Equal(t, "new", getObject(t, c, "master.repo", "dir/file", minio.GetObjectOptions{}))
+		opts = minio.GetObjectOptions{}
+		opts.Set("versionId", commitInfo.Commit.ID)
+		require.Equal(t, content, getObject(t, c, "master.repo", "dir/file", opts))
+
+		dst, err := minio.NewDestinationInfo("master.repo", "copy", nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, c.CopyObject(dst, minio.NewSourceInfo("master.repo", "dir/file", nil)))
+		require.Equal(t, "new", getObject(t, c, "master.repo", "copy", minio.GetObjectOptions{}))
+
+		require.NoError(t, c.RemoveObject("master.repo", "copy"))
+		_, err = c.StatObject("master.repo", "copy", minio.StatObjectOptions{})
+		require.YesError(t, err)
+		require.Equal(t, "NoSuchKey", minio.ToErrorResponse(err).Code)
+		// Deleting an object that doesn't exist succeeds.
+		require.NoError(t, c.RemoveObject("master.repo", "copy"))
+	})
+}
+
+func TestListObjects(t *testing.T) {
+	withS3(t, func(env *testpachd.RealEnv, c *minio.Client) {
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		require.NoError(t, env.PachClient.WithModifyFileClient("repo", "master", func(mfc *client.ModifyFileClient) error {
+			for _, p := range []string{"a", "b/c", "b/d", "b/e/f", "g"} {
+				if err := mfc.AppendFile(p, true, strings.NewReader(p)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+		list := func(prefix string, recursive bool) []string {
+			var keys []string
+			for obj := range c.ListObjectsV2("master.repo", prefix, recursive, nil) {
+				require.NoError(t, obj.Err)
+				keys = append(keys, obj.Key)
+			}
+			return keys
+		}
+		require.Equal(t, []string{"a", "b/", "g"}, list("", false))
+		require.Equal(t, []string{"a", "b/c", "b/d", "b/e/f", "g"}, list("", true))
+		require.Equal(t, []string{"b/c", "b/d", "b/e/"}, list("b/", false))
+		require.Equal(t, []string{"b/c", "b/d", "b/e/f"}, list("b/", true))
+
+		// Listings are paginated.
+		core := minio.Core{Client: c}
+		result, err := core.ListObjectsV2("master.repo", "", "", false, "", 2, "")
+		require.NoError(t, err)
+		require.True(t, result.IsTruncated)
+		require.Equal(t, 2, len(result.Contents))
+		result, err = core.ListObjectsV2("master.repo", "", result.NextContinuationToken, false, "", 10, "")
+		require.NoError(t, err)
+		require.False(t, result.IsTruncated)
+		require.Equal(t, 3, len(result.Contents))
+		require.Equal(t, "b/e/f", result.Contents[0].Key)
+	})
+}
+
+func TestMultipartUpload(t *testing.T) {
+	withS3(t, func(env *testpachd.RealEnv, c *minio.Client) {
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		require.NoError(t, env.PachClient.CreateBranch("repo", "master", "", nil))
+		core := minio.Core{Client: c}
+		uploadID, err := core.NewMultipartUpload("master.repo", "file", minio.PutObjectOptions{})
+		require.NoError(t, err)
+		uploads, err := core.ListMultipartUploads("master.repo", "", "", "", "", 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(uploads.Uploads))
+
+		var parts []minio.CompletePart
+		var expected bytes.Buffer
+		for i := 1; i <= 3; i++ {
+			data := bytes.Repeat([]byte{byte('a' + i)}, 1024*i)
+			expected.Write(data)
+			part, err := core.PutObjectPart("master.repo", "file", uploadID, i, bytes.NewReader(data), int64(len(data)), "", "", nil)
+			require.NoError(t, err)
+			parts = append(parts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+		}
+		listed, err := core.ListObjectParts("master.repo", "file", uploadID, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(listed.ObjectParts))
+
+		// Parts must be in order.
+		_, err = core.CompleteMultipartUpload("master.repo", "file", uploadID, []minio.CompletePart{parts[1], parts[0]})
+		require.YesError(t, err)
+		_, err = core.CompleteMultipartUpload("master.repo", "file", uploadID, parts)
+		require.NoError(t, err)
+		require.Equal(t, expected.String(), getObject(t, c, "master.repo", "file", minio.GetObjectOptions{}))
+
+		// The upload is gone once it's completed.
+		_, err = core.ListObjectParts("master.repo", "file", uploadID, 0, 10)
+		require.YesError(t, err)
+		uploadID, err = core.NewMultipartUpload("master.repo", "other", minio.PutObjectOptions{})
+		require.NoError(t, err)
+		require.NoError(t, core.AbortMultipartUpload("master.repo", "other", uploadID))
+		_, err = core.ListObjectParts("master.repo", "other", uploadID, 0, 10)
+		require.YesError(t, err)
+	})
+}
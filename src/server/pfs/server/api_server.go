@@ -240,6 +240,13 @@ func (a *apiServer) ClearCommit(ctx context.Context, request *pfs.ClearCommitReq
 	return nil, a.driver.clearCommit(a.env.GetPachClient(ctx), request.Commit)
 }
 
+// BuildCommit implements the protobuf pfs.BuildCommit RPC
+func (a *apiServer) BuildCommit(ctx context.Context, request *pfs.BuildCommitRequest) (response *pfs.Commit, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.buildCommit(ctx, request)
+}
+
 // CreateBranchInTransaction is identical to CreateBranch except that it can run
 // inside an existing etcd STM transaction.  This is not an RPC.
 func (a *apiServer) CreateBranchInTransaction(txnCtx *txnenv.TransactionContext, request *pfs.CreateBranchRequest) error {
@@ -315,7 +322,11 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 			return 0, err
 		}
 		var bytesRead int64
-		if err := a.driver.modifyFile(a.env.GetPachClient(server.Context()), request.Commit, func(uw *fileset.UnorderedWriter) error {
+		pachClient := a.env.GetPachClient(server.Context())
+		s := newSplitter(func(dir string) (int64, error) {
+			return a.driver.nextSplitIndex(pachClient, request.Commit, dir)
+		})
+		if err := a.driver.modifyFile(pachClient, request.Commit, func(uw *fileset.UnorderedWriter) error {
 			for {
 				req, err := server.Recv()
 				if err != nil {
@@ -331,11 +342,11 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 					var err error
 					switch mod.AppendFile.Source.(type) {
 					case *pfs.AppendFile_RawFileSource:
-						n, err = appendFileRaw(uw, server, mod.AppendFile)
+						n, err = appendFileRaw(uw, s, server, mod.AppendFile)
 					case *pfs.AppendFile_TarFileSource:
 						n, err = appendFileTar(uw, server, mod.AppendFile)
 					case *pfs.AppendFile_UrlFileSource:
-						n, err = appendFileURL(server.Context(), uw, mod.AppendFile)
+						n, err = appendFileURL(server.Context(), uw, s, mod.AppendFile)
 					}
 					bytesRead += n
 					if err != nil {
@@ -358,13 +369,13 @@ type modifyFileSource interface {
 	Recv() (*pfs.ModifyFileRequest, error)
 }
 
-func appendFileRaw(uw *fileset.UnorderedWriter, server modifyFileSource, req *pfs.AppendFile) (int64, error) {
+func appendFileRaw(uw *fileset.UnorderedWriter, s *splitter, server modifyFileSource, req *pfs.AppendFile) (int64, error) {
 	src := req.Source.(*pfs.AppendFile_RawFileSource).RawFileSource
 	rfsr := &rawFileSourceReader{
 		server: server,
 		r:      bytes.NewReader(src.Data),
 	}
-	err := uw.Append(src.Path, req.Overwrite, rfsr, req.Tag)
+	err := appendFile(uw, s, src.Path, rfsr, req)
 	return rfsr.bytesRead, err
 }
 
@@ -393,6 +404,9 @@ func (rfsr *rawFileSourceReader) Read(data []byte) (int, error) {
 
 func appendFileTar(uw *fileset.UnorderedWriter, server modifyFileSource, req *pfs.AppendFile) (int64, error) {
 	src := req.Source.(*pfs.AppendFile_TarFileSource).TarFileSource
+	if req.Delimiter != pfs.Delimiter_NONE {
+		return 0, errors.Errorf("tar file sources cannot be split")
+	}
 	tfsr := &tarFileSourceReader{
 		server: server,
 		r:      bytes.NewReader(src.Data),
@@ -437,7 +451,7 @@ func (tfsr *tarFileSourceReader) Read(data []byte) (int, error) {
 }
 
 // TODO: Collect and return bytes read and figure out parallel download (task chain in chunk package might be helpful).
-func appendFileURL(ctx context.Context, uw *fileset.UnorderedWriter, req *pfs.AppendFile) (_ int64, retErr error) {
+func appendFileURL(ctx context.Context, uw *fileset.UnorderedWriter, s *splitter, req *pfs.AppendFile) (_ int64, retErr error) {
 	src := req.Source.(*pfs.AppendFile_UrlFileSource).UrlFileSource
 	url, err := url.Parse(src.URL)
 	if err != nil {
@@ -458,7 +472,7 @@ func appendFileURL(ctx context.Context, uw *fileset.UnorderedWriter, req *pfs.Ap
 				retErr = err
 			}
 		}()
-		return 0, uw.Append(src.Path, req.Overwrite, resp.Body, req.Tag)
+		return 0, appendFile(uw, s, src.Path, resp.Body, req)
 	default:
 		url, err := obj.ParseURL(src.URL)
 		if err != nil {
@@ -480,7 +494,7 @@ func appendFileURL(ctx context.Context, uw *fileset.UnorderedWriter, req *pfs.Ap
 						retErr = err
 					}
 				}()
-				return uw.Append(filepath.Join(src.Path, strings.TrimPrefix(name, path)), req.Overwrite, r, req.Tag)
+				return appendFile(uw, s, filepath.Join(src.Path, strings.TrimPrefix(name, path)), r, req)
 			})
 		}
 		r, err := objClient.Reader(ctx, url.Object, 0, 0)
@@ -492,7 +506,7 @@ func appendFileURL(ctx context.Context, uw *fileset.UnorderedWriter, req *pfs.Ap
 				retErr = err
 			}
 		}()
-		return 0, uw.Append(src.Path, req.Overwrite, r, req.Tag)
+		return 0, appendFile(uw, s, src.Path, r, req)
 	}
 }
 
@@ -519,7 +533,7 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 		commit := request.File.Commit
 		glob := request.File.Path
 		gfw := newGetFileWriter(grpcutil.NewStreamingBytesWriter(server))
-		err := a.driver.getFile(a.env.GetPachClient(server.Context()), commit, glob, gfw)
+		err := a.driver.getFile(a.env.GetPachClient(server.Context()), commit, glob, request.OffsetBytes, request.SizeBytes, gfw)
 		return gfw.bytesWritten, err
 	})
 }
@@ -553,7 +567,7 @@ func (a *apiServer) ListFile(request *pfs.ListFileRequest, server pfs.API_ListFi
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listFile(a.env.GetPachClient(server.Context()), request.File, request.Full, func(fi *pfs.FileInfo) error {
+	return a.driver.listFile(a.env.GetPachClient(server.Context()), request.File, request.Full, request.History, func(fi *pfs.FileInfo) error {
 		sent++
 		return server.Send(fi)
 	})
@@ -621,15 +635,65 @@ func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d messages", sent), retErr, time.Since(start))
 	}(time.Now())
-	if err := a.driver.fsck(a.env.GetPachClient(fsckServer.Context()), request.Fix, func(resp *pfs.FsckResponse) error {
+	pachClient := a.env.GetPachClient(fsckServer.Context())
+	cb := func(resp *pfs.FsckResponse) error {
 		sent++
 		return fsckServer.Send(resp)
-	}); err != nil {
+	}
+	if err := a.driver.fsck(pachClient, request.Fix, cb); err != nil {
 		return err
 	}
+	if request.Storage {
+		return a.driver.fsckStorage(pachClient, request.VerifyContent, request.Fix, cb)
+	}
 	return nil
 }
 
+// GarbageCollect implements the protobuf pfs.GarbageCollect RPC
+func (a *apiServer) GarbageCollect(ctx context.Context, request *pfs.GarbageCollectRequest) (response *pfs.GarbageCollectResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.garbageCollect(ctx, request.DryRun)
+}
+
+// RewrapDataKeys implements the protobuf pfs.RewrapDataKeys RPC
+func (a *apiServer) RewrapDataKeys(ctx context.Context, request *pfs.RewrapDataKeysRequest) (response *pfs.RewrapDataKeysResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	n, err := a.driver.storage.ChunkStorage().RewrapDataKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.RewrapDataKeysResponse{Rewrapped: int64(n)}, nil
+}
+
+// ReconcileStorage implements the protobuf pfs.ReconcileStorage RPC
+func (a *apiServer) ReconcileStorage(request *pfs.ReconcileStorageRequest, server pfs.API_ReconcileStorageServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	sent := 0
+	defer func(start time.Time) {
+		a.Log(request, fmt.Sprintf("stream containing %d messages", sent), retErr, time.Since(start))
+	}(time.Now())
+	gracePeriod := time.Duration(request.GracePeriodSeconds) * time.Second
+	return a.driver.reconcileStorage(server.Context(), gracePeriod, request.Fix, func(resp *pfs.ReconcileStorageResponse) error {
+		sent++
+		return server.Send(resp)
+	})
+}
+
+// MigrateV1 implements the protobuf pfs.MigrateV1 RPC
+func (a *apiServer) MigrateV1(request *pfs.MigrateV1Request, server pfs.API_MigrateV1Server) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	sent := 0
+	defer func(start time.Time) {
+		a.Log(request, fmt.Sprintf("stream containing %d messages", sent), retErr, time.Since(start))
+	}(time.Now())
+	return a.driver.migrateV1(server.Context(), request.V1StorageRoot, func(resp *pfs.MigrateV1Response) error {
+		sent++
+		return server.Send(resp)
+	})
+}
+
 // CreateFileset implements the pfs.CreateFileset RPC
 func (a *apiServer) CreateFileset(server pfs.API_CreateFilesetServer) error {
 	fsID, err := a.driver.createFileset(server)
@@ -21,6 +21,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
@@ -46,6 +47,7 @@ const (
 const (
 	storageTaskNamespace = "storage"
 	tmpRepo              = client.TmpRepoName
+	quarantinePrefix     = "__quarantine__"
 	defaultTTL           = client.DefaultTTL
 	maxTTL               = 30 * time.Minute
 )
@@ -85,6 +87,11 @@ type driver struct {
 	storage         *fileset.Storage
 	compactionQueue *work.TaskQueue
 
+	// db records the progress of migrating V1 commits, and objClient reads
+	// the V1 data.
+	db        *sqlx.DB
+	objClient obj.Client
+
 	// TODO: remove this. It prevents flakiness when running on macOS (millisecond resolution timestamps)
 	nonce uint64
 }
@@ -110,6 +117,8 @@ func newDriver(env *serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPr
 			return pfsdb.Branches(etcdClient, etcdPrefix, repo)
 		},
 		openCommits: pfsdb.OpenCommits(etcdClient, etcdPrefix),
+		db:          db,
+		objClient:   objClient,
 		// TODO: set maxFanIn based on downward API.
 	}
 	// Setup tracker and chunk / fileset storage.
@@ -121,7 +130,20 @@ func newDriver(env *serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPr
 	chunkStorage := chunk.NewStorage(objClient, chunk.NewPostgresStore(db), tracker, chunkStorageOpts...)
 	d.storage = fileset.NewStorage(fileset.NewPostgresStore(db), tracker, chunkStorage, env.FileSetStorageOptions()...)
 	// Setup compaction queue and worker.
-	d.compactionQueue, err = work.NewTaskQueue(context.Background(), etcdClient, etcdPrefix, storageTaskNamespace)
+	if err := work.ValidateBackend(env.WorkBackend); err != nil {
+		return nil, err
+	}
+	// The compaction tasks of all pachds (and pipeline sidecars) are run by
+	// the same storage workers, which schedule them by their priority.
+	queueOpts := []work.TaskQueueOption{
+		work.WithPriority(env.StorageTaskPriority, env.StorageTaskWeight),
+		work.WithGroup(env.StorageTaskGroup),
+	}
+	if env.WorkBackend == work.PostgresBackend {
+		d.compactionQueue, err = work.NewPostgresTaskQueue(context.Background(), db, storageTaskNamespace, queueOpts...)
+	} else {
+		d.compactionQueue, err = work.NewTaskQueue(context.Background(), etcdClient, etcdPrefix, storageTaskNamespace, queueOpts...)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -240,6 +262,23 @@ func (d *driver) inspectRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo,
 	return result, nil
 }
 
+// repoKeyDomain returns the key domain that the data in a repo is encrypted
+// in. It identifies the repo by its creation time as well as its name, so a
+// repo that is deleted and recreated with the same name gets a new data key.
+func repoKeyDomain(repoInfo *pfs.RepoInfo) string {
+	created := repoInfo.GetCreated()
+	return fmt.Sprintf("%s@%d.%09d", repoInfo.Repo.Name, created.GetSeconds(), created.GetNanos())
+}
+
+// keyDomain returns the key domain of repo (see repoKeyDomain).
+func (d *driver) keyDomain(ctx context.Context, repo *pfs.Repo) (string, error) {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo.Name, repoInfo); err != nil {
+		return "", err
+	}
+	return repoKeyDomain(repoInfo), nil
+}
+
 func (d *driver) getAccessLevel(pachClient *client.APIClient, repo *pfs.Repo) (auth.Scope, error) {
 	ctx := pachClient.Ctx()
 	who, err := pachClient.AuthAPIClient.WhoAmI(ctx, &auth.WhoAmIRequest{})
@@ -800,6 +839,11 @@ func (d *driver) finishCommit(txnCtx *txnenv.TransactionContext, commit *pfs.Com
 	if description != "" {
 		commitInfo.Description = description
 	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.Stm).Get(commit.Repo.Name, repoInfo); err != nil {
+		return err
+	}
+	keyDomain := repoKeyDomain(repoInfo)
 	commitPath := commitKey(commit)
 	// Run compaction task.
 	return d.compactionQueue.RunTaskBlock(txnCtx.Client.Ctx(), func(m *work.Master) error {
@@ -819,7 +863,7 @@ func (d *driver) finishCommit(txnCtx *txnenv.TransactionContext, commit *pfs.Com
 		}
 		if !diffExists {
 			// Compact the commit changes into a diff file set.
-			if err := d.compact(m, path.Join(commitPath, fileset.Diff), []string{commitPath}); err != nil {
+			if err := d.compact(m, path.Join(commitPath, fileset.Diff), []string{commitPath}, keyDomain); err != nil {
 				return err
 			}
 		}
@@ -839,7 +883,7 @@ func (d *driver) finishCommit(txnCtx *txnenv.TransactionContext, commit *pfs.Com
 			if err != nil {
 				return err
 			}
-			if err := d.compact(m, compactSpec.Output, compactSpec.Input); err != nil {
+			if err := d.compact(m, compactSpec.Output, compactSpec.Input, keyDomain); err != nil {
 				return err
 			}
 		}
@@ -877,6 +921,65 @@ func (d *driver) finishCommit(txnCtx *txnenv.TransactionContext, commit *pfs.Com
 	})
 }
 
+// buildCommit creates a commit with the ID, origin, provenance and timestamps
+// in the request, and applies the changes in the request's file set on top of
+// the parent commit. It is used by Restore to recreate extracted commits, so,
+// unlike startCommit, the commit's branch is only recorded in the commit info,
+// and the commit is not propagated downstream.
+func (d *driver) buildCommit(ctx context.Context, req *pfs.BuildCommitRequest) (*pfs.Commit, error) {
+	if req.Parent == nil || req.Parent.Repo == nil {
+		return nil, errors.Errorf("parent cannot be nil")
+	}
+	id := req.ID
+	if id == "" {
+		id = uuid.NewWithoutDashes()
+	}
+	commit := client.NewCommit(req.Parent.Repo.Name, id)
+	var started time.Time
+	if req.Started != nil {
+		var err error
+		started, err = types.TimestampFromProto(req.Started)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not convert 'started' time")
+		}
+	}
+	if req.FilesetId != "" {
+		subFileSetPath := path.Join(commitPath(commit), fileset.SubFileSetStr(d.getSubFileset()))
+		if err := d.storage.Copy(ctx, path.Join(tmpRepo, req.FilesetId, fileset.Compacted), subFileSetPath, 0); err != nil {
+			return nil, err
+		}
+	}
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		if _, err := d.makeCommit(txnCtx, id, req.Parent, "", req.Origin, req.Provenance, req.Description, started, time.Time{}, 0); err != nil {
+			return err
+		}
+		commits := d.commits(commit.Repo.Name).ReadWrite(txnCtx.Stm)
+		if req.Branch != "" {
+			commitInfo := &pfs.CommitInfo{}
+			if err := commits.Update(commit.ID, commitInfo, func() error {
+				commitInfo.Branch = client.NewBranch(commit.Repo.Name, req.Branch)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		if req.Finished == nil {
+			return nil
+		}
+		if err := d.finishCommit(txnCtx, commit, ""); err != nil {
+			return err
+		}
+		commitInfo := &pfs.CommitInfo{}
+		return commits.Update(commit.ID, commitInfo, func() error {
+			commitInfo.Finished = req.Finished
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
 func (d *driver) updateProvenanceProgress(txnCtx *txnenv.TransactionContext, success bool, ci *pfs.CommitInfo) error {
 	if d.env.DisableCommitProgressCounter {
 		return nil
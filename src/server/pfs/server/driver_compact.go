@@ -19,7 +19,9 @@ import (
 	"golang.org/x/net/context"
 )
 
-func (d *driver) compact(master *work.Master, outputPath string, inputPrefixes []string) error {
+// compact compacts the file sets under inputPrefixes into outputPath, with the
+// chunks written in keyDomain.
+func (d *driver) compact(master *work.Master, outputPath string, inputPrefixes []string, keyDomain string) error {
 	ctx := master.Ctx()
 	// resolve prefixes into paths
 	inputPaths := []string{}
@@ -33,7 +35,7 @@ func (d *driver) compact(master *work.Master, outputPath string, inputPrefixes [
 	}
 	// TODO: There is probably a better way to handle empty filesets.
 	if len(inputPaths) == 0 {
-		w := d.storage.NewWriter(ctx, outputPath)
+		w := d.storage.NewWriter(ctx, outputPath, fileset.WithKeyDomain(keyDomain))
 		return w.Close()
 	}
 	if len(inputPaths) == 1 {
@@ -43,6 +45,7 @@ func (d *driver) compact(master *work.Master, outputPath string, inputPrefixes [
 		res, err := d.compactIter(ctx, compactSpec{
 			master:     master,
 			inputPaths: inputPaths,
+			keyDomain:  keyDomain,
 			maxFanIn:   d.env.StorageCompactionMaxFanIn,
 		})
 		if err != nil {
@@ -56,6 +59,7 @@ func (d *driver) compact(master *work.Master, outputPath string, inputPrefixes [
 type compactSpec struct {
 	master     *work.Master
 	inputPaths []string
+	keyDomain  string
 	maxFanIn   int
 }
 
@@ -67,7 +71,7 @@ type compactResult struct {
 // if len(inputPaths) <= params.maxFanIn otherwise it will split inputPaths recursively.
 func (d *driver) compactIter(ctx context.Context, params compactSpec) (*compactResult, error) {
 	if len(params.inputPaths) <= params.maxFanIn {
-		return d.shardedCompact(ctx, params.master, params.inputPaths)
+		return d.shardedCompact(ctx, params.master, params.inputPaths, params.keyDomain)
 	}
 	childSize := params.maxFanIn
 	for len(params.inputPaths)/childSize > params.maxFanIn {
@@ -87,6 +91,7 @@ func (d *driver) compactIter(ctx context.Context, params compactSpec) (*compactR
 			res, err := d.compactIter(ctx, compactSpec{
 				master:     params.master,
 				inputPaths: params.inputPaths[start:end],
+				keyDomain:  params.keyDomain,
 				maxFanIn:   params.maxFanIn,
 			})
 			if err != nil {
@@ -96,7 +101,7 @@ func (d *driver) compactIter(ctx context.Context, params compactSpec) (*compactR
 			childOutputPaths = append(childOutputPaths, res.OutputPath)
 		}
 		var err error
-		res, err = d.shardedCompact(ctx, params.master, childOutputPaths)
+		res, err = d.shardedCompact(ctx, params.master, childOutputPaths, params.keyDomain)
 		return err
 	}); err != nil {
 		return nil, err
@@ -108,9 +113,12 @@ func (d *driver) compactIter(ctx context.Context, params compactSpec) (*compactR
 // gives those shards to workers, and waits for them to complete.
 // Fan in is bound by len(inputPaths), concatenating shards have
 // fan in of one because they are concatenated sequentially.
-func (d *driver) shardedCompact(ctx context.Context, master *work.Master, inputPaths []string) (*compactResult, error) {
+func (d *driver) shardedCompact(ctx context.Context, master *work.Master, inputPaths []string, keyDomain string) (*compactResult, error) {
 	scratch := path.Join(tmpRepo, uuid.NewWithoutDashes())
-	compaction := &pfs.Compaction{InputPrefixes: inputPaths}
+	compaction := &pfs.Compaction{
+		InputPrefixes: inputPaths,
+		KeyDomain:     keyDomain,
+	}
 	var subtasks []*work.Task
 	var shardOutputs []string
 	fs, err := d.storage.Open(ctx, inputPaths)
@@ -160,7 +168,7 @@ func (d *driver) shardedCompact(ctx context.Context, master *work.Master, inputP
 			return err
 		}
 		var err error
-		res, err = d.concatFileSets(ctx, shardOutputs)
+		res, err = d.concatFileSets(ctx, shardOutputs, keyDomain)
 		return err
 	}); err != nil {
 		return nil, err
@@ -170,9 +178,9 @@ func (d *driver) shardedCompact(ctx context.Context, master *work.Master, inputP
 
 // concatFileSets concatenates the filesets in inputPaths and writes the result to outputPath
 // TODO: move this to the fileset package, and error if the entries are not sorted.
-func (d *driver) concatFileSets(ctx context.Context, inputPaths []string) (*compactResult, error) {
+func (d *driver) concatFileSets(ctx context.Context, inputPaths []string, keyDomain string) (*compactResult, error) {
 	outputPath := path.Join(tmpRepo, uuid.NewWithoutDashes())
-	fsw := d.storage.NewWriter(ctx, outputPath, fileset.WithTTL(defaultTTL))
+	fsw := d.storage.NewWriter(ctx, outputPath, fileset.WithKeyDomain(keyDomain), fileset.WithTTL(defaultTTL))
 	for _, inputPath := range inputPaths {
 		fs, err := d.storage.Open(ctx, []string{inputPath})
 		if err != nil {
@@ -190,7 +198,12 @@ func (d *driver) concatFileSets(ctx context.Context, inputPaths []string) (*comp
 
 func (d *driver) compactionWorker() {
 	ctx := context.Background()
-	w := work.NewWorker(d.etcdClient, d.prefix, storageTaskNamespace)
+	var w *work.Worker
+	if d.env.WorkBackend == work.PostgresBackend {
+		w = work.NewPostgresWorker(d.db, storageTaskNamespace)
+	} else {
+		w = work.NewWorker(d.etcdClient, d.prefix, storageTaskNamespace)
+	}
 	err := backoff.RetryNotify(func() error {
 		return w.Run(ctx, func(ctx context.Context, subtask *work.Task) error {
 			return d.compactShard(ctx, subtask)
@@ -212,7 +225,7 @@ func (d *driver) compactShard(ctx context.Context, subtask *work.Task) error {
 		Lower: shard.Range.Lower,
 		Upper: shard.Range.Upper,
 	}
-	_, err = d.storage.Compact(ctx, shard.OutputPath, shard.Compaction.InputPrefixes, defaultTTL, index.WithRange(pathRange))
+	_, err = d.storage.Compact(ctx, shard.OutputPath, shard.Compaction.InputPrefixes, shard.Compaction.KeyDomain, defaultTTL, index.WithRange(pathRange))
 	return err
 }
 
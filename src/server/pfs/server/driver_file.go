@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bytes"
 	"io"
 	"path"
 	"path/filepath"
@@ -66,8 +67,12 @@ func (d *driver) withCommitWriter(ctx context.Context, commit *pfs.Commit, cb fu
 	n := d.getSubFileset()
 	subFileSetStr := fileset.SubFileSetStr(n)
 	subFileSetPath := path.Join(commit.Repo.Name, commit.ID, subFileSetStr)
+	keyDomain, err := d.keyDomain(ctx, commit.Repo)
+	if err != nil {
+		return err
+	}
 	return d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
-		id, err := d.withTmpUnorderedWriter(ctx, renewer, false, cb)
+		id, err := d.withTmpUnorderedWriter(ctx, renewer, keyDomain, false, cb)
 		if err != nil {
 			return err
 		}
@@ -83,10 +88,15 @@ func (d *driver) getSubFileset() int64 {
 	return millis + int64(nonce%1e6)
 }
 
-func (d *driver) withTmpUnorderedWriter(ctx context.Context, renewer *renew.StringSet, compact bool, cb func(*fileset.UnorderedWriter) error) (string, error) {
+// withTmpUnorderedWriter calls cb with an unordered writer for a temporary file set.
+// The chunks written are in the passed in key domain, or the default key domain if it is empty.
+func (d *driver) withTmpUnorderedWriter(ctx context.Context, renewer *renew.StringSet, keyDomain string, compact bool, cb func(*fileset.UnorderedWriter) error) (string, error) {
 	id := uuid.NewWithoutDashes()
 	inputPath := path.Join(tmpRepo, id)
 	opts := []fileset.UnorderedWriterOption{fileset.WithRenewal(defaultTTL, renewer)}
+	if keyDomain != "" {
+		opts = append(opts, fileset.WithUnorderedKeyDomain(keyDomain))
+	}
 	defaultTag := fileset.SubFileSetStr(d.getSubFileset())
 	uw, err := d.storage.NewUnorderedWriter(ctx, inputPath, defaultTag, opts...)
 	if err != nil {
@@ -100,7 +110,7 @@ func (d *driver) withTmpUnorderedWriter(ctx context.Context, renewer *renew.Stri
 	}
 	if compact {
 		outputPath := path.Join(tmpRepo, id, fileset.Compacted)
-		_, err := d.storage.Compact(ctx, outputPath, []string{inputPath}, defaultTTL)
+		_, err := d.storage.Compact(ctx, outputPath, []string{inputPath}, keyDomain, defaultTTL)
 		if err != nil {
 			return "", err
 		}
@@ -119,16 +129,57 @@ func (d *driver) withWriter(pachClient *client.APIClient, commit *pfs.Commit, cb
 		return pfsserver.ErrCommitFinished{commitInfo.Commit}
 	}
 	commit = commitInfo.Commit
+	keyDomain, err := d.keyDomain(ctx, commit.Repo)
+	if err != nil {
+		return err
+	}
 	n := d.getSubFileset()
 	subFileSetStr := fileset.SubFileSetStr(n)
 	subFileSetPath := path.Join(commit.Repo.Name, commit.ID, subFileSetStr)
-	fsw := d.storage.NewWriter(ctx, subFileSetPath)
+	fsw := d.storage.NewWriter(ctx, subFileSetPath, fileset.WithKeyDomain(keyDomain))
 	if err := cb(subFileSetStr, fsw); err != nil {
 		return err
 	}
 	return fsw.Close()
 }
 
+// nextSplitIndex returns the index of the next file that split data should be
+// written to in dir when modifying commit, which is one more than the index of
+// the last numbered file in dir. Deleted files are not taken into account, so
+// indexes are never reused within a commit.
+func (d *driver) nextSplitIndex(pachClient *client.APIClient, commit *pfs.Commit, dir string) (int64, error) {
+	ctx := pachClient.Ctx()
+	commitInfo, err := d.inspectCommit(pachClient, commit, pfs.CommitState_STARTED)
+	if err != nil {
+		if isNotFoundErr(err) || isNoHeadErr(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	// Files are written to a new commit if the commit is finished (see
+	// modifyFile), so the finished commit is all that needs to be read.
+	// Otherwise, the files written to the open commit so far are read along
+	// with its parent.
+	fs, err := d.openCommit(ctx, commitInfo, index.WithPrefix(strings.TrimSuffix(dir, "/")+"/"))
+	if err != nil || fs == nil {
+		return 0, err
+	}
+	var next int64
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		p := f.Index().Path
+		if path.Dir(p) != dir {
+			return nil
+		}
+		if i, ok := parseSplitFileName(path.Base(p)); ok && i >= next {
+			next = i + 1
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return next, nil
+}
+
 func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.File, overwrite bool) (retErr error) {
 	ctx := pachClient.Ctx()
 	srcCommitInfo, err := d.inspectCommit(pachClient, src.Commit, pfs.CommitState_STARTED)
@@ -181,21 +232,28 @@ func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.
 	})
 }
 
-func (d *driver) getFile(pachClient *client.APIClient, commit *pfs.Commit, glob string, w io.Writer) error {
+func (d *driver) getFile(pachClient *client.APIClient, commit *pfs.Commit, glob string, offsetBytes, sizeBytes int64, w io.Writer) error {
 	ctx := pachClient.Ctx()
+	if offsetBytes < 0 || sizeBytes < 0 {
+		return errors.Errorf("offset (%d) and size (%d) cannot be negative", offsetBytes, sizeBytes)
+	}
 	commitInfo, err := d.inspectCommit(pachClient, commit, pfs.CommitState_STARTED)
 	if err != nil {
 		return err
 	}
-	if commitInfo.Finished == nil {
-		return pfsserver.ErrCommitNotFinished{commitInfo.Commit}
-	}
-	commit = commitInfo.Commit
 	indexOpt, mf, err := parseGlob(glob)
 	if err != nil {
 		return err
 	}
-	fs, err := d.storage.Open(ctx, []string{compactedCommitPath(commit)}, indexOpt)
+	// Open commits can be read, and their files are the ones written to them
+	// so far.
+	fs, err := d.openCommit(ctx, commitInfo, indexOpt)
+	if err != nil || fs == nil {
+		return err
+	}
+	// The files matched by the glob are in directories whose paths start with
+	// the directory of its literal prefix.
+	sd, err := d.readSplitData(ctx, commitInfo, path.Dir(globLiteralPrefix(cleanPath(glob))))
 	if err != nil {
 		return err
 	}
@@ -219,7 +277,35 @@ func (d *driver) getFile(pachClient *client.APIClient, commit *pfs.Commit, glob
 	// 		return th
 	// 	},
 	// }
-	return fileset.WriteTarStream(ctx, w, filter)
+	return sd.writeTarStreamRange(ctx, w, filter, offsetBytes, sizeBytes)
+}
+
+// openCommit opens the commit's file set for reading. Finished commits are
+// read from their compacted file set, and open commits are read from the
+// files written to them so far on top of their parent's compacted file set.
+// A nil file set is returned if the commit does not have any files yet.
+func (d *driver) openCommit(ctx context.Context, commitInfo *pfs.CommitInfo, opts ...index.Option) (fileset.FileSet, error) {
+	prefixes := []string{compactedCommitPath(commitInfo.Commit)}
+	if commitInfo.Finished == nil {
+		// File sets are ordered with priority from least to greatest.
+		prefixes = []string{commitPath(commitInfo.Commit)}
+		if commitInfo.ParentCommit != nil {
+			prefixes = append([]string{compactedCommitPath(commitInfo.ParentCommit)}, prefixes...)
+		}
+	}
+	var fileSets []string
+	for _, prefix := range prefixes {
+		if err := d.storage.Store().Walk(ctx, prefix, func(p string) error {
+			fileSets = append(fileSets, p)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(fileSets) == 0 {
+		return nil, nil
+	}
+	return d.storage.Open(ctx, fileSets, opts...)
 }
 
 func (d *driver) inspectFile(pachClient *client.APIClient, file *pfs.File) (*pfs.FileInfo, error) {
@@ -260,7 +346,10 @@ func (d *driver) inspectFile(pachClient *client.APIClient, file *pfs.File) (*pfs
 	return ret, nil
 }
 
-func (d *driver) listFile(pachClient *client.APIClient, file *pfs.File, full bool, cb func(*pfs.FileInfo) error) error {
+func (d *driver) listFile(pachClient *client.APIClient, file *pfs.File, full bool, history int64, cb func(*pfs.FileInfo) error) error {
+	if history != 0 {
+		return d.listFileHistory(pachClient, file, history, cb)
+	}
 	if _, err := d.inspectCommit(pachClient, file.Commit, pfs.CommitState_FINISHED); err != nil {
 		return err
 	}
@@ -301,6 +390,74 @@ func (d *driver) listFile(pachClient *client.APIClient, file *pfs.File, full boo
 	})
 }
 
+// listFileHistory calls cb with up to history versions (or all versions, if
+// history is negative) of each file that listFile returns, newest first. The
+// versions of a file are found by walking the commit's ancestry and comparing
+// the file's hash, and each version's FileInfo is from the oldest commit in the
+// run of ancestors with that hash, which is the commit it was last modified in.
+func (d *driver) listFileHistory(pachClient *client.APIClient, file *pfs.File, history int64, cb func(*pfs.FileInfo) error) error {
+	commitInfo, err := d.inspectCommit(pachClient, file.Commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return err
+	}
+	var paths []string
+	current := make(map[string]*pfs.FileInfo)
+	if err := d.listFile(pachClient, file, true, 0, func(fi *pfs.FileInfo) error {
+		fi.Committed = commitInfo.Finished
+		paths = append(paths, fi.File.Path)
+		current[fi.File.Path] = fi
+		return nil
+	}); err != nil {
+		return err
+	}
+	versions := make(map[string][]*pfs.FileInfo)
+	for len(current) > 0 && commitInfo.ParentCommit != nil {
+		commitInfo, err = d.inspectCommit(pachClient, commitInfo.ParentCommit, pfs.CommitState_STARTED)
+		if err != nil {
+			return err
+		}
+		if commitInfo.Finished == nil {
+			// Unfinished commits can't be read, so their changes are attributed
+			// to the next finished commit.
+			continue
+		}
+		parent := make(map[string]*pfs.FileInfo)
+		parentFile := client.NewFile(commitInfo.Commit.Repo.Name, commitInfo.Commit.ID, file.Path)
+		if err := d.listFile(pachClient, parentFile, true, 0, func(fi *pfs.FileInfo) error {
+			fi.Committed = commitInfo.Finished
+			parent[fi.File.Path] = fi
+			return nil
+		}); err != nil {
+			return err
+		}
+		next := make(map[string]*pfs.FileInfo)
+		for p, fi := range current {
+			parentFi, ok := parent[p]
+			if ok && parentFi.FileType == fi.FileType && bytes.Equal(parentFi.Hash, fi.Hash) {
+				// The file was not modified in the current commit.
+				next[p] = parentFi
+				continue
+			}
+			versions[p] = append(versions[p], fi)
+			if ok && (history < 0 || int64(len(versions[p])) < history) {
+				next[p] = parentFi
+			}
+		}
+		current = next
+	}
+	for p, fi := range current {
+		versions[p] = append(versions[p], fi)
+	}
+	for _, p := range paths {
+		for _, fi := range versions[p] {
+			if err := cb(fi); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (d *driver) walkFile(pachClient *client.APIClient, file *pfs.File, cb func(*pfs.FileInfo) error) (retErr error) {
 	ctx := pachClient.Ctx()
 	commitInfo, err := d.inspectCommit(pachClient, file.Commit, pfs.CommitState_STARTED)
@@ -436,7 +593,9 @@ func (d *driver) createFileset(server pfs.API_CreateFilesetServer) (string, erro
 	var id string
 	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
 		var err error
-		id, err = d.withTmpUnorderedWriter(ctx, renewer, true, func(uw *fileset.UnorderedWriter) error {
+		id, err = d.withTmpUnorderedWriter(ctx, renewer, "", true, func(uw *fileset.UnorderedWriter) error {
+			// The fileset starts out empty, so split files are numbered from 0.
+			s := newSplitter(nil)
 			for {
 				req, err := server.Recv()
 				if err != nil {
@@ -451,15 +610,19 @@ func (d *driver) createFileset(server pfs.API_CreateFilesetServer) (string, erro
 					var err error
 					switch mod.AppendFile.Source.(type) {
 					case *pfs.AppendFile_RawFileSource:
-						_, err = appendFileRaw(uw, server, mod.AppendFile)
+						_, err = appendFileRaw(uw, s, server, mod.AppendFile)
 					case *pfs.AppendFile_TarFileSource:
 						_, err = appendFileTar(uw, server, mod.AppendFile)
 					case *pfs.AppendFile_UrlFileSource:
-						_, err = appendFileURL(server.Context(), uw, mod.AppendFile)
+						_, err = appendFileURL(server.Context(), uw, s, mod.AppendFile)
 					}
 					if err != nil {
 						return err
 					}
+				case *pfs.ModifyFileRequest_DeleteFile:
+					if err := deleteFile(uw, mod.DeleteFile); err != nil {
+						return err
+					}
 				}
 			}
 		})
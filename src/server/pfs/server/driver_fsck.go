@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"path"
 	"strings"
@@ -12,6 +13,9 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 )
 
 func equalBranches(a, b []*pfs.Branch) bool {
@@ -585,3 +589,158 @@ func (d *driver) fsck(pachClient *client.APIClient, fix bool, cb func(*pfs.FsckR
 	}
 	return nil
 }
+
+// ErrStorageCorruption A file set of a commit references data that is missing or corrupted in chunk storage.
+// This struct contains all the information that was used to demonstrate that this invariant is not being satisfied.
+type ErrStorageCorruption struct {
+	Commit  *pfs.Commit
+	FileSet string
+	// Path is the path of the file that references the data, it is empty if
+	// the file set's index references the data.
+	Path string
+	Err  error
+}
+
+func (e ErrStorageCorruption) Error() string {
+	var msg strings.Builder
+	msg.WriteString("storage error: " + e.Err.Error() + "\n")
+	msg.WriteString("commit " + e.Commit.ID + " in repo " + e.Commit.Repo.Name + "\n")
+	if e.Path != "" {
+		msg.WriteString("file " + e.Path + "\n")
+	} else {
+		msg.WriteString("index of file set " + e.FileSet + "\n")
+	}
+	return msg.String()
+}
+
+// fsckStorage verifies that the data referenced by the file sets of every
+// commit exists in chunk storage and is tracked. If verifyContent is true, it
+// also verifies that the data hashes correctly.
+// If fix is true, the files that reference missing or corrupted data are
+// quarantined: they are removed from the commit, and the commit's original
+// file sets are kept under the quarantine prefix.
+func (d *driver) fsckStorage(pachClient *client.APIClient, verifyContent, fix bool, cb func(*pfs.FsckResponse) error) error {
+	if _, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{}); err != nil {
+		if !auth.IsErrNotActivated(err) {
+			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error authenticating (must log in to run fsck)")
+		}
+	}
+	ctx := pachClient.Ctx()
+	onError := func(err error) error { return cb(&pfs.FsckResponse{Error: err.Error()}) }
+	onFix := func(fix string) error { return cb(&pfs.FsckResponse{Fix: fix}) }
+	checker := d.storage.ChunkStorage().NewChecker(verifyContent)
+	// The commits are checked as they are listed, rather than collected first,
+	// so the check does not need to hold every commit in memory.
+	repoInfo := &pfs.RepoInfo{}
+	return d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions, func(repoName string) error {
+		keyDomain := repoKeyDomain(repoInfo)
+		commitInfo := &pfs.CommitInfo{}
+		return d.commits(repoName).ReadOnly(ctx).List(commitInfo, col.DefaultOptions, func(string) error {
+			return d.fsckCommitStorage(ctx, checker, commitInfo, keyDomain, fix, onError, onFix)
+		})
+	})
+}
+
+// fsckCommitStorage checks the data referenced by the file sets of a commit,
+// and quarantines the files that reference missing or corrupted data if fix is
+// true. The file sets that replace the commit's file sets are written in
+// keyDomain.
+func (d *driver) fsckCommitStorage(ctx context.Context, checker *chunk.Checker, ci *pfs.CommitInfo, keyDomain string, fix bool, onError func(error) error, onFix func(string) error) error {
+	// Only the file sets that the commit is read from are checked, which
+	// are the compacted file sets once the commit is finished.
+	prefix := compactedCommitPath(ci.Commit)
+	if ci.Finished == nil {
+		prefix = commitPath(ci.Commit)
+	}
+	affected := make(map[string]bool)
+	var indexCorrupted bool
+	if err := d.storage.Store().Walk(ctx, prefix, func(p string) error {
+		return d.fsckFileSet(ctx, checker, p, func(filePath string, err error) error {
+			if filePath == "" {
+				indexCorrupted = true
+			}
+			affected[filePath] = true
+			return onError(ErrStorageCorruption{
+				Commit:  ci.Commit,
+				FileSet: p,
+				Path:    filePath,
+				Err:     err,
+			})
+		})
+	}); err != nil {
+		return err
+	}
+	if !fix || len(affected) == 0 {
+		return nil
+	}
+	if ci.Finished == nil {
+		return onError(errors.Errorf("cannot quarantine files in commit %s@%s, because it is not finished", ci.Commit.Repo.Name, ci.Commit.ID))
+	}
+	if indexCorrupted {
+		return onError(errors.Errorf("cannot quarantine files in commit %s@%s, because its file set index is corrupted", ci.Commit.Repo.Name, ci.Commit.ID))
+	}
+	quarantinePath := path.Join(quarantinePrefix, commitPath(ci.Commit), uuid.NewWithoutDashes())
+	if err := d.storage.Quarantine(ctx, prefix, quarantinePath, keyDomain, defaultTTL, func(idx *index.Index) bool {
+		return affected[idx.Path]
+	}); err != nil {
+		return err
+	}
+	for filePath := range affected {
+		if err := onFix(fmt.Sprintf(
+			"quarantined file %s in commit %s@%s, the original file set is at %s",
+			filePath, ci.Commit.Repo.Name, ci.Commit.ID, quarantinePath),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fsckFileSet checks the data referenced by a file set, calling onCorrupt for
+// each file that references missing or corrupted data.
+func (d *driver) fsckFileSet(ctx context.Context, checker *chunk.Checker, p string, onCorrupt func(string, error) error) error {
+	md, err := d.storage.Store().Get(ctx, p)
+	if err != nil {
+		return err
+	}
+	for _, topIdx := range []*index.Index{md.Additive, md.Deletive} {
+		if topIdx == nil {
+			continue
+		}
+		if topIdx.Range != nil {
+			if err := checker.Check(ctx, topIdx.Range.ChunkRef); err != nil {
+				if err := onCorrupt("", chunkError(topIdx.Range.ChunkRef, err)); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		// Errors from onCorrupt are returned as is, any other error from
+		// iterating means the lower levels of the index could not be read.
+		var cbErr error
+		if err := index.NewReader(d.storage.ChunkStorage(), topIdx).Iterate(ctx, func(idx *index.Index) error {
+			if idx.File == nil {
+				return nil
+			}
+			for _, dataRef := range idx.File.DataRefs {
+				if err := checker.Check(ctx, dataRef); err != nil {
+					cbErr = onCorrupt(idx.Path, chunkError(dataRef, err))
+					return cbErr
+				}
+			}
+			return nil
+		}); err != nil {
+			if cbErr != nil {
+				return cbErr
+			}
+			if err := onCorrupt("", errors.Wrapf(err, "error reading index")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func chunkError(dataRef *chunk.DataRef, err error) error {
+	return errors.Wrapf(err, "chunk %v", chunk.ID(dataRef.Ref.Id).HexString())
+}
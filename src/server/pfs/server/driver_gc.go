@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset"
+)
+
+// garbageCollect runs a garbage collection cycle and reports the number of
+// objects and bytes that were (or, if dryRun is true, would be) reclaimed.
+func (d *driver) garbageCollect(ctx context.Context, dryRun bool) (*pfs.GarbageCollectResponse, error) {
+	resp := &pfs.GarbageCollectResponse{
+		Chunks:      &pfs.GarbageCollectStats{},
+		FileSets:    &pfs.GarbageCollectStats{},
+		TmpFileSets: &pfs.GarbageCollectStats{},
+	}
+	if err := d.storage.GCReport(ctx, dryRun, func(id string, size int64) error {
+		var stats *pfs.GarbageCollectStats
+		switch {
+		case strings.HasPrefix(id, chunk.TrackerPrefix):
+			stats = resp.Chunks
+		case strings.HasPrefix(id, fileset.TrackerPrefix+tmpRepo+"/"):
+			stats = resp.TmpFileSets
+		case strings.HasPrefix(id, fileset.TrackerPrefix):
+			stats = resp.FileSets
+		default:
+			// Renewer handles do not reference any data.
+			return nil
+		}
+		stats.Objects++
+		stats.Bytes += size
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
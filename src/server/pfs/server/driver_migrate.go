@@ -0,0 +1,261 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/migrations"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/renew"
+	"golang.org/x/sync/errgroup"
+)
+
+// migrateV1 converts the hash trees of the finished commits written by V1 into
+// V2 file sets, then verifies the file sets against the V1 data. The repo,
+// commit and branch metadata is shared by V1 and V2, so the commit IDs,
+// provenance and branch heads are kept as is. A V1 hash tree holds the entire
+// file system of a commit, so it is written as the compacted file set of the
+// commit. The progress of the migration is recorded per commit, so it can be
+// resumed.
+func (d *driver) migrateV1(ctx context.Context, v1StorageRoot string, cb func(*pfs.MigrateV1Response) error) error {
+	if v1StorageRoot == "" {
+		v1StorageRoot = d.v1StorageRoot()
+	}
+	tmpDir, err := ioutil.TempDir("", "v1-migration")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	v1 := migrations.NewV1Storage(d.objClient, v1StorageRoot, tmpDir)
+	var commitInfos []*pfs.CommitInfo
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions, func(repoName string) error {
+		commitInfo := &pfs.CommitInfo{}
+		return d.commits(repoName).ReadOnly(ctx).List(commitInfo, col.DefaultOptions, func(string) error {
+			commitInfos = append(commitInfos, proto.Clone(commitInfo).(*pfs.CommitInfo))
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, commitInfo := range commitInfos {
+		// Open commits do not have a hash tree, they are finished by V2.
+		if commitInfo.Finished == nil {
+			continue
+		}
+		commit := commitInfo.Commit
+		migrated, verified, err := migrations.V1CommitState(ctx, d.db, commit.Repo.Name, commit.ID)
+		if err != nil {
+			return err
+		}
+		if !migrated {
+			isV1, err := d.isV1Commit(ctx, commitInfo)
+			if err != nil {
+				return err
+			}
+			if !isV1 {
+				continue
+			}
+			files, size, err := d.migrateV1Commit(ctx, v1, commitInfo)
+			if err != nil {
+				return errors.Wrapf(err, "error migrating commit %s@%s", commit.Repo.Name, commit.ID)
+			}
+			if err := migrations.MarkV1CommitMigrated(ctx, d.db, commit.Repo.Name, commit.ID); err != nil {
+				return err
+			}
+			if err := cb(&pfs.MigrateV1Response{
+				Commit:    commit,
+				State:     pfs.MigrateV1State_MIGRATED,
+				Files:     files,
+				SizeBytes: size,
+			}); err != nil {
+				return err
+			}
+		}
+		if !verified {
+			files, size, err := d.verifyV1Commit(ctx, v1, commitInfo)
+			if err != nil {
+				return errors.Wrapf(err, "error verifying commit %s@%s", commit.Repo.Name, commit.ID)
+			}
+			if err := migrations.MarkV1CommitVerified(ctx, d.db, commit.Repo.Name, commit.ID); err != nil {
+				return err
+			}
+			if err := cb(&pfs.MigrateV1Response{
+				Commit:    commit,
+				State:     pfs.MigrateV1State_VERIFIED,
+				Files:     files,
+				SizeBytes: size,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// v1StorageRoot returns the root that the V1 object API stored its data
+// under, which is the storage root (without a leading slash for the S3
+// compatible backends).
+func (d *driver) v1StorageRoot() string {
+	root := d.env.StorageRoot
+	switch d.env.StorageBackend {
+	case MinioBackendEnvVar, AmazonBackendEnvVar:
+		if len(root) > 0 && root[0] == '/' {
+			root = root[1:]
+		}
+	}
+	return root
+}
+
+// isV1Commit returns true if a finished commit was written by V1, and has not
+// been migrated yet. Commits written by V1 either have a hash tree, or no data
+// at all (no compacted file set).
+func (d *driver) isV1Commit(ctx context.Context, commitInfo *pfs.CommitInfo) (bool, error) {
+	tree, trees, err := migrations.V1Trees(commitInfo)
+	if err != nil {
+		return false, err
+	}
+	if tree != nil || len(trees) > 0 {
+		return true, nil
+	}
+	compacted, err := d.filesetExists(ctx, compactedCommitPath(commitInfo.Commit))
+	if err != nil {
+		return false, err
+	}
+	return !compacted, nil
+}
+
+func (d *driver) filesetExists(ctx context.Context, p string) (bool, error) {
+	var exists bool
+	if err := d.storage.Store().Walk(ctx, p, func(_ string) error {
+		exists = true
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// migrateV1Commit writes the files in the hash tree of a V1 commit to the
+// compacted file set of the commit. The content of the files is streamed from
+// the V1 objects and blocks into the chunks of the file set.
+func (d *driver) migrateV1Commit(ctx context.Context, v1 *migrations.V1Storage, commitInfo *pfs.CommitInfo) (int64, int64, error) {
+	commit := commitInfo.Commit
+	// The compacted file set is written by an interrupted migration of the
+	// commit if it was interrupted before the commit was marked as migrated.
+	// The file set is checked when the commit is verified.
+	compacted, err := d.filesetExists(ctx, compactedCommitPath(commit))
+	if err != nil {
+		return 0, 0, err
+	}
+	if compacted {
+		return 0, 0, nil
+	}
+	keyDomain, err := d.keyDomain(ctx, commit.Repo)
+	if err != nil {
+		return 0, 0, err
+	}
+	var files, size int64
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
+		id, err := d.withTmpUnorderedWriter(ctx, renewer, keyDomain, true, func(uw *fileset.UnorderedWriter) error {
+			return v1.WalkFiles(ctx, commitInfo, func(file *migrations.V1File) error {
+				h := newContentHash()
+				pr, pw := io.Pipe()
+				var eg errgroup.Group
+				eg.Go(func() error {
+					err := v1.WriteFile(ctx, file, io.MultiWriter(pw, h))
+					pw.CloseWithError(err)
+					return err
+				})
+				eg.Go(func() error {
+					err := uw.Append(file.Path, false, pr)
+					pr.CloseWithError(err)
+					return err
+				})
+				if err := eg.Wait(); err != nil {
+					return err
+				}
+				files++
+				size += h.size
+				return nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+		return d.storage.Copy(ctx, path.Join(tmpRepo, id, fileset.Compacted), compactedCommitPath(commit), 0)
+	}); err != nil {
+		return 0, 0, err
+	}
+	return files, size, nil
+}
+
+// verifyV1Commit checks that the files in the compacted file set of a
+// migrated V1 commit have the same content as the files in its hash tree.
+func (d *driver) verifyV1Commit(ctx context.Context, v1 *migrations.V1Storage, commitInfo *pfs.CommitInfo) (int64, int64, error) {
+	commit := commitInfo.Commit
+	v2Hashes := make(map[string]*contentHash)
+	fs, err := d.storage.Open(ctx, []string{compactedCommitPath(commit)})
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		h := newContentHash()
+		if err := f.Content(h); err != nil {
+			return err
+		}
+		v2Hashes[f.Index().Path] = h
+		return nil
+	}); err != nil {
+		return 0, 0, err
+	}
+	var files, size int64
+	if err := v1.WalkFiles(ctx, commitInfo, func(file *migrations.V1File) error {
+		h := newContentHash()
+		if err := v1.WriteFile(ctx, file, h); err != nil {
+			return err
+		}
+		p := fileset.Clean(file.Path, false)
+		v2Hash, ok := v2Hashes[p]
+		if !ok {
+			return errors.Errorf("file %s is missing from the migrated file set", p)
+		}
+		if !bytes.Equal(h.Sum(nil), v2Hash.Sum(nil)) || h.size != v2Hash.size {
+			return errors.Errorf("file %s does not match the V1 file (V1: %x, %d bytes, V2: %x, %d bytes)", p, h.Sum(nil), h.size, v2Hash.Sum(nil), v2Hash.size)
+		}
+		delete(v2Hashes, p)
+		files++
+		size += h.size
+		return nil
+	}); err != nil {
+		return 0, 0, err
+	}
+	for p := range v2Hashes {
+		return 0, 0, errors.Errorf("file %s is in the migrated file set, but not the V1 hash tree", p)
+	}
+	return files, size, nil
+}
+
+// contentHash hashes the content written to it, and counts its size.
+type contentHash struct {
+	hash.Hash
+	size int64
+}
+
+func newContentHash() *contentHash {
+	return &contentHash{Hash: pfs.NewHash()}
+}
+
+func (h *contentHash) Write(data []byte) (int, error) {
+	h.size += int64(len(data))
+	return h.Hash.Write(data)
+}
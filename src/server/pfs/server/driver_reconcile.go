@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultReconcileGracePeriod is the grace period used by the PFS master when
+// reconciling storage, if one is not configured.
+const defaultReconcileGracePeriod = time.Hour
+
+// reconcileStorage finds the chunks that are in object storage, but not the
+// tracker, or vice versa.
+func (d *driver) reconcileStorage(ctx context.Context, gracePeriod time.Duration, fix bool, cb func(*pfs.ReconcileStorageResponse) error) error {
+	return d.storage.ChunkStorage().Reconcile(ctx, gracePeriod, fix, func(orphan *chunk.Orphan) error {
+		return cb(&pfs.ReconcileStorageResponse{
+			Type:  pfs.OrphanType(orphan.Type),
+			Chunk: orphan.ChunkID.HexString(),
+			Fixed: orphan.Fixed,
+		})
+	})
+}
+
+// reconcileStorageLoop periodically reconciles storage, fixing the orphans
+// found, until the context is cancelled.
+func (d *driver) reconcileStorageLoop(ctx context.Context, period, gracePeriod time.Duration) error {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if err := d.reconcileStorage(ctx, gracePeriod, true, func(resp *pfs.ReconcileStorageResponse) error {
+			log.Infof("reconciled %v %v (fixed: %v)", resp.Type, resp.Chunk, resp.Fixed)
+			return nil
+		}); err != nil {
+			log.Errorf("error reconciling storage: %v", err)
+		}
+	}
+}
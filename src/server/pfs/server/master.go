@@ -11,6 +11,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -26,7 +27,27 @@ func (d *driver) master(env *serviceenv.ServiceEnv, db *sqlx.DB) {
 			return err
 		}
 		defer masterLock.Unlock(masterCtx)
-		return d.storage.GC(masterCtx)
+		eg, ctx := errgroup.WithContext(masterCtx)
+		eg.Go(func() error {
+			return d.storage.GC(ctx)
+		})
+		if env.StorageReconcilePeriod != "" {
+			period, err := time.ParseDuration(env.StorageReconcilePeriod)
+			if err != nil {
+				return err
+			}
+			gracePeriod := defaultReconcileGracePeriod
+			if env.StorageReconcileGracePeriod != "" {
+				gracePeriod, err = time.ParseDuration(env.StorageReconcileGracePeriod)
+				if err != nil {
+					return err
+				}
+			}
+			eg.Go(func() error {
+				return d.reconcileStorageLoop(ctx, period, gracePeriod)
+			})
+		}
+		return eg.Wait()
 	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
 		log.Errorf("error in pfs master: %v", err)
 		return nil
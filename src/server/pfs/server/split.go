@@ -0,0 +1,405 @@
+package server
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/sql"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/src/server/pkg/tarutil"
+)
+
+// The header and footer of the files in a directory of split data are stored
+// once for the directory, under keys outside of the file system's namespace
+// (see fileset.UnorderedWriter.AppendKey), and added to the files when they
+// are read.
+const (
+	splitHeaderPrefix = "split-header:"
+	splitFooterPrefix = "split-footer:"
+)
+
+// splitter splits data into records and writes them to directories of
+// numbered files. It tracks the index of the next file in each directory
+// written to, so that multiple appends to a directory in one ModifyFile
+// don't write to the same files.
+type splitter struct {
+	// nextIndex returns the index of the next file in a directory that was
+	// not written to by the splitter. It may be nil, in which case numbering
+	// starts at 0.
+	nextIndex func(dir string) (int64, error)
+	indexes   map[string]int64
+}
+
+func newSplitter(nextIndex func(dir string) (int64, error)) *splitter {
+	return &splitter{
+		nextIndex: nextIndex,
+		indexes:   make(map[string]int64),
+	}
+}
+
+// appendFile writes the data read from r to p, or splits it into a directory
+// of numbered files at p if req has a delimiter.
+func appendFile(uw *fileset.UnorderedWriter, s *splitter, p string, r io.Reader, req *pfs.AppendFile) error {
+	if req.Delimiter == pfs.Delimiter_NONE {
+		return uw.Append(p, req.Overwrite, r, req.Tag)
+	}
+	return s.append(uw, p, r, req)
+}
+
+func (s *splitter) append(uw *fileset.UnorderedWriter, p string, r io.Reader, req *pfs.AppendFile) error {
+	dir := cleanPath(p)
+	index, err := s.start(uw, dir, req.Overwrite)
+	if err != nil {
+		return err
+	}
+	rr, err := newRecordReader(r, req.Delimiter)
+	if err != nil {
+		return err
+	}
+	var header []byte
+	for i := int64(0); i < req.HeaderRecords; i++ {
+		record, err := rr.readRecord()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		header = append(header, record...)
+	}
+	var file string
+	var fileDatums, fileBytes int64
+	record, err := rr.readRecord()
+	for ; err == nil; record, err = rr.readRecord() {
+		if file == "" || splitFull(req, fileDatums, fileBytes) {
+			file = splitFileName(dir, index)
+			index++
+			fileDatums, fileBytes = 0, 0
+		}
+		if err := uw.Append(file, false, bytes.NewReader(record), req.Tag); err != nil {
+			return err
+		}
+		fileDatums++
+		fileBytes += int64(len(record))
+	}
+	if !errors.Is(err, io.EOF) {
+		return err
+	}
+	// The SQL header is only known once the first record has been read, and
+	// the footer once all of them have been.
+	header = append(append([]byte{}, rr.header()...), header...)
+	if len(header) > 0 {
+		if err := uw.AppendKey(splitHeaderPrefix+dir, true, bytes.NewReader(header), req.Tag); err != nil {
+			return err
+		}
+	}
+	if footer := rr.footer(); len(footer) > 0 {
+		if err := uw.AppendKey(splitFooterPrefix+dir, true, bytes.NewReader(footer), req.Tag); err != nil {
+			return err
+		}
+	}
+	s.indexes[dir] = index
+	return nil
+}
+
+// start returns the index of the first file to write to in dir. If overwrite
+// is set, the directory (including anything written to it by the splitter)
+// is deleted, and numbering starts over.
+func (s *splitter) start(uw *fileset.UnorderedWriter, dir string, overwrite bool) (int64, error) {
+	if overwrite {
+		uw.Delete(dir + "/")
+		for i := int64(0); i < s.indexes[dir]; i++ {
+			uw.Delete(splitFileName(dir, i))
+		}
+		for _, prefix := range []string{splitHeaderPrefix, splitFooterPrefix} {
+			if err := uw.AppendKey(prefix+dir, true, &bytes.Buffer{}); err != nil {
+				return 0, err
+			}
+		}
+		return 0, nil
+	}
+	if index, ok := s.indexes[dir]; ok {
+		return index, nil
+	}
+	if s.nextIndex == nil {
+		return 0, nil
+	}
+	return s.nextIndex(dir)
+}
+
+// splitFull returns whether a file with the given number of datums and bytes
+// is full. If neither target is set, each file contains a single datum.
+func splitFull(req *pfs.AppendFile, fileDatums, fileBytes int64) bool {
+	if req.TargetFileDatums == 0 && req.TargetFileBytes == 0 {
+		return fileDatums >= 1
+	}
+	if req.TargetFileDatums != 0 && fileDatums >= req.TargetFileDatums {
+		return true
+	}
+	return req.TargetFileBytes != 0 && fileBytes >= req.TargetFileBytes
+}
+
+// splitFileName returns the path of the file with the given index in a
+// directory of split data.
+func splitFileName(dir string, index int64) string {
+	return path.Join(dir, fmt.Sprintf("%016x", index))
+}
+
+// parseSplitFileName returns the index of a file in a directory of split
+// data, or false if the file's name is not an index.
+func parseSplitFileName(name string) (int64, bool) {
+	if len(name) != 16 {
+		return 0, false
+	}
+	index, err := strconv.ParseInt(name, 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return index, true
+}
+
+type recordReader interface {
+	// readRecord returns the next record, or io.EOF if there are none.
+	readRecord() ([]byte, error)
+	// header returns data that is read at the beginning of every file. It
+	// is only valid after readRecord has returned a record.
+	header() []byte
+	// footer returns data that is read at the end of every file. It is only
+	// valid after readRecord has returned io.EOF.
+	footer() []byte
+}
+
+func newRecordReader(r io.Reader, delimiter pfs.Delimiter) (recordReader, error) {
+	switch delimiter {
+	case pfs.Delimiter_LINE:
+		return &lineReader{r: bufio.NewReader(r)}, nil
+	case pfs.Delimiter_JSON:
+		return &jsonReader{d: json.NewDecoder(r)}, nil
+	case pfs.Delimiter_CSV:
+		return &csvReader{r: bufio.NewReader(r)}, nil
+	case pfs.Delimiter_SQL:
+		return &sqlReader{r: sql.NewPGDumpReader(bufio.NewReader(r))}, nil
+	default:
+		return nil, errors.Errorf("unrecognized delimiter: %v", delimiter)
+	}
+}
+
+type lineReader struct {
+	r *bufio.Reader
+}
+
+func (lr *lineReader) readRecord() ([]byte, error) {
+	line, err := lr.r.ReadBytes('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) && len(line) > 0 {
+			// The last line doesn't end with a newline.
+			return line, nil
+		}
+		return nil, err
+	}
+	return line, nil
+}
+
+func (lr *lineReader) header() []byte { return nil }
+
+func (lr *lineReader) footer() []byte { return nil }
+
+type jsonReader struct {
+	d *json.Decoder
+}
+
+func (jr *jsonReader) readRecord() ([]byte, error) {
+	var value json.RawMessage
+	if err := jr.d.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (jr *jsonReader) header() []byte { return nil }
+
+func (jr *jsonReader) footer() []byte { return nil }
+
+// csvReader splits CSV data on the boundaries of its records, and returns
+// each record as it appears in the data (including its line ending), so split
+// files are byte-identical to the data they were split from.
+type csvReader struct {
+	r *bufio.Reader
+}
+
+func (cr *csvReader) readRecord() ([]byte, error) {
+	var record []byte
+	var quoted bool
+	for {
+		line, err := cr.r.ReadBytes('\n')
+		record = append(record, line...)
+		if err != nil {
+			if !errors.Is(err, io.EOF) || len(record) == 0 {
+				return nil, err
+			}
+			if quoted {
+				return nil, errors.Errorf("unterminated quoted field in CSV record: %q", record)
+			}
+			// The last record doesn't end with a newline.
+			return record, nil
+		}
+		// Newlines in quoted fields don't end the record. Each quote starts
+		// or ends a quoted field, except escaped quotes (""), which do both.
+		if bytes.Count(line, []byte{'"'})%2 == 1 {
+			quoted = !quoted
+		}
+		if !quoted {
+			return record, nil
+		}
+	}
+}
+
+func (cr *csvReader) header() []byte { return nil }
+
+func (cr *csvReader) footer() []byte { return nil }
+
+type sqlReader struct {
+	r *sql.PGDumpReader
+}
+
+func (sr *sqlReader) readRecord() ([]byte, error) {
+	row, err := sr.r.ReadRow()
+	if err != nil {
+		return nil, err
+	}
+	if row == nil {
+		// ReadRow returns a nil row when it reaches the end of the rows.
+		return nil, io.EOF
+	}
+	return row, nil
+}
+
+func (sr *sqlReader) header() []byte { return sr.r.Header }
+
+func (sr *sqlReader) footer() []byte { return sr.r.Footer }
+
+// splitData is the headers and footers of directories of split data, keyed by
+// directory.
+type splitData struct {
+	headers, footers map[string][]byte
+}
+
+// readSplitData reads the headers and footers of the directories of split data
+// in a commit whose paths start with dirPrefix.
+func (d *driver) readSplitData(ctx context.Context, commitInfo *pfs.CommitInfo, dirPrefix string) (*splitData, error) {
+	sd := &splitData{
+		headers: make(map[string][]byte),
+		footers: make(map[string][]byte),
+	}
+	for prefix, data := range map[string]map[string][]byte{
+		splitHeaderPrefix: sd.headers,
+		splitFooterPrefix: sd.footers,
+	} {
+		fs, err := d.openCommit(ctx, commitInfo, index.WithPrefix(prefix+dirPrefix))
+		if err != nil {
+			return nil, err
+		}
+		if fs == nil {
+			continue
+		}
+		if err := fs.Iterate(ctx, func(f fileset.File) error {
+			buf := &bytes.Buffer{}
+			if err := f.Content(buf); err != nil {
+				return err
+			}
+			if buf.Len() > 0 {
+				data[strings.TrimPrefix(f.Index().Path, prefix)] = buf.Bytes()
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return sd, nil
+}
+
+// writeTarStreamRange is like fileset.WriteTarStreamRange, except the files
+// in directories of split data are written with the directory's header and
+// footer, and the range applies to the file with them.
+func (sd *splitData) writeTarStreamRange(ctx context.Context, w io.Writer, fs fileset.FileSet, offsetBytes, sizeBytes int64) error {
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		dir := path.Dir(f.Index().Path)
+		header, footer := sd.headers[dir], sd.footers[dir]
+		if fileset.IsDir(f.Index().Path) || (len(header) == 0 && len(footer) == 0) {
+			return fileset.WriteTarEntryRange(w, f, offsetBytes, sizeBytes)
+		}
+		return writeSplitTarEntryRange(w, f, header, footer, offsetBytes, sizeBytes)
+	}); err != nil {
+		return err
+	}
+	return tar.NewWriter(w).Close()
+}
+
+func writeSplitTarEntryRange(w io.Writer, f fileset.File, header, footer []byte, offsetBytes, sizeBytes int64) error {
+	idx := f.Index()
+	fileBytes := index.SizeBytes(idx)
+	n := int64(len(header)) + fileBytes + int64(len(footer)) - offsetBytes
+	if n < 0 {
+		n = 0
+	}
+	if sizeBytes > 0 && sizeBytes < n {
+		n = sizeBytes
+	}
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(tarutil.NewHeader(idx.Path, n)); err != nil {
+		return err
+	}
+	// writeRange writes the part of the range that falls in the next size
+	// bytes of the entry.
+	writeRange := func(size int64, cb func(offsetBytes, sizeBytes int64) error) error {
+		if offsetBytes >= size {
+			offsetBytes -= size
+			return nil
+		}
+		m := size - offsetBytes
+		if m > n {
+			m = n
+		}
+		if m == 0 {
+			return nil
+		}
+		if err := cb(offsetBytes, m); err != nil {
+			return err
+		}
+		offsetBytes = 0
+		n -= m
+		return nil
+	}
+	writeBytes := func(offsetBytes, sizeBytes int64, data []byte) error {
+		_, err := tw.Write(data[offsetBytes : offsetBytes+sizeBytes])
+		return err
+	}
+	if err := writeRange(int64(len(header)), func(offsetBytes, sizeBytes int64) error {
+		return writeBytes(offsetBytes, sizeBytes, header)
+	}); err != nil {
+		return err
+	}
+	if err := writeRange(fileBytes, func(offsetBytes, sizeBytes int64) error {
+		return f.Content(tw, chunk.WithRange(offsetBytes, sizeBytes))
+	}); err != nil {
+		return err
+	}
+	if err := writeRange(int64(len(footer)), func(offsetBytes, sizeBytes int64) error {
+		return writeBytes(offsetBytes, sizeBytes, footer)
+	}); err != nil {
+		return err
+	}
+	return tw.Flush()
+}
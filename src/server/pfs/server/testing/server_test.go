@@ -2,6 +2,7 @@ package testing
 
 import (
 	"archive/tar"
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
@@ -29,6 +30,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/sql"
 	"github.com/pachyderm/pachyderm/src/server/pkg/tarutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
 	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
@@ -2353,32 +2355,84 @@ func TestListCommit(t *testing.T) {
 	}))
 }
 
-// TODO: Make work with V2?
-//func TestOffsetRead(t *testing.T) {
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		repo := "TestOffsetRead"
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//		commit, err := env.PachClient.StartCommit(repo, "")
-//		require.NoError(t, err)
-//		fileData := "foo\n"
-//		require.NoError(t, env.PachClient.PutFile(repo, commit.ID, "foo", strings.NewReader(fileData)))
-//		require.NoError(t, env.PachClient.PutFile(repo, commit.ID, "foo", strings.NewReader(fileData)))
-//
-//		var buffer bytes.Buffer
-//		require.NoError(t, env.PachClient.GetFile(repo, commit.ID, "foo", int64(len(fileData)*2)+1, 0, &buffer))
-//		require.Equal(t, "", buffer.String())
-//
-//		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
-//
-//		buffer.Reset()
-//		require.NoError(t, env.PachClient.GetFile(repo, commit.ID, "foo", int64(len(fileData)*2)+1, 0, &buffer))
-//		require.Equal(t, "", buffer.String())
-//
-//		return nil
-//	}))
-//}
+func TestOffsetRead(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		repo := "TestOffsetRead"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "")
+		require.NoError(t, err)
+		fileData := "foo\n"
+		require.NoError(t, env.PachClient.PutFile(repo, commit.ID, "foo", strings.NewReader(fileData)))
+		require.NoError(t, env.PachClient.PutFile(repo, commit.ID, "foo", strings.NewReader(fileData)))
+
+		var buffer bytes.Buffer
+		require.NoError(t, env.PachClient.GetFileRange(repo, commit.ID, "foo", int64(len(fileData)*2)+1, 0, &buffer))
+		require.Equal(t, "", buffer.String())
+
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+
+		buffer.Reset()
+		require.NoError(t, env.PachClient.GetFileRange(repo, commit.ID, "foo", int64(len(fileData)*2)+1, 0, &buffer))
+		require.Equal(t, "", buffer.String())
+
+		buffer.Reset()
+		require.NoError(t, env.PachClient.GetFileRange(repo, commit.ID, "foo", 2, 0, &buffer))
+		require.Equal(t, "o\nfoo\n", buffer.String())
+
+		buffer.Reset()
+		require.NoError(t, env.PachClient.GetFileRange(repo, commit.ID, "foo", 2, 3, &buffer))
+		require.Equal(t, "o\nf", buffer.String())
+
+		return nil
+	}))
+}
+
+func TestGetFileRange(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		repo := tu.UniqueString("TestGetFileRange")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "")
+		require.NoError(t, err)
+		// The file is large enough to span many chunks.
+		fileData := random.String(10 * units.MB)
+		require.NoError(t, env.PachClient.PutFile(repo, commit.ID, "file", strings.NewReader(fileData)))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+		for i := 0; i < 10; i++ {
+			offset := rand.Intn(len(fileData))
+			size := rand.Intn(len(fileData) - offset)
+			var buffer bytes.Buffer
+			require.NoError(t, env.PachClient.GetFileRange(repo, commit.ID, "file", int64(offset), int64(size), &buffer))
+			require.Equal(t, fileData[offset:offset+size], buffer.String())
+		}
+		t.Run("ReadSeeker", func(t *testing.T) {
+			rs, err := env.PachClient.GetFileReadSeeker(repo, commit.ID, "file")
+			require.NoError(t, err)
+			size, err := rs.Seek(0, io.SeekEnd)
+			require.NoError(t, err)
+			require.Equal(t, int64(len(fileData)), size)
+			_, err = rs.Seek(-4096, io.SeekEnd)
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(rs)
+			require.NoError(t, err)
+			require.Equal(t, fileData[len(fileData)-4096:], string(data))
+			_, err = rs.Seek(100, io.SeekStart)
+			require.NoError(t, err)
+			buf := make([]byte, 100)
+			_, err = io.ReadFull(rs, buf)
+			require.NoError(t, err)
+			require.Equal(t, fileData[100:200], string(buf))
+		})
+		t.Run("Negative", func(t *testing.T) {
+			require.YesError(t, env.PachClient.GetFileRange(repo, commit.ID, "file", -1, 0, &bytes.Buffer{}))
+		})
+
+		return nil
+	}))
+}
 
 func TestBranch2(t *testing.T) {
 	t.Parallel()
@@ -3178,118 +3232,68 @@ func TestFlushNonExistentCommit(t *testing.T) {
 	}))
 }
 
+func TestPutFileSplit(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		if testing.Short() {
+			t.Skip("Skipping integration tests in short mode")
+		}
+
+		// create repos
+		repo := tu.UniqueString("TestPutFileSplit")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "none", pfs.Delimiter_NONE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n")))
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n")))
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n")))
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "line2", pfs.Delimiter_LINE, 2, 0, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n")))
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "line3", pfs.Delimiter_LINE, 0, 8, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n")))
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}")))
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}")))
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "json2", pfs.Delimiter_JSON, 2, 0, 0, false, strings.NewReader("{}{}{}{}")))
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "json3", pfs.Delimiter_JSON, 0, 4, 0, false, strings.NewReader("{}{}{}{}")))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit2.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n")))
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit2.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}")))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit2.ID))
+
+		checkFiles := func(commitID, path string, n int, sizeBytes uint64) {
+			files, err := env.PachClient.ListFileAll(repo, commitID, path)
+			require.NoError(t, err)
+			require.Equal(t, n, len(files))
+			for _, fileInfo := range files {
+				require.Equal(t, sizeBytes, fileInfo.SizeBytes)
+			}
+		}
+		fileInfo, err := env.PachClient.InspectFile(repo, commit.ID, "none")
+		require.NoError(t, err)
+		require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
+		checkFiles(commit.ID, "line", 6, 4)
+		checkFiles(commit2.ID, "line", 9, 4)
+		checkFiles(commit.ID, "line2", 2, 8)
+		checkFiles(commit.ID, "line3", 2, 8)
+		checkFiles(commit.ID, "json", 20, 2)
+		checkFiles(commit2.ID, "json", 30, 2)
+		checkFiles(commit.ID, "json2", 2, 4)
+		checkFiles(commit.ID, "json3", 2, 4)
+
+		// Overwriting a split directory replaces all of its files.
+		require.NoError(t, env.PachClient.PutFileSplit(repo, "master", "line", pfs.Delimiter_LINE, 0, 0, 0, true, strings.NewReader("0\n1\n")))
+		checkFiles("master", "line", 2, 2)
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "line/0000000000000001", &buf))
+		require.Equal(t, "1\n", buf.String())
+
+		return nil
+	}))
+}
+
 // TODO: Make work with V2?
-//func TestPutFileSplit(t *testing.T) {
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		if testing.Short() {
-//			t.Skip("Skipping integration tests in short mode")
-//		}
-//
-//		// create repos
-//		repo := tu.UniqueString("TestPutFileSplit")
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//		commit, err := env.PachClient.StartCommit(repo, "master")
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "none", pfs.Delimiter_NONE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line2", pfs.Delimiter_LINE, 2, 0, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line3", pfs.Delimiter_LINE, 0, 8, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json2", pfs.Delimiter_JSON, 2, 0, 0, false, strings.NewReader("{}{}{}{}"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json3", pfs.Delimiter_JSON, 0, 4, 0, false, strings.NewReader("{}{}{}{}"))
-//		require.NoError(t, err)
-//
-//		files, err := env.PachClient.ListFileAll(repo, commit.ID, "line2")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(8), fileInfo.SizeBytes)
-//		}
-//
-//		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
-//		commit2, err := env.PachClient.StartCommit(repo, "master")
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit2.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit2.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-//		require.NoError(t, err)
-//
-//		files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
-//		require.NoError(t, err)
-//		require.Equal(t, 9, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//
-//		require.NoError(t, env.PachClient.FinishCommit(repo, commit2.ID))
-//		fileInfo, err := env.PachClient.InspectFile(repo, commit.ID, "none")
-//		require.NoError(t, err)
-//		require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "line")
-//		require.NoError(t, err)
-//		require.Equal(t, 6, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
-//		require.NoError(t, err)
-//		require.Equal(t, 9, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "line2")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(8), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "line3")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(8), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "json")
-//		require.NoError(t, err)
-//		require.Equal(t, 20, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(2), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit2.ID, "json")
-//		require.NoError(t, err)
-//		require.Equal(t, 30, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(2), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "json2")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "json3")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//
-//		return nil
-//	}))
-//}
-//
 //func TestPutFileSplitBig(t *testing.T) {
 //	if os.Getenv("RUN_BAD_TESTS") == "" {
 //		t.Skip("Skipping because RUN_BAD_TESTS was empty")
@@ -3324,99 +3328,134 @@ func TestFlushNonExistentCommit(t *testing.T) {
 //		return nil
 //	}))
 //}
-//
-//func TestPutFileSplitCSV(t *testing.T) {
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		// create repos
-//		repo := tu.UniqueString("TestPutFileSplitCSV")
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//		_, err := env.PachClient.PutFileSplit(repo, "master", "data", pfs.Delimiter_CSV, 0, 0, 0, false,
-//			// Weird, but this is actually two lines ("is\na" is quoted, so one cell)
-//			strings.NewReader("this,is,a,test\n"+
-//				"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"))
-//		require.NoError(t, err)
-//		fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/data")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(fileInfos))
-//		var contents bytes.Buffer
-//		env.PachClient.GetFile(repo, "master", "/data/0000000000000000", &contents)
-//		require.Equal(t, "this,is,a,test\n", contents.String())
-//		contents.Reset()
-//		env.PachClient.GetFile(repo, "master", "/data/0000000000000001", &contents)
-//		require.Equal(t, "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n", contents.String())
-//
-//		return nil
-//	}))
-//}
-//
-//func TestPutFileSplitSQL(t *testing.T) {
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		// create repos
-//		repo := tu.UniqueString("TestPutFileSplitSQL")
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//
-//		_, err := env.PachClient.PutFileSplit(repo, "master", "/sql", pfs.Delimiter_SQL, 0, 0, 0,
-//			false, strings.NewReader(tu.TestPGDump))
-//		require.NoError(t, err)
-//		fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/sql")
-//		require.NoError(t, err)
-//		require.Equal(t, 5, len(fileInfos))
-//
-//		// Get one of the SQL records & validate it
-//		var contents bytes.Buffer
-//		env.PachClient.GetFile(repo, "master", "/sql/0000000000000000", &contents)
-//		// Validate that the recieved pgdump file creates the cars table
-//		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
-//		// Validate the SQL header more generally by passing the output of GetFile
-//		// back through the SQL library & confirm that it parses correctly but only
-//		// has one row
-//		pgReader := sql.NewPGDumpReader(bufio.NewReader(bytes.NewReader(contents.Bytes())))
-//		record, err := pgReader.ReadRow()
-//		require.NoError(t, err)
-//		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
-//		_, err = pgReader.ReadRow()
-//		require.YesError(t, err)
-//		require.True(t, errors.Is(err, io.EOF))
-//
-//		// Create a new commit that overwrites all existing data & puts it back with
-//		// --header-records=1
-//		commit, err := env.PachClient.StartCommit(repo, "master")
-//		require.NoError(t, err)
-//		require.NoError(t, env.PachClient.DeleteFile(repo, commit.ID, "/sql"))
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "/sql", pfs.Delimiter_SQL, 0, 0, 1,
-//			false, strings.NewReader(tu.TestPGDump))
-//		require.NoError(t, err)
-//		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
-//		fileInfos, err = env.PachClient.ListFileAll(repo, "master", "/sql")
-//		require.NoError(t, err)
-//		require.Equal(t, 4, len(fileInfos))
-//
-//		// Get one of the SQL records & validate it
-//		contents.Reset()
-//		env.PachClient.GetFile(repo, "master", "/sql/0000000000000003", &contents)
-//		// Validate a that the recieved pgdump file creates the cars table
-//		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
-//		// Validate the SQL header more generally by passing the output of GetFile
-//		// back through the SQL library & confirm that it parses correctly but only
-//		// has one row
-//		pgReader = sql.NewPGDumpReader(bufio.NewReader(strings.NewReader(contents.String())))
-//		record, err = pgReader.ReadRow()
-//		require.NoError(t, err)
-//		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
-//		record, err = pgReader.ReadRow()
-//		require.NoError(t, err)
-//		require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", string(record))
-//		_, err = pgReader.ReadRow()
-//		require.YesError(t, err)
-//		require.True(t, errors.Is(err, io.EOF))
-//
-//		return nil
-//	}))
-//}
+
+func TestPutFileSplitCSV(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		// create repos
+		repo := tu.UniqueString("TestPutFileSplitCSV")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		require.NoError(t, env.PachClient.PutFileSplit(repo, "master", "data", pfs.Delimiter_CSV, 0, 0, 0, false,
+			// Weird, but this is actually two lines ("is\na" is quoted, so one cell)
+			strings.NewReader("this,is,a,test\n"+
+				"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n")))
+		fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/data")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fileInfos))
+		var contents bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "/data/0000000000000000", &contents))
+		require.Equal(t, "this,is,a,test\n", contents.String())
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "/data/0000000000000001", &contents))
+		require.Equal(t, "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n", contents.String())
+
+		// Records are split as they appear in the data, rather than re-encoded.
+		require.NoError(t, env.PachClient.PutFileSplit(repo, "master", "raw", pfs.Delimiter_CSV, 0, 0, 0, false,
+			strings.NewReader("\"quoted\",plain\r\nlast,\"\"")))
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "/raw/0000000000000000", &contents))
+		require.Equal(t, "\"quoted\",plain\r\n", contents.String())
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "/raw/0000000000000001", &contents))
+		require.Equal(t, "last,\"\"", contents.String())
+
+		// With a header record, the header is stored once for the directory
+		// rather than in a file of its own, and read at the beginning of each
+		// file.
+		require.NoError(t, env.PachClient.PutFileSplit(repo, "master", "header", pfs.Delimiter_CSV, 0, 0, 1, false,
+			strings.NewReader("make,model\nTesla,Roadster\nHonda,Civic\n")))
+		fileInfos, err = env.PachClient.ListFileAll(repo, "master", "/header")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fileInfos))
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "/header/0000000000000000", &contents))
+		require.Equal(t, "make,model\nTesla,Roadster\n", contents.String())
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "/header/0000000000000001", &contents))
+		require.Equal(t, "make,model\nHonda,Civic\n", contents.String())
+		fileInfo, err := env.PachClient.InspectFile(repo, "master", "/header/0000000000000001")
+		require.NoError(t, err)
+		require.Equal(t, uint64(len("Honda,Civic\n")), fileInfo.SizeBytes)
+		// Ranges apply to the file with its header.
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFileRange(repo, "master", "/header/0000000000000001", 5, 10, &contents))
+		require.Equal(t, "model\nHond", contents.String())
+
+		// Appending to the directory continues the numbering.
+		require.NoError(t, env.PachClient.PutFileSplit(repo, "master", "header", pfs.Delimiter_CSV, 0, 0, 1, false,
+			strings.NewReader("make,model\nDodge,Viper\n")))
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "/header/0000000000000002", &contents))
+		require.Equal(t, "make,model\nDodge,Viper\n", contents.String())
+
+		return nil
+	}))
+}
+
+func TestPutFileSplitSQL(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		// create repos
+		repo := tu.UniqueString("TestPutFileSplitSQL")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+
+		require.NoError(t, env.PachClient.PutFileSplit(repo, "master", "/sql", pfs.Delimiter_SQL, 0, 0, 0,
+			false, strings.NewReader(tu.TestPGDump)))
+		fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/sql")
+		require.NoError(t, err)
+		require.Equal(t, 5, len(fileInfos))
+
+		// Get one of the SQL records & validate it
+		var contents bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "/sql/0000000000000000", &contents))
+		// Validate that the recieved pgdump file creates the cars table
+		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
+		// Validate the SQL header more generally by passing the output of GetFile
+		// back through the SQL library & confirm that it parses correctly but only
+		// has one row
+		pgReader := sql.NewPGDumpReader(bufio.NewReader(bytes.NewReader(contents.Bytes())))
+		record, err := pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
+		_, err = pgReader.ReadRow()
+		require.YesError(t, err)
+		require.True(t, errors.Is(err, io.EOF))
+
+		// Create a new commit that overwrites all existing data & puts it back with
+		// --header-records=1
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "/sql", pfs.Delimiter_SQL, 0, 0, 1,
+			true, strings.NewReader(tu.TestPGDump)))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+		fileInfos, err = env.PachClient.ListFileAll(repo, "master", "/sql")
+		require.NoError(t, err)
+		require.Equal(t, 4, len(fileInfos))
+
+		// Get one of the SQL records & validate it
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "/sql/0000000000000003", &contents))
+		// Validate a that the recieved pgdump file creates the cars table
+		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
+		// Validate the SQL header more generally by passing the output of GetFile
+		// back through the SQL library & confirm that it parses correctly but only
+		// has one row
+		pgReader = sql.NewPGDumpReader(bufio.NewReader(strings.NewReader(contents.String())))
+		record, err = pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
+		record, err = pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", string(record))
+		_, err = pgReader.ReadRow()
+		require.YesError(t, err)
+		require.True(t, errors.Is(err, io.EOF))
+
+		return nil
+	}))
+}
 
 func TestDiffFile(t *testing.T) {
 	t.Parallel()
@@ -5344,50 +5383,55 @@ func TestPutFileOutputRepo(t *testing.T) {
 	}))
 }
 
-// TODO: Make work with V2?
-//func TestFileHistory(t *testing.T) {
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		var err error
-//
-//		repo := "test"
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//		numCommits := 10
-//		for i := 0; i < numCommits; i++ {
-//			_, err = env.PachClient.PutFile(repo, "master", "file", strings.NewReader("foo\n"))
-//			require.NoError(t, err)
-//		}
-//		fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", -1)
-//		require.NoError(t, err)
-//		require.Equal(t, numCommits, len(fileInfos))
-//
-//		for i := 1; i < numCommits; i++ {
-//			fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", int64(i))
-//			require.NoError(t, err)
-//			require.Equal(t, i, len(fileInfos))
-//		}
-//
-//		require.NoError(t, env.PachClient.DeleteFile(repo, "master", "file"))
-//		for i := 0; i < numCommits; i++ {
-//			_, err = env.PachClient.PutFile(repo, "master", "file", strings.NewReader("foo\n"))
-//			require.NoError(t, err)
-//			_, err = env.PachClient.PutFile(repo, "master", "unrelated", strings.NewReader("foo\n"))
-//			require.NoError(t, err)
-//		}
-//		fileInfos, err = env.PachClient.ListFileHistory(repo, "master", "file", -1)
-//		require.NoError(t, err)
-//		require.Equal(t, numCommits, len(fileInfos))
-//
-//		for i := 1; i < numCommits; i++ {
-//			fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", int64(i))
-//			require.NoError(t, err)
-//			require.Equal(t, i, len(fileInfos))
-//		}
-//
-//		return nil
-//	}))
-//}
+func TestFileHistory(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		var err error
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		numCommits := 10
+		for i := 0; i < numCommits; i++ {
+			require.NoError(t, env.PachClient.PutFile(repo, "master", "file", strings.NewReader("foo\n")))
+		}
+		fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", -1)
+		require.NoError(t, err)
+		require.Equal(t, numCommits, len(fileInfos))
+
+		for i := 1; i < numCommits; i++ {
+			fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", int64(i))
+			require.NoError(t, err)
+			require.Equal(t, i, len(fileInfos))
+		}
+
+		require.NoError(t, env.PachClient.DeleteFile(repo, "master", "file"))
+		var commitIDs []string
+		for i := 0; i < numCommits; i++ {
+			require.NoError(t, env.PachClient.PutFile(repo, "master", "file", strings.NewReader("foo\n")))
+			commitInfo, err := env.PachClient.InspectCommit(repo, "master")
+			require.NoError(t, err)
+			commitIDs = append(commitIDs, commitInfo.Commit.ID)
+			require.NoError(t, env.PachClient.PutFile(repo, "master", "unrelated", strings.NewReader("foo\n")))
+		}
+		fileInfos, err = env.PachClient.ListFileHistory(repo, "master", "file", -1)
+		require.NoError(t, err)
+		require.Equal(t, numCommits, len(fileInfos))
+		// Each version is attributed to the commit that last modified it, not
+		// the later commits that modified "unrelated".
+		for i, fi := range fileInfos {
+			require.Equal(t, commitIDs[numCommits-1-i], fi.File.Commit.ID)
+		}
+
+		for i := 1; i < numCommits; i++ {
+			fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", int64(i))
+			require.NoError(t, err)
+			require.Equal(t, i, len(fileInfos))
+		}
+
+		return nil
+	}))
+}
 
 func TestUpdateRepo(t *testing.T) {
 	t.Parallel()
@@ -5721,6 +5765,60 @@ func TestFsckFix(t *testing.T) {
 	}))
 }
 
+func TestFsckStorage(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(repo, commit.ID, "big", strings.NewReader(random.String(10*units.MB))))
+		require.NoError(t, env.PachClient.PutFile(repo, commit.ID, "small", strings.NewReader("small")))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+		fsckErrors := func(verifyContent, fix bool) []string {
+			var errs []string
+			require.NoError(t, env.PachClient.FsckStorage(verifyContent, fix, func(resp *pfs.FsckResponse) error {
+				if resp.Error != "" {
+					errs = append(errs, resp.Error)
+				}
+				return nil
+			}))
+			return errs
+		}
+		require.Equal(t, 0, len(fsckErrors(true, false)))
+		// Corrupt the largest chunk, which holds data from the big file.
+		chunkDir := path.Join(env.LocalStorageDirectory, "chunk")
+		infos, err := ioutil.ReadDir(chunkDir)
+		require.NoError(t, err)
+		var largest os.FileInfo
+		for _, info := range infos {
+			if largest == nil || info.Size() > largest.Size() {
+				largest = info
+			}
+		}
+		require.NoError(t, ioutil.WriteFile(path.Join(chunkDir, largest.Name()), []byte("corrupted"), 0644))
+		// The corruption is only detected when the content is verified.
+		require.Equal(t, 0, len(fsckErrors(false, false)))
+		errs := fsckErrors(true, false)
+		require.True(t, len(errs) > 0)
+		for _, err := range errs {
+			require.True(t, strings.Contains(err, "file /big"), err)
+		}
+		// Quarantine the big file, the small file should be unaffected.
+		require.Equal(t, len(errs), len(fsckErrors(true, true)))
+		require.Equal(t, 0, len(fsckErrors(true, false)))
+		fileInfos, err := env.PachClient.ListFileAll(repo, commit.ID, "")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+		require.Equal(t, "/small", fileInfos[0].File.Path)
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile(repo, commit.ID, "small", buf))
+		require.Equal(t, "small", buf.String())
+		return nil
+	}))
+}
+
 // TODO: Make work with V2?
 //func TestPutFileAtomic(t *testing.T) {
 //	t.Parallel()
@@ -6562,3 +6660,48 @@ func getRand() *rand.Rand {
 func randomReader(n int) io.Reader {
 	return io.LimitReader(getRand(), int64(n))
 }
+
+func TestBuildCommit(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		repo := "TestBuildCommit"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(repo, commit1.ID, "a", strings.NewReader("a")))
+		require.NoError(t, env.PachClient.PutFile(repo, commit1.ID, "b", strings.NewReader("b")))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit1.ID))
+
+		fsclient, err := env.PachClient.NewCreateFilesetClient()
+		require.NoError(t, err)
+		require.NoError(t, fsclient.AppendFile("c", true, strings.NewReader("c")))
+		require.NoError(t, fsclient.DeleteFile("a"))
+		resp, err := fsclient.Close()
+		require.NoError(t, err)
+
+		id := uuid.NewWithoutDashes()
+		_, err = env.PachClient.PfsAPIClient.BuildCommit(env.PachClient.Ctx(), &pfs.BuildCommitRequest{
+			Parent:      pclient.NewCommit(repo, commit1.ID),
+			Branch:      "master",
+			ID:          id,
+			Description: "restored",
+			Started:     types.TimestampNow(),
+			Finished:    types.TimestampNow(),
+			FilesetId:   resp.FilesetId,
+		})
+		require.NoError(t, err)
+		commitInfo, err := env.PachClient.InspectCommit(repo, id)
+		require.NoError(t, err)
+		require.NotNil(t, commitInfo.Finished)
+		require.Equal(t, commit1.ID, commitInfo.ParentCommit.ID)
+		require.Equal(t, "master", commitInfo.Branch.Name)
+		require.Equal(t, "restored", commitInfo.Description)
+		fis, err := env.PachClient.ListFileAll(repo, id, "/")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fis))
+		require.Equal(t, "/b", fis[0].File.Path)
+		require.Equal(t, "/c", fis[1].File.Path)
+		return nil
+	}))
+}
@@ -21,6 +21,8 @@ var authHandlers = map[string]authHandler{
 
 	// Allow InspectCluster to succeed before a user logs in
 	"/admin.API/InspectCluster": unauthenticated,
+	"/admin.API/Extract":        authDisabledOr(admin),
+	"/admin.API/Restore":        authDisabledOr(admin),
 
 	//
 	// Auth API
@@ -108,34 +110,39 @@ var authHandlers = map[string]authHandler{
 	//
 
 	// TODO: Add methods to handle repo permissions
-	"/pfs.API/CreateRepo":      authDisabledOr(authenticated),
-	"/pfs.API/InspectRepo":     authDisabledOr(authenticated),
-	"/pfs.API/ListRepo":        authDisabledOr(authenticated),
-	"/pfs.API/DeleteRepo":      authDisabledOr(authenticated),
-	"/pfs.API/StartCommit":     authDisabledOr(authenticated),
-	"/pfs.API/FinishCommit":    authDisabledOr(authenticated),
-	"/pfs.API/InspectCommit":   authDisabledOr(authenticated),
-	"/pfs.API/ListCommit":      authDisabledOr(authenticated),
-	"/pfs.API/DeleteCommit":    authDisabledOr(authenticated),
-	"/pfs.API/FlushCommit":     authDisabledOr(authenticated),
-	"/pfs.API/SubscribeCommit": authDisabledOr(authenticated),
-	"/pfs.API/ClearCommit":     authDisabledOr(authenticated),
-	"/pfs.API/CreateBranch":    authDisabledOr(authenticated),
-	"/pfs.API/InspectBranch":   authDisabledOr(authenticated),
-	"/pfs.API/ListBranch":      authDisabledOr(authenticated),
-	"/pfs.API/DeleteBranch":    authDisabledOr(authenticated),
-	"/pfs.API/ModifyFile":      authDisabledOr(authenticated),
-	"/pfs.API/CopyFile":        authDisabledOr(authenticated),
-	"/pfs.API/GetFile":         authDisabledOr(authenticated),
-	"/pfs.API/InspectFile":     authDisabledOr(authenticated),
-	"/pfs.API/ListFile":        authDisabledOr(authenticated),
-	"/pfs.API/WalkFile":        authDisabledOr(authenticated),
-	"/pfs.API/GlobFile":        authDisabledOr(authenticated),
-	"/pfs.API/DiffFile":        authDisabledOr(authenticated),
-	"/pfs.API/DeleteAll":       authDisabledOr(authenticated),
-	"/pfs.API/Fsck":            authDisabledOr(authenticated),
-	"/pfs.API/CreateFileset":   authDisabledOr(authenticated),
-	"/pfs.API/RenewFileset":    authDisabledOr(authenticated),
+	"/pfs.API/CreateRepo":       authDisabledOr(authenticated),
+	"/pfs.API/InspectRepo":      authDisabledOr(authenticated),
+	"/pfs.API/ListRepo":         authDisabledOr(authenticated),
+	"/pfs.API/DeleteRepo":       authDisabledOr(authenticated),
+	"/pfs.API/StartCommit":      authDisabledOr(authenticated),
+	"/pfs.API/FinishCommit":     authDisabledOr(authenticated),
+	"/pfs.API/InspectCommit":    authDisabledOr(authenticated),
+	"/pfs.API/ListCommit":       authDisabledOr(authenticated),
+	"/pfs.API/DeleteCommit":     authDisabledOr(authenticated),
+	"/pfs.API/FlushCommit":      authDisabledOr(authenticated),
+	"/pfs.API/SubscribeCommit":  authDisabledOr(authenticated),
+	"/pfs.API/ClearCommit":      authDisabledOr(authenticated),
+	"/pfs.API/BuildCommit":      authDisabledOr(admin),
+	"/pfs.API/CreateBranch":     authDisabledOr(authenticated),
+	"/pfs.API/InspectBranch":    authDisabledOr(authenticated),
+	"/pfs.API/ListBranch":       authDisabledOr(authenticated),
+	"/pfs.API/DeleteBranch":     authDisabledOr(authenticated),
+	"/pfs.API/ModifyFile":       authDisabledOr(authenticated),
+	"/pfs.API/CopyFile":         authDisabledOr(authenticated),
+	"/pfs.API/GetFile":          authDisabledOr(authenticated),
+	"/pfs.API/InspectFile":      authDisabledOr(authenticated),
+	"/pfs.API/ListFile":         authDisabledOr(authenticated),
+	"/pfs.API/WalkFile":         authDisabledOr(authenticated),
+	"/pfs.API/GlobFile":         authDisabledOr(authenticated),
+	"/pfs.API/DiffFile":         authDisabledOr(authenticated),
+	"/pfs.API/DeleteAll":        authDisabledOr(authenticated),
+	"/pfs.API/Fsck":             authDisabledOr(authenticated),
+	"/pfs.API/GarbageCollect":   authDisabledOr(admin),
+	"/pfs.API/RewrapDataKeys":   authDisabledOr(admin),
+	"/pfs.API/ReconcileStorage": authDisabledOr(admin),
+	"/pfs.API/MigrateV1":        authDisabledOr(admin),
+	"/pfs.API/CreateFileset":    authDisabledOr(authenticated),
+	"/pfs.API/RenewFileset":     authDisabledOr(authenticated),
 
 	//
 	// Object API
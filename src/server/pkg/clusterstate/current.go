@@ -5,6 +5,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/track"
+	"github.com/pachyderm/pachyderm/src/server/pkg/work"
 	"golang.org/x/net/context"
 )
 
@@ -23,4 +24,20 @@ var DesiredClusterState migrations.State = migrations.InitialState().
 	}).
 	Apply("storage fileset store v0", func(ctx context.Context, env migrations.Env) error {
 		return fileset.SetupPostgresStoreV0(ctx, env.Tx)
+	}).
+	Apply("storage chunk key store v0", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupPostgresKeyStoreV0(ctx, env.Tx)
+	}).
+	Apply("v1 migration progress v0", func(ctx context.Context, env migrations.Env) error {
+		return migrations.SetupV1MigrationV0(ctx, env.Tx)
+	}).
+	Apply("create work schema", func(ctx context.Context, env migrations.Env) error {
+		_, err := env.Tx.ExecContext(ctx, `CREATE SCHEMA IF NOT EXISTS work`)
+		return err
+	}).
+	Apply("work task store v0", func(ctx context.Context, env migrations.Env) error {
+		return work.SetupPostgresStoreV0(ctx, env.Tx)
+	}).
+	Apply("work subtask commits v0", func(ctx context.Context, env migrations.Env) error {
+		return work.SetupPostgresCommitsV0(ctx, env.Tx)
 	})
@@ -0,0 +1,322 @@
+package migrations
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+	pfs1_11 "github.com/pachyderm/pachyderm/src/client/admin/v1_11/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+)
+
+// v1PrefixLength is the length of the object hash prefixes that the V1 object
+// indexes are keyed by.
+const v1PrefixLength = 2
+
+// SetupV1MigrationV0 creates the table that records the progress of migrating
+// V1 commits to V2 file sets.
+func SetupV1MigrationV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS v1_migrated_commits (
+		repo VARCHAR(4096) NOT NULL,
+		commit_id VARCHAR(64) NOT NULL,
+		verified BOOLEAN NOT NULL DEFAULT FALSE,
+		migrated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY(repo, commit_id)
+	);
+	`)
+	return err
+}
+
+// V1CommitState returns whether a V1 commit has been migrated to a V2 file
+// set, and whether the file set has been verified against the V1 data.
+func V1CommitState(ctx context.Context, db *sqlx.DB, repo, commitID string) (migrated, verified bool, retErr error) {
+	if err := db.GetContext(ctx, &verified, `
+	SELECT verified
+	FROM v1_migrated_commits
+	WHERE repo = $1 AND commit_id = $2
+	`, repo, commitID); err != nil {
+		if err == sql.ErrNoRows {
+			return false, false, nil
+		}
+		return false, false, err
+	}
+	return true, verified, nil
+}
+
+// MarkV1CommitMigrated records that a V1 commit has been migrated to a V2 file
+// set. The commit is no longer verified, if it was.
+func MarkV1CommitMigrated(ctx context.Context, db *sqlx.DB, repo, commitID string) error {
+	_, err := db.ExecContext(ctx, `
+	INSERT INTO v1_migrated_commits (repo, commit_id) VALUES ($1, $2)
+	ON CONFLICT (repo, commit_id) DO UPDATE SET verified = FALSE, migrated_at = CURRENT_TIMESTAMP
+	`, repo, commitID)
+	return err
+}
+
+// MarkV1CommitVerified records that the V2 file set for a migrated V1 commit
+// has been verified.
+func MarkV1CommitVerified(ctx context.Context, db *sqlx.DB, repo, commitID string) error {
+	res, err := db.ExecContext(ctx, `
+	UPDATE v1_migrated_commits SET verified = TRUE
+	WHERE repo = $1 AND commit_id = $2
+	`, repo, commitID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.Errorf("commit %s@%s has not been migrated", repo, commitID)
+	}
+	return nil
+}
+
+// V1File is a file in the hash tree of a V1 commit.
+type V1File struct {
+	Path           string
+	objects        []*pfs.Object
+	blockRefs      []*pfs.BlockRef
+	header, footer *pfs.Object
+}
+
+// V1Storage reads the hash trees and objects written by the V1 object API.
+// The V1 object API lays out its data under a root directory, with the
+// blocks at block/<hash>, the objects that have not been indexed yet at
+// object/<hash> and the object indexes at index/<hash prefix>.
+type V1Storage struct {
+	objClient obj.Client
+	root      string
+	tmpDir    string
+	indexes   map[string]*pfs.ObjectIndex
+}
+
+// NewV1Storage creates a new V1Storage for the V1 data under root. Hash trees
+// that need to be deserialized to disk are stored in tmpDir.
+// V1Storage is not safe for concurrent use.
+func NewV1Storage(objClient obj.Client, root, tmpDir string) *V1Storage {
+	return &V1Storage{
+		objClient: objClient,
+		root:      root,
+		tmpDir:    tmpDir,
+		indexes:   make(map[string]*pfs.ObjectIndex),
+	}
+}
+
+// V1Trees returns the hash trees of a commit written by V1. The hash trees
+// are not part of the V2 commit info, so they are only kept as unrecognized
+// fields of the commits written by V1.
+func V1Trees(commitInfo *pfs.CommitInfo) (tree *pfs.Object, trees []*pfs.Object, retErr error) {
+	data, err := commitInfo.Marshal()
+	if err != nil {
+		return nil, nil, errors.EnsureStack(err)
+	}
+	v1CommitInfo := &pfs1_11.CommitInfo{}
+	if err := v1CommitInfo.Unmarshal(data); err != nil {
+		return nil, nil, errors.EnsureStack(err)
+	}
+	if v1CommitInfo.Tree != nil {
+		tree = &pfs.Object{Hash: v1CommitInfo.Tree.Hash}
+	}
+	for _, t := range v1CommitInfo.Trees {
+		trees = append(trees, &pfs.Object{Hash: t.Hash})
+	}
+	return tree, trees, nil
+}
+
+// WalkFiles calls cb with each file in the hash tree of a V1 commit, in the
+// order of the hash tree. Commits without a hash tree have no files.
+func (s *V1Storage) WalkFiles(ctx context.Context, commitInfo *pfs.CommitInfo, cb func(*V1File) error) (retErr error) {
+	tree, trees, err := V1Trees(commitInfo)
+	if err != nil {
+		return err
+	}
+	// The headers and footers of directories are shared by the files
+	// directly under them, and directories are walked before their children.
+	shared := make(map[string]*hashtree.Shared)
+	f := func(p string, node *hashtree.NodeProto) error {
+		if node.DirNode != nil {
+			if node.DirNode.Shared != nil {
+				shared[p] = node.DirNode.Shared
+			}
+			return nil
+		}
+		if node.FileNode == nil {
+			return nil
+		}
+		file := &V1File{
+			Path:      p,
+			objects:   node.FileNode.Objects,
+			blockRefs: node.FileNode.BlockRefs,
+		}
+		if node.FileNode.HasHeaderFooter {
+			if sh, ok := shared[path.Dir(p)]; ok {
+				file.header = sh.Header
+				file.footer = sh.Footer
+			}
+		}
+		return cb(file)
+	}
+	switch {
+	case len(trees) > 0:
+		var rs []io.ReadCloser
+		defer func() {
+			for _, r := range rs {
+				if err := r.Close(); err != nil && retErr == nil {
+					retErr = err
+				}
+			}
+		}()
+		for _, t := range trees {
+			r, err := s.objectReader(ctx, t)
+			if err != nil {
+				return err
+			}
+			rs = append(rs, r)
+		}
+		return hashtree.Walk(rs, "/", f)
+	case tree != nil:
+		r, err := s.objectReader(ctx, tree)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := r.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		h, err := hashtree.DeserializeDBHashTree(s.tmpDir, r)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := h.Destroy(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		return h.Walk("/", f)
+	default:
+		return nil
+	}
+}
+
+// WriteFile writes the content of a V1 file to w, one object or block
+// reference at a time.
+func (s *V1Storage) WriteFile(ctx context.Context, file *V1File, w io.Writer) error {
+	writeObject := func(object *pfs.Object) error {
+		if object == nil {
+			return nil
+		}
+		blockRef, err := s.objectBlockRef(ctx, object)
+		if err != nil {
+			return err
+		}
+		return s.writeBlockRef(ctx, blockRef, w)
+	}
+	if err := writeObject(file.header); err != nil {
+		return err
+	}
+	for _, object := range file.objects {
+		if err := writeObject(object); err != nil {
+			return err
+		}
+	}
+	for _, blockRef := range file.blockRefs {
+		if err := s.writeBlockRef(ctx, blockRef, w); err != nil {
+			return err
+		}
+	}
+	return writeObject(file.footer)
+}
+
+func (s *V1Storage) objectReader(ctx context.Context, object *pfs.Object) (io.ReadCloser, error) {
+	blockRef, err := s.objectBlockRef(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+	return s.blockRefReader(ctx, blockRef)
+}
+
+// objectBlockRef looks up the block reference for an object, first in the
+// object indexes, then in the objects that have not been indexed yet.
+func (s *V1Storage) objectBlockRef(ctx context.Context, object *pfs.Object) (*pfs.BlockRef, error) {
+	prefix := object.Hash
+	if len(prefix) > v1PrefixLength {
+		prefix = prefix[:v1PrefixLength]
+	}
+	objectIndex, ok := s.indexes[prefix]
+	if !ok {
+		objectIndex = &pfs.ObjectIndex{}
+		if err := s.readProto(ctx, filepath.Join(s.root, "index", prefix), objectIndex); err != nil && !s.objClient.IsNotExist(err) {
+			return nil, err
+		}
+		s.indexes[prefix] = objectIndex
+	}
+	if blockRef, ok := objectIndex.Objects[object.Hash]; ok {
+		return blockRef, nil
+	}
+	blockRef := &pfs.BlockRef{}
+	if err := s.readProto(ctx, filepath.Join(s.root, "object", object.Hash), blockRef); err != nil {
+		if s.objClient.IsNotExist(err) {
+			return nil, errors.Errorf("object %s not found", object.Hash)
+		}
+		return nil, err
+	}
+	return blockRef, nil
+}
+
+func (s *V1Storage) blockRefReader(ctx context.Context, blockRef *pfs.BlockRef) (io.ReadCloser, error) {
+	size := blockRef.Range.Upper - blockRef.Range.Lower
+	if size == 0 {
+		return ioutil.NopCloser(bytes.NewReader(nil)), nil
+	}
+	return s.objClient.Reader(ctx, filepath.Join(s.root, "block", blockRef.Block.Hash), blockRef.Range.Lower, size)
+}
+
+func (s *V1Storage) writeBlockRef(ctx context.Context, blockRef *pfs.BlockRef, w io.Writer) (retErr error) {
+	r, err := s.blockRefReader(ctx, blockRef)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	n, err := io.Copy(w, r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if uint64(n) != blockRef.Range.Upper-blockRef.Range.Lower {
+		return errors.Errorf("block %s is truncated: read %d bytes of range [%d, %d)", blockRef.Block.Hash, n, blockRef.Range.Lower, blockRef.Range.Upper)
+	}
+	return nil
+}
+
+func (s *V1Storage) readProto(ctx context.Context, p string, pb proto.Message) (retErr error) {
+	r, err := s.objClient.Reader(ctx, p, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(proto.Unmarshal(data, pb))
+}
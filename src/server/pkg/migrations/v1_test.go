@@ -0,0 +1,146 @@
+package migrations
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pfs1_11 "github.com/pachyderm/pachyderm/src/client/admin/v1_11/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+)
+
+// v1Writer writes objects in the V1 object storage layout, each object in its
+// own block.
+type v1Writer struct {
+	t         *testing.T
+	objClient obj.Client
+	root      string
+	index     *pfs.ObjectIndex
+}
+
+func (w *v1Writer) writeFile(p string, data []byte) {
+	objW, err := w.objClient.Writer(context.Background(), p)
+	require.NoError(w.t, err)
+	_, err = objW.Write(data)
+	require.NoError(w.t, err)
+	require.NoError(w.t, objW.Close())
+}
+
+// putObject writes an object, which is either indexed or written to the
+// object directory.
+func (w *v1Writer) putObject(hash string, data []byte, indexed bool) *pfs.Object {
+	block := &pfs.Block{Hash: "block-" + hash}
+	w.writeFile(filepath.Join(w.root, "block", block.Hash), data)
+	blockRef := &pfs.BlockRef{
+		Block: block,
+		Range: &pfs.ByteRange{Upper: uint64(len(data))},
+	}
+	if indexed {
+		w.index.Objects[hash] = blockRef
+	} else {
+		blockRefData, err := blockRef.Marshal()
+		require.NoError(w.t, err)
+		w.writeFile(filepath.Join(w.root, "object", hash), blockRefData)
+	}
+	return &pfs.Object{Hash: hash}
+}
+
+func TestV1Storage(t *testing.T) {
+	root, err := ioutil.TempDir("", "v1-storage")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	objClient, err := obj.NewLocalClient(root)
+	require.NoError(t, err)
+	w := &v1Writer{
+		t:         t,
+		objClient: objClient,
+		root:      root,
+		index:     &pfs.ObjectIndex{Objects: make(map[string]*pfs.BlockRef)},
+	}
+	tree, err := hashtree.NewDBHashTree(root)
+	require.NoError(t, err)
+	defer tree.Destroy()
+	// A file made of an indexed object and an object that was not indexed.
+	require.NoError(t, tree.PutFile("/a", []*pfs.Object{
+		w.putObject("aa1", []byte("foo"), true),
+		w.putObject("aa2", []byte("bar"), false),
+	}, 6))
+	// A file with a header and footer.
+	header := w.putObject("bb1", []byte("header\n"), true)
+	footer := w.putObject("bb2", []byte("footer\n"), false)
+	require.NoError(t, tree.PutDirHeaderFooter("/dir", header, footer, 7, 7))
+	require.NoError(t, tree.PutFileHeaderFooter("/dir/b", []*pfs.Object{
+		w.putObject("cc1", []byte("body\n"), true),
+	}, 5))
+	// A file that references a block directly.
+	w.writeFile(filepath.Join(root, "block", "block-c"), []byte("xxxbazxxx"))
+	require.NoError(t, tree.PutFileBlockRefs("/dir/c", []*pfs.BlockRef{{
+		Block: &pfs.Block{Hash: "block-c"},
+		Range: &pfs.ByteRange{Lower: 3, Upper: 6},
+	}}, 3))
+	require.NoError(t, tree.Hash())
+	buf := &bytes.Buffer{}
+	require.NoError(t, tree.Serialize(buf))
+	treeObject := w.putObject("dd1", buf.Bytes(), false)
+	indexData, err := w.index.Marshal()
+	require.NoError(t, err)
+	for _, prefix := range []string{"aa", "bb", "cc"} {
+		w.writeFile(filepath.Join(root, "index", prefix), indexData)
+	}
+	// The V1 hash tree is kept in the unrecognized fields of the commit info.
+	v1CommitInfo := &pfs1_11.CommitInfo{
+		Commit: &pfs1_11.Commit{Repo: &pfs1_11.Repo{Name: "repo"}, ID: "commit"},
+		Tree:   &pfs1_11.Object{Hash: treeObject.Hash},
+	}
+	data, err := v1CommitInfo.Marshal()
+	require.NoError(t, err)
+	commitInfo := &pfs.CommitInfo{}
+	require.NoError(t, commitInfo.Unmarshal(data))
+	s := NewV1Storage(objClient, root, root)
+	files := make(map[string]string)
+	require.NoError(t, s.WalkFiles(context.Background(), commitInfo, func(file *V1File) error {
+		buf := &bytes.Buffer{}
+		if err := s.WriteFile(context.Background(), file, buf); err != nil {
+			return err
+		}
+		files[file.Path] = buf.String()
+		return nil
+	}))
+	require.Equal(t, map[string]string{
+		"/a":     "foobar",
+		"/dir/b": "header\nbody\nfooter\n",
+		"/dir/c": "baz",
+	}, files)
+}
+
+func TestV1CommitState(t *testing.T) {
+	db := dbutil.NewTestDB(t)
+	ctx := context.Background()
+	state := InitialState().
+		Apply("v1 migration progress v0", func(ctx context.Context, env Env) error {
+			return SetupV1MigrationV0(ctx, env.Tx)
+		})
+	require.NoError(t, ApplyMigrations(ctx, db, Env{}, state))
+	checkState := func(expectedMigrated, expectedVerified bool) {
+		migrated, verified, err := V1CommitState(ctx, db, "repo", "commit")
+		require.NoError(t, err)
+		require.Equal(t, expectedMigrated, migrated)
+		require.Equal(t, expectedVerified, verified)
+	}
+	checkState(false, false)
+	require.YesError(t, MarkV1CommitVerified(ctx, db, "repo", "commit"))
+	require.NoError(t, MarkV1CommitMigrated(ctx, db, "repo", "commit"))
+	checkState(true, false)
+	require.NoError(t, MarkV1CommitVerified(ctx, db, "repo", "commit"))
+	checkState(true, true)
+	// Migrating a commit again means it needs to be verified again.
+	require.NoError(t, MarkV1CommitMigrated(ctx, db, "repo", "commit"))
+	checkState(true, false)
+}
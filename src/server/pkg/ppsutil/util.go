@@ -322,10 +322,12 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 		PodPatch:              pipelineInfo.PodPatch,
 		Spout:                 pipelineInfo.Spout,
 		SchedulingSpec:        pipelineInfo.SchedulingSpec,
+		PrioritySpec:          pipelineInfo.PrioritySpec,
 		DatumTries:            pipelineInfo.DatumTries,
 		Standby:               pipelineInfo.Standby,
 		S3Out:                 pipelineInfo.S3Out,
 		Metadata:              pipelineInfo.Metadata,
+		Speculative:           pipelineInfo.Speculative,
 	}
 }
 
@@ -405,6 +407,14 @@ func SidecarS3GatewayService(jobID string) string {
 	return "s3-" + jobID
 }
 
+// SidecarS3GatewayPipelineService returns the name of the kubernetes service
+// created for the pipeline 'pipelineName' to handle sidecar s3 gateway
+// requests for the output commits of all of the pipeline's running jobs,
+// which are served as the buckets "<job id>.out".
+func SidecarS3GatewayPipelineService(pipelineName string) string {
+	return pipelineName + "-s3"
+}
+
 // ErrorState returns true if s is an error state for a pipeline, that is, a
 // state that users should be aware of and one which will have a "Reason" set
 // for why it's in this state.
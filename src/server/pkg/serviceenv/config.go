@@ -68,6 +68,7 @@ type PachdSpecificConfiguration struct {
 	DeploymentID               string `env:"CLUSTER_DEPLOYMENT_ID,default="`
 	RequireCriticalServersOnly bool   `env:"REQUIRE_CRITICAL_SERVERS_ONLY",default=false"`
 	MetricsEndpoint            string `env:"METRICS_ENDPOINT",default="`
+	HTTPAllowedOrigins         string `env:"HTTP_ALLOWED_ORIGINS,default="`
 	// TODO: Merge this with the worker specific pod name (PPS_POD_NAME) into a global configuration pod name.
 	PachdPodName        string `env:"PACHD_POD_NAME,required"`
 	PostgresServiceHost string `env:"POSTGRES_SERVICE_HOST"`
@@ -92,6 +93,21 @@ type StorageConfiguration struct {
 	StorageCompactionMaxFanIn      int    `env:"STORAGE_COMPACTION_MAX_FANIN,default=50"`
 	StorageFileSetsMaxOpen         int    `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize           int    `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
+	StorageChunkCacheRoot          string `env:"STORAGE_CHUNK_CACHE_ROOT"`
+	StorageChunkCacheSize          string `env:"STORAGE_CHUNK_CACHE_SIZE"`
+	StorageCompression             string `env:"STORAGE_COMPRESSION"`
+	StorageMasterKey               string `env:"STORAGE_MASTER_KEY"`
+	StorageMasterKeyFile           string `env:"STORAGE_MASTER_KEY_FILE"`
+	StoragePreviousMasterKeys      string `env:"STORAGE_PREVIOUS_MASTER_KEYS"`
+	StorageReconcilePeriod         string `env:"STORAGE_RECONCILE_PERIOD"`
+	StorageReconcileGracePeriod    string `env:"STORAGE_RECONCILE_GRACE_PERIOD"`
+	// The storage task group, priority and weight are set in pipeline
+	// sidecars from the pipeline's priority spec, so that the storage workers
+	// shared by all of the pipelines schedule the pipeline's storage tasks
+	// by its priority.
+	StorageTaskGroup    string `env:"STORAGE_TASK_GROUP"`
+	StorageTaskPriority int64  `env:"STORAGE_TASK_PRIORITY"`
+	StorageTaskWeight   int64  `env:"STORAGE_TASK_WEIGHT"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -119,6 +135,9 @@ type FeatureFlags struct {
 	DisableCommitProgressCounter bool `env:"DISABLE_COMMIT_PROGRESS_COUNTER,default=false"`
 	LokiLogging                  bool `env:"LOKI_LOGGING,default=false"`
 	IdentityServerEnabled        bool `env:"IDENTITY_SERVER_ENABLED,default=false"`
+	// WorkBackend is the backend ("etcd" or "postgres") that task queues
+	// store their tasks in.
+	WorkBackend string `env:"WORK_BACKEND,default=etcd"`
 }
 
 // NewConfiguration creates a generic configuration from a specific type of configuration.
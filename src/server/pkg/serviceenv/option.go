@@ -1,13 +1,17 @@
 package serviceenv
 
 import (
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // ChunkStorageOptions returns the chunk storage options for the service environment.
@@ -16,16 +20,80 @@ func (env *ServiceEnv) ChunkStorageOptions() ([]chunk.StorageOption, error) {
 	if env.StorageUploadConcurrencyLimit > 0 {
 		opts = append(opts, chunk.WithMaxConcurrentObjects(0, env.StorageUploadConcurrencyLimit))
 	}
-	if env.StorageDiskCacheSize > 0 {
+	if env.StorageChunkCacheSize != "" {
+		cache, err := env.chunkCache()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, chunk.WithCache(cache))
+	} else if env.StorageDiskCacheSize > 0 {
 		diskCache, err := obj.NewLocalClient(filepath.Join(os.TempDir(), "pfs-cache", uuid.NewWithoutDashes()))
 		if err != nil {
 			return nil, err
 		}
 		opts = append(opts, chunk.WithObjectCache(diskCache, env.StorageDiskCacheSize))
 	}
+	// gzip is the only compression algorithm supported for chunks.
+	switch strings.ToLower(env.StorageCompression) {
+	case "", "none":
+	case "gzip":
+		opts = append(opts, chunk.WithCompression(chunk.CompressionAlgo_GZIP_BEST_SPEED))
+	default:
+		return nil, errors.Errorf("unrecognized storage compression algorithm %q, only \"gzip\" and \"none\" are supported", env.StorageCompression)
+	}
+	master, err := env.masterKey()
+	if err != nil {
+		return nil, err
+	}
+	if master != nil {
+		var previous []*chunk.MasterKey
+		for _, s := range strings.Split(env.StoragePreviousMasterKeys, ",") {
+			if strings.TrimSpace(s) == "" {
+				continue
+			}
+			mk, err := chunk.ParseMasterKey(s)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error parsing previous master key")
+			}
+			previous = append(previous, mk)
+		}
+		opts = append(opts, chunk.WithEncryption(chunk.NewPostgresKeyStore(env.GetDBClient()), master, previous...))
+	}
 	return opts, nil
 }
 
+// masterKey returns the master key for chunk encryption, or nil if chunk
+// encryption is not set up.
+func (env *ServiceEnv) masterKey() (*chunk.MasterKey, error) {
+	s := env.StorageMasterKey
+	if env.StorageMasterKeyFile != "" {
+		data, err := ioutil.ReadFile(env.StorageMasterKeyFile)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		s = string(data)
+	}
+	if s == "" {
+		return nil, nil
+	}
+	return chunk.ParseMasterKey(s)
+}
+
+// chunkCache creates the local disk chunk cache configured for the service
+// environment. The cache is in a temporary directory if a root is not
+// configured, so it does not survive restarts.
+func (env *ServiceEnv) chunkCache() (*chunk.Cache, error) {
+	size, err := resource.ParseQuantity(env.StorageChunkCacheSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse chunk cache size %q", env.StorageChunkCacheSize)
+	}
+	root := env.StorageChunkCacheRoot
+	if root == "" {
+		root = filepath.Join(os.TempDir(), "pfs-chunk-cache", uuid.NewWithoutDashes())
+	}
+	return chunk.NewCache(root, size.Value())
+}
+
 // FileSetStorageOptions returns the fileset storage options for the service environment.
 func (env *ServiceEnv) FileSetStorageOptions() []fileset.StorageOption {
 	var opts []fileset.StorageOption
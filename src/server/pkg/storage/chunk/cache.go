@@ -0,0 +1,229 @@
+package chunk
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const cacheTmpDir = "tmp"
+
+// CacheStats are the statistics for a chunk cache.
+type CacheStats struct {
+	Hits, Misses, Evictions, Corrupted int64
+	// Entries and SizeBytes are the number of chunks, and their total size,
+	// currently in the cache.
+	Entries, SizeBytes int64
+}
+
+// Cache is a bounded local disk cache of chunks with LRU eviction. The cache
+// is persistent, the chunks in the cache directory are loaded when the cache
+// is created (the least recently used chunks are tracked by their
+// modification time). Chunks are verified against their ID when they are
+// read from the cache, corrupted chunks are evicted.
+// Cache is safe for concurrent use.
+type Cache struct {
+	root     string
+	maxBytes int64
+
+	mu    sync.Mutex
+	lru   *simplelru.LRU
+	stats CacheStats
+}
+
+// NewCache creates a new chunk cache in root, which holds up to maxBytes of
+// chunks.
+func NewCache(root string, maxBytes int64) (*Cache, error) {
+	if err := os.MkdirAll(filepath.Join(root, cacheTmpDir), 0777); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	c := &Cache{
+		root:     root,
+		maxBytes: maxBytes,
+	}
+	lru, err := simplelru.NewLRU(math.MaxInt32, c.onEvicted)
+	if err != nil {
+		return nil, err
+	}
+	c.lru = lru
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// load adds the chunks that are already in the cache directory to the cache,
+// from least to most recently used.
+func (c *Cache) load() error {
+	// Clean up the partially written chunks.
+	if err := os.RemoveAll(filepath.Join(c.root, cacheTmpDir)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := os.MkdirAll(filepath.Join(c.root, cacheTmpDir), 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	fileInfos, err := ioutil.ReadDir(c.root)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	sort.Slice(fileInfos, func(i, j int) bool {
+		return fileInfos[i].ModTime().Before(fileInfos[j].ModTime())
+	})
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir() {
+			continue
+		}
+		if _, err := IDFromHex(fileInfo.Name()); err != nil {
+			continue
+		}
+		c.add(fileInfo.Name(), fileInfo.Size())
+	}
+	return nil
+}
+
+// Get returns the data for a chunk, and whether the chunk was in the cache.
+func (c *Cache) Get(chunkID ID) ([]byte, bool) {
+	key := chunkID.HexString()
+	c.mu.Lock()
+	_, ok := c.lru.Get(key)
+	c.mu.Unlock()
+	if !ok {
+		c.report("miss", func(stats *CacheStats) { stats.Misses++ })
+		return nil, false
+	}
+	p := filepath.Join(c.root, key)
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		// The chunk was evicted since it was looked up.
+		c.report("miss", func(stats *CacheStats) { stats.Misses++ })
+		return nil, false
+	}
+	if !bytes.Equal(Hash(data), chunkID) {
+		logrus.Warnf("evicting corrupted chunk %v from the chunk cache", key)
+		c.mu.Lock()
+		c.lru.Remove(key)
+		c.mu.Unlock()
+		c.report("corrupted", func(stats *CacheStats) { stats.Corrupted++ })
+		return nil, false
+	}
+	// Record the use of the chunk, so the order of use is kept across
+	// restarts.
+	now := time.Now()
+	if err := os.Chtimes(p, now, now); err != nil && !os.IsNotExist(err) {
+		logrus.Warnf("could not update the modification time of chunk %v in the chunk cache: %v", key, err)
+	}
+	c.report("hit", func(stats *CacheStats) { stats.Hits++ })
+	return data, true
+}
+
+// Put adds a chunk to the cache, evicting the least recently used chunks to
+// stay within the size bound. Chunks that do not match their ID, or are
+// larger than the cache, are not added.
+func (c *Cache) Put(chunkID ID, data []byte) error {
+	if int64(len(data)) > c.maxBytes || !bytes.Equal(Hash(data), chunkID) {
+		return nil
+	}
+	key := chunkID.HexString()
+	c.mu.Lock()
+	ok := c.lru.Contains(key)
+	c.mu.Unlock()
+	if ok {
+		return nil
+	}
+	// Write the chunk to a temporary file, then rename it into place, so
+	// partially written chunks are never in the cache.
+	tmpPath := filepath.Join(c.root, cacheTmpDir, uuid.NewWithoutDashes())
+	if err := ioutil.WriteFile(tmpPath, data, 0666); err != nil {
+		os.Remove(tmpPath)
+		return errors.EnsureStack(err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lru.Contains(key) {
+		// The chunk was added concurrently.
+		return errors.EnsureStack(os.Remove(tmpPath))
+	}
+	if err := os.Rename(tmpPath, filepath.Join(c.root, key)); err != nil {
+		os.Remove(tmpPath)
+		return errors.EnsureStack(err)
+	}
+	c.add(key, int64(len(data)))
+	return nil
+}
+
+// Stats returns the statistics for the cache.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Entries = int64(c.lru.Len())
+	return stats
+}
+
+// add adds a chunk to the LRU, then evicts chunks until the cache is within
+// its size bound. c.mu must be held (or the cache not yet shared).
+func (c *Cache) add(key string, size int64) {
+	c.lru.Add(key, size)
+	c.stats.SizeBytes += size
+	for c.stats.SizeBytes > c.maxBytes {
+		if _, _, ok := c.lru.RemoveOldest(); !ok {
+			return
+		}
+		c.stats.Evictions++
+		reportCacheEvent("eviction")
+	}
+}
+
+// onEvicted is called by the LRU when a chunk is removed, with c.mu held.
+func (c *Cache) onEvicted(key, value interface{}) {
+	c.stats.SizeBytes -= value.(int64)
+	if err := os.Remove(filepath.Join(c.root, key.(string))); err != nil && !os.IsNotExist(err) {
+		logrus.Errorf("could not delete chunk %v from the chunk cache: %v", key, err)
+	}
+}
+
+func (c *Cache) report(event string, update func(*CacheStats)) {
+	c.mu.Lock()
+	update(&c.stats)
+	c.mu.Unlock()
+	reportCacheEvent(event)
+}
+
+var (
+	cacheEvents         *prometheus.CounterVec
+	registerCacheEvents sync.Once
+)
+
+// reportCacheEvent records a chunk cache event (hit, miss, eviction or
+// corrupted).
+func reportCacheEvent(event string) {
+	registerCacheEvents.Do(func() {
+		cacheEvents = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "pachyderm",
+				Subsystem: "storage_chunk",
+				Name:      "cache_events",
+				Help:      "chunk cache events, count by event type (hit, miss, eviction or corrupted)",
+			},
+			[]string{"event"},
+		)
+		if err := prometheus.Register(cacheEvents); err != nil {
+			// metrics may be redundantly registered; ignore these errors
+			if !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+				logrus.Infof("error registering prometheus metric: %v", err)
+			}
+		}
+	})
+	cacheEvents.WithLabelValues(event).Inc()
+}
@@ -0,0 +1,70 @@
+package chunk
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func putChunk(t *testing.T, c *Cache, data []byte) ID {
+	chunkID := Hash(data)
+	require.NoError(t, c.Put(chunkID, data))
+	return chunkID
+}
+
+func getChunk(t *testing.T, c *Cache, chunkID ID, data []byte) {
+	cached, ok := c.Get(chunkID)
+	require.True(t, ok)
+	require.Equal(t, data, cached)
+}
+
+func getMiss(t *testing.T, c *Cache, chunkID ID) {
+	_, ok := c.Get(chunkID)
+	require.False(t, ok)
+}
+
+func TestCache(t *testing.T) {
+	root, err := ioutil.TempDir("", "chunk-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	c, err := NewCache(root, 250)
+	require.NoError(t, err)
+	data1, data2, data3 := RandSeq(100), RandSeq(100), RandSeq(100)
+	id1 := putChunk(t, c, data1)
+	id2 := putChunk(t, c, data2)
+	getChunk(t, c, id1, data1)
+	getChunk(t, c, id2, data2)
+	// Adding a third chunk evicts the least recently used chunk.
+	id3 := putChunk(t, c, data3)
+	getMiss(t, c, id1)
+	getChunk(t, c, id2, data2)
+	getChunk(t, c, id3, data3)
+	// Chunks that don't match their ID, or don't fit in the cache, are not
+	// added.
+	require.NoError(t, c.Put(id1, data2))
+	getMiss(t, c, id1)
+	big := RandSeq(300)
+	getMiss(t, c, putChunk(t, c, big))
+	require.Equal(t, CacheStats{
+		Hits:      4,
+		Misses:    3,
+		Evictions: 1,
+		Entries:   2,
+		SizeBytes: 200,
+	}, c.Stats())
+	// Corrupted chunks are evicted.
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, id2.HexString()), data1, 0666))
+	getMiss(t, c, id2)
+	require.Equal(t, int64(1), c.Stats().Corrupted)
+	require.Equal(t, int64(1), c.Stats().Entries)
+	// The cache is persistent.
+	id1 = putChunk(t, c, data1)
+	c, err = NewCache(root, 250)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), c.Stats().Entries)
+	getChunk(t, c, id1, data1)
+	getChunk(t, c, id3, data3)
+}
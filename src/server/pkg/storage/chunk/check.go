@@ -0,0 +1,135 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/hash"
+)
+
+var (
+	// ErrChunkNotTracked chunk is not tracked
+	ErrChunkNotTracked = errors.Errorf("chunk is not tracked")
+	// ErrChunkCorrupted chunk data does not match its hash
+	ErrChunkCorrupted = errors.Errorf("chunk data does not match its hash")
+)
+
+// Checker checks that the chunks referenced by data references are intact.
+// The result of checking a chunk is cached, so each chunk is only checked once.
+type Checker struct {
+	client        *Client
+	verifyContent bool
+	results       map[string]error
+	// lastID and lastChunk are the ID and (decrypted and decompressed) data
+	// of the last chunk downloaded, data references into the same chunk are
+	// usually checked in sequence.
+	lastID    string
+	lastChunk []byte
+}
+
+// NewChecker creates a new Checker. If verifyContent is true, the chunks are
+// downloaded and their data is checked against the hashes in the chunk and
+// data references, otherwise only the existence of the chunks is checked.
+func (s *Storage) NewChecker(verifyContent bool) *Checker {
+	client := NewClient(s.objClient, s.mdstore, s.tracker, "")
+	client.keys = s.keys
+	return &Checker{
+		client:        client,
+		verifyContent: verifyContent,
+		results:       make(map[string]error),
+	}
+}
+
+// Check checks the data referenced by a data reference. It checks that the
+// chunk exists in object storage and is tracked, and if content verification
+// is enabled, that the chunk and referenced data hash correctly.
+func (c *Checker) Check(ctx context.Context, dataRef *DataRef) error {
+	chunkID := ID(dataRef.Ref.Id)
+	id := chunkID.HexString()
+	err, ok := c.results[id]
+	if !ok {
+		err = c.checkChunk(ctx, dataRef.Ref)
+		c.results[id] = err
+	}
+	if err != nil || !c.verifyContent {
+		return err
+	}
+	return c.checkData(ctx, dataRef)
+}
+
+func (c *Checker) checkChunk(ctx context.Context, ref *Ref) error {
+	chunkID := ID(ref.Id)
+	if !c.client.objc.Exists(ctx, chunkPath(chunkID)) {
+		return ErrChunkNotExists
+	}
+	exists, err := c.client.tracker.Exists(ctx, ObjectID(chunkID))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrChunkNotTracked
+	}
+	if !c.verifyContent {
+		return nil
+	}
+	_, err = c.getChunk(ctx, ref)
+	return err
+}
+
+func (c *Checker) checkData(ctx context.Context, dataRef *DataRef) error {
+	chunk, err := c.getChunk(ctx, dataRef.Ref)
+	if err != nil {
+		return err
+	}
+	if int64(len(chunk)) != dataRef.Ref.SizeBytes || dataRef.OffsetBytes+dataRef.SizeBytes > int64(len(chunk)) {
+		return errors.Wrapf(ErrChunkCorrupted, "data reference is out of the chunk's bounds")
+	}
+	if dataRef.Hash == "" {
+		return nil
+	}
+	data := chunk[dataRef.OffsetBytes : dataRef.OffsetBytes+dataRef.SizeBytes]
+	if hash.EncodeHash(Hash(data)) != dataRef.Hash {
+		return errors.Wrapf(ErrChunkCorrupted, "data at offset %v does not match its hash", dataRef.OffsetBytes)
+	}
+	return nil
+}
+
+// getChunk downloads a chunk, checks it against its ID, then decrypts and
+// decompresses it and checks its data against its hash.
+func (c *Checker) getChunk(ctx context.Context, ref *Ref) ([]byte, error) {
+	id := ID(ref.Id).HexString()
+	if id == c.lastID {
+		return c.lastChunk, nil
+	}
+	buf := &bytes.Buffer{}
+	if err := c.client.Get(ctx, ref.Id, buf); err != nil {
+		return nil, err
+	}
+	chunk := buf.Bytes()
+	if !bytes.Equal(Hash(chunk), ref.Id) {
+		return nil, ErrChunkCorrupted
+	}
+	if ref.EncryptionAlgo != EncryptionAlgo_UNENCRYPTED {
+		if c.client.keys == nil {
+			return nil, errors.Errorf("chunk %v is encrypted, but encryption is not set up", id)
+		}
+		key, err := c.client.keys.key(ctx, ref.KeyId)
+		if err != nil {
+			return nil, err
+		}
+		chunk, err = decrypt(key, chunk)
+		if err != nil {
+			return nil, errors.Wrapf(ErrChunkCorrupted, "error decrypting: %v", err)
+		}
+	}
+	chunk, err := decompress(ref.CompressionAlgo, chunk)
+	if err != nil {
+		return nil, errors.Wrapf(ErrChunkCorrupted, "error decompressing: %v", err)
+	}
+	if Hash(chunk).HexString() != ref.contentHash() {
+		return nil, errors.Wrapf(ErrChunkCorrupted, "chunk data does not match its hash")
+	}
+	c.lastID, c.lastChunk = id, chunk
+	return chunk, nil
+}
@@ -22,6 +22,62 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CompressionAlgo is the algorithm a chunk is compressed with before it is
+// stored.
+type CompressionAlgo int32
+
+const (
+	CompressionAlgo_NONE            CompressionAlgo = 0
+	CompressionAlgo_GZIP_BEST_SPEED CompressionAlgo = 1
+)
+
+var CompressionAlgo_name = map[int32]string{
+	0: "NONE",
+	1: "GZIP_BEST_SPEED",
+}
+
+var CompressionAlgo_value = map[string]int32{
+	"NONE":            0,
+	"GZIP_BEST_SPEED": 1,
+}
+
+func (x CompressionAlgo) String() string {
+	return proto.EnumName(CompressionAlgo_name, int32(x))
+}
+
+func (CompressionAlgo) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b36f82a9f02ff9, []int{0}
+}
+
+// EncryptionAlgo is the algorithm a chunk is encrypted with before it is
+// stored (after compression).
+type EncryptionAlgo int32
+
+const (
+	EncryptionAlgo_UNENCRYPTED EncryptionAlgo = 0
+	// AES-256-GCM with a nonce derived from the chunk's data, so that chunks
+	// encrypted with the same data key are deduplicated.
+	EncryptionAlgo_AES_256_GCM EncryptionAlgo = 1
+)
+
+var EncryptionAlgo_name = map[int32]string{
+	0: "UNENCRYPTED",
+	1: "AES_256_GCM",
+}
+
+var EncryptionAlgo_value = map[string]int32{
+	"UNENCRYPTED": 0,
+	"AES_256_GCM": 1,
+}
+
+func (x EncryptionAlgo) String() string {
+	return proto.EnumName(EncryptionAlgo_name, int32(x))
+}
+
+func (EncryptionAlgo) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b36f82a9f02ff9, []int{1}
+}
+
 // DataRef is a reference to data within a chunk.
 type DataRef struct {
 	// The chunk the referenced data is located in.
@@ -99,9 +155,21 @@ func (m *DataRef) GetSizeBytes() int64 {
 }
 
 type Ref struct {
-	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SizeBytes            int64    `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	Edge                 bool     `protobuf:"varint,3,opt,name=edge,proto3" json:"edge,omitempty"`
+	// The ID of the chunk, which is the hash of the chunk as stored (after
+	// compression and encryption).
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The size of the chunk's data (before compression).
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Edge      bool  `protobuf:"varint,3,opt,name=edge,proto3" json:"edge,omitempty"`
+	// The algorithm the chunk is compressed with.
+	CompressionAlgo CompressionAlgo `protobuf:"varint,4,opt,name=compression_algo,json=compressionAlgo,proto3,enum=chunk.CompressionAlgo" json:"compression_algo,omitempty"`
+	// The algorithm the chunk is encrypted with, and the ID of the data key it
+	// is encrypted with.
+	EncryptionAlgo EncryptionAlgo `protobuf:"varint,5,opt,name=encryption_algo,json=encryptionAlgo,proto3,enum=chunk.EncryptionAlgo" json:"encryption_algo,omitempty"`
+	KeyId          string         `protobuf:"bytes,6,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// The hash of the chunk's data (before compression and encryption), which
+	// is the hash of data references to the whole chunk.
+	Hash                 []byte   `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -161,7 +229,37 @@ func (m *Ref) GetEdge() bool {
 	return false
 }
 
+func (m *Ref) GetCompressionAlgo() CompressionAlgo {
+	if m != nil {
+		return m.CompressionAlgo
+	}
+	return CompressionAlgo_NONE
+}
+
+func (m *Ref) GetEncryptionAlgo() EncryptionAlgo {
+	if m != nil {
+		return m.EncryptionAlgo
+	}
+	return EncryptionAlgo_UNENCRYPTED
+}
+
+func (m *Ref) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *Ref) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("chunk.CompressionAlgo", CompressionAlgo_name, CompressionAlgo_value)
+	proto.RegisterEnum("chunk.EncryptionAlgo", EncryptionAlgo_name, EncryptionAlgo_value)
 	proto.RegisterType((*DataRef)(nil), "chunk.DataRef")
 	proto.RegisterType((*Ref)(nil), "chunk.Ref")
 }
@@ -171,23 +269,33 @@ func init() {
 }
 
 var fileDescriptor_80b36f82a9f02ff9 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x4e, 0x2d, 0x2a,
-	0x4b, 0x2d, 0xd2, 0x2f, 0xc8, 0x4e, 0xd7, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0xd5, 0x4f,
-	0xce, 0x28, 0xcd, 0xcb, 0x86, 0x90, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xac, 0x60, 0x8e,
-	0x52, 0x2d, 0x17, 0xbb, 0x4b, 0x62, 0x49, 0x62, 0x50, 0x6a, 0x9a, 0x90, 0x0c, 0x17, 0x73, 0x51,
-	0x6a, 0x9a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x11, 0x97, 0x1e, 0x44, 0x71, 0x50, 0x6a, 0x5a,
-	0x10, 0x48, 0x58, 0x48, 0x88, 0x8b, 0x25, 0x23, 0xb1, 0x38, 0x43, 0x82, 0x49, 0x81, 0x51, 0x83,
-	0x33, 0x08, 0xcc, 0x16, 0x52, 0xe4, 0xe2, 0xc9, 0x4f, 0x4b, 0x2b, 0x4e, 0x2d, 0x89, 0x4f, 0xaa,
-	0x2c, 0x49, 0x2d, 0x96, 0x60, 0x56, 0x60, 0xd4, 0x60, 0x0e, 0xe2, 0x86, 0x88, 0x39, 0x81, 0x84,
-	0x84, 0x64, 0xb9, 0xb8, 0x8a, 0x33, 0xab, 0x52, 0xa1, 0x0a, 0x58, 0xc0, 0x0a, 0x38, 0x41, 0x22,
-	0x60, 0x69, 0x25, 0x0f, 0x2e, 0x66, 0x90, 0xd5, 0x7c, 0x5c, 0x4c, 0x99, 0x29, 0x60, 0x9b, 0x79,
-	0x82, 0x98, 0x32, 0x53, 0xd0, 0x74, 0x31, 0xa1, 0xe9, 0x02, 0xb9, 0x25, 0x35, 0x25, 0x3d, 0x15,
-	0x6c, 0x1f, 0x47, 0x10, 0x98, 0xed, 0xe4, 0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x46, 0x59, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7,
-	0xea, 0x17, 0x24, 0x26, 0x67, 0x54, 0xa6, 0xa4, 0x16, 0x21, 0xb3, 0x8a, 0x8b, 0x92, 0xf5, 0x71,
-	0x05, 0x53, 0x12, 0x1b, 0x38, 0x84, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x31, 0x4d,
-	0xd5, 0x49, 0x01, 0x00, 0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0x6b, 0xdb, 0x40,
+	0x10, 0xcd, 0x5a, 0xb6, 0x93, 0x8c, 0x83, 0x24, 0xb6, 0xa4, 0xe8, 0xd0, 0x1a, 0x37, 0xf4, 0x60,
+	0x72, 0xb0, 0x8a, 0x4a, 0x7b, 0x29, 0x14, 0xfc, 0xb1, 0x04, 0x1f, 0xaa, 0x9a, 0x75, 0x7a, 0x68,
+	0x2e, 0x42, 0x96, 0x46, 0x1f, 0x28, 0xf1, 0x8a, 0x5d, 0xa5, 0xa0, 0x42, 0xff, 0x5f, 0x8f, 0xfd,
+	0x09, 0xc5, 0x3f, 0xa2, 0xe7, 0xa2, 0xb5, 0x71, 0x6b, 0x43, 0x2e, 0xcb, 0xdb, 0x37, 0x33, 0xef,
+	0xcd, 0x0c, 0x03, 0xaf, 0x15, 0xca, 0x6f, 0x28, 0xdd, 0xb2, 0x48, 0x5d, 0x55, 0x09, 0x19, 0xa6,
+	0xe8, 0x46, 0xd9, 0xe3, 0xba, 0xd8, 0xbe, 0xa3, 0x52, 0x8a, 0x4a, 0xd0, 0x8e, 0xfe, 0x5c, 0xfd,
+	0x80, 0xd3, 0x59, 0x58, 0x85, 0x1c, 0x13, 0xfa, 0x02, 0x0c, 0x89, 0x89, 0x43, 0x06, 0x64, 0xd8,
+	0xf3, 0x60, 0xb4, 0x4d, 0xe6, 0x98, 0xf0, 0x86, 0xa6, 0x14, 0xda, 0x59, 0xa8, 0x32, 0xa7, 0x35,
+	0x20, 0xc3, 0x73, 0xae, 0x31, 0x7d, 0x05, 0x17, 0x22, 0x49, 0x14, 0x56, 0xc1, 0xaa, 0xae, 0x50,
+	0x39, 0xc6, 0x80, 0x0c, 0x0d, 0xde, 0xdb, 0x72, 0x93, 0x86, 0xa2, 0x2f, 0x01, 0x54, 0xfe, 0x1d,
+	0x77, 0x09, 0x6d, 0x9d, 0x70, 0xde, 0x30, 0x3a, 0x7c, 0xf5, 0x87, 0x80, 0xd1, 0x78, 0x9b, 0xd0,
+	0xca, 0x63, 0x6d, 0x7d, 0xc1, 0x5b, 0x79, 0x7c, 0x54, 0xd6, 0x3a, 0x2a, 0x6b, 0x9a, 0xc1, 0x38,
+	0x45, 0x6d, 0x78, 0xc6, 0x35, 0xa6, 0x63, 0xb0, 0x23, 0xf1, 0x50, 0x4a, 0x54, 0x2a, 0x17, 0xeb,
+	0x20, 0xbc, 0x4f, 0x85, 0xf6, 0x33, 0xbd, 0xe7, 0xbb, 0x59, 0xa6, 0xff, 0xc2, 0xe3, 0xfb, 0x54,
+	0x70, 0x2b, 0x3a, 0x24, 0xe8, 0x47, 0xb0, 0x70, 0x1d, 0xc9, 0xba, 0xac, 0xf6, 0x0a, 0x1d, 0xad,
+	0x70, 0xb9, 0x53, 0x60, 0xfb, 0xa8, 0x16, 0x30, 0xf1, 0xe0, 0x4f, 0x2f, 0xa1, 0x5b, 0x60, 0x1d,
+	0xe4, 0xb1, 0xd3, 0xd5, 0x5b, 0xea, 0x14, 0x58, 0xcf, 0xe3, 0xfd, 0xea, 0x4e, 0xf5, 0x78, 0x1a,
+	0x5f, 0xbf, 0x01, 0xeb, 0xa8, 0x1d, 0x7a, 0x06, 0x6d, 0xff, 0xb3, 0xcf, 0xec, 0x13, 0xfa, 0x0c,
+	0xac, 0x9b, 0xbb, 0xf9, 0x22, 0x98, 0xb0, 0xe5, 0x6d, 0xb0, 0x5c, 0x30, 0x36, 0xb3, 0xc9, 0xb5,
+	0x07, 0xe6, 0xa1, 0x3d, 0xb5, 0xa0, 0xf7, 0xc5, 0x67, 0xfe, 0x94, 0x7f, 0x5d, 0xdc, 0xb2, 0x99,
+	0x7d, 0xd2, 0x10, 0x63, 0xb6, 0x0c, 0xbc, 0x77, 0xef, 0x83, 0x9b, 0xe9, 0x27, 0x9b, 0x4c, 0xe6,
+	0x3f, 0x37, 0x7d, 0xf2, 0x6b, 0xd3, 0x27, 0xbf, 0x37, 0x7d, 0x72, 0xf7, 0x21, 0xcd, 0xab, 0xec,
+	0x71, 0x35, 0x8a, 0xc4, 0x83, 0x5b, 0x86, 0x51, 0x56, 0xc7, 0x28, 0xff, 0x47, 0x4a, 0x46, 0xee,
+	0x53, 0xb7, 0xb3, 0xea, 0xea, 0xb3, 0x79, 0xfb, 0x77, 0x00, 0x1e, 0x32, 0xc2, 0x36, 0x5e, 0x02,
+	0x00, 0x00,
 }
 
 func (m *DataRef) Marshal() (dAtA []byte, err error) {
@@ -270,6 +378,30 @@ func (m *Ref) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintChunk(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintChunk(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EncryptionAlgo != 0 {
+		i = encodeVarintChunk(dAtA, i, uint64(m.EncryptionAlgo))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CompressionAlgo != 0 {
+		i = encodeVarintChunk(dAtA, i, uint64(m.CompressionAlgo))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Edge {
 		i--
 		if m.Edge {
@@ -348,6 +480,20 @@ func (m *Ref) Size() (n int) {
 	if m.Edge {
 		n += 2
 	}
+	if m.CompressionAlgo != 0 {
+		n += 1 + sovChunk(uint64(m.CompressionAlgo))
+	}
+	if m.EncryptionAlgo != 0 {
+		n += 1 + sovChunk(uint64(m.EncryptionAlgo))
+	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovChunk(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovChunk(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -619,6 +765,110 @@ func (m *Ref) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Edge = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionAlgo", wireType)
+			}
+			m.CompressionAlgo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionAlgo |= CompressionAlgo(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionAlgo", wireType)
+			}
+			m.EncryptionAlgo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EncryptionAlgo |= EncryptionAlgo(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChunk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChunk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChunk(dAtA[iNdEx:])
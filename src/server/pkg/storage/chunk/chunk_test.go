@@ -4,8 +4,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"math/rand"
+	"path"
 	"testing"
+	"time"
 
 	"github.com/chmduquesne/rollinghash/buzhash64"
 	units "github.com/docker/go-units"
@@ -48,6 +52,240 @@ func TestWriteThenRead(t *testing.T) {
 	}
 }
 
+func TestReadRange(t *testing.T) {
+	_, chunks := newTestStorage(t)
+	msg := random.SeedRand()
+	for _, test := range tests {
+		t.Run(test.name(), func(t *testing.T) {
+			as := generateAnnotations(test)
+			writeAnnotations(t, chunks, as, msg)
+			for _, a := range as {
+				offset := rand.Intn(len(a.data) + 1)
+				size := rand.Intn(len(a.data) - offset + 1)
+				r := chunks.NewReader(context.Background(), a.dataRefs, WithRange(int64(offset), int64(size)))
+				buf := &bytes.Buffer{}
+				require.NoError(t, r.Get(buf), msg)
+				expected := a.data[offset:]
+				if size > 0 {
+					expected = expected[:size]
+				}
+				require.Equal(t, 0, bytes.Compare(expected, buf.Bytes()), msg)
+			}
+		})
+	}
+}
+
+func TestCompression(t *testing.T) {
+	objC, chunks := newTestStorage(t)
+	compressedChunks := NewStorage(objC, chunks.mdstore, chunks.tracker, WithCompression(CompressionAlgo_GZIP_BEST_SPEED))
+	msg := random.SeedRand()
+	test := test{1 * units.KB, 10 * units.MB}
+	as := generateAnnotations(test)
+	writeAnnotations(t, compressedChunks, as, msg)
+	readAnnotations(t, compressedChunks, as, msg)
+	// Check that the chunks are recorded as compressed, that the hashes of
+	// the data references are the hashes of the uncompressed data (which
+	// passes verification), and that the chunks are stored in less space than
+	// their data.
+	checker := compressedChunks.NewChecker(true)
+	for _, a := range as {
+		for _, dataRef := range a.dataRefs {
+			require.Equal(t, CompressionAlgo_GZIP_BEST_SPEED, dataRef.Ref.CompressionAlgo, msg)
+			require.NoError(t, checker.Check(context.Background(), dataRef), msg)
+		}
+		if len(a.dataRefs) == 1 {
+			require.Equal(t, Hash(a.data).HexString(), a.dataRefs[0].Hash, msg)
+		}
+	}
+	var storedSize int64
+	require.NoError(t, chunks.List(context.Background(), func(p string) error {
+		r, err := objC.Reader(context.Background(), p, 0, 0)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		n, err := io.Copy(ioutil.Discard, r)
+		storedSize += n
+		return err
+	}), msg)
+	require.True(t, storedSize < int64(test.n), msg)
+	// Check that uncompressed chunks can be copied into compressed chunks, and
+	// that the result can be read back by an uncompressed storage (the
+	// compression algorithm is recorded in the chunk references).
+	as = generateAnnotations(test)
+	writeAnnotations(t, chunks, as, msg)
+	cb := func(annotations []*Annotation) error {
+		for _, a := range annotations {
+			testA := a.Data.(*testAnnotation)
+			if a.NextDataRef != nil {
+				testA.dataRefs = append(testA.dataRefs, a.NextDataRef)
+			}
+		}
+		return nil
+	}
+	w := compressedChunks.NewWriter(context.Background(), uuid.NewWithoutDashes(), cb)
+	copyAnnotations(t, compressedChunks, w, as, msg)
+	require.NoError(t, w.Close(), msg)
+	readAnnotations(t, chunks, as, msg)
+}
+
+func TestEncryption(t *testing.T) {
+	db := dbutil.NewTestDB(t)
+	keys := NewTestKeyStore(t, db)
+	master := newTestMasterKey(t)
+	objC, chunks := NewTestStorage(t, db, track.NewTestTracker(t, db), WithEncryption(keys, master))
+	msg := random.SeedRand()
+	test := test{1 * units.KB, 1 * units.MB}
+	as := generateAnnotations(test)
+	writeAnnotations(t, chunks, as, msg)
+	readAnnotations(t, chunks, as, msg)
+	for _, a := range as {
+		for _, dataRef := range a.dataRefs {
+			require.Equal(t, EncryptionAlgo_AES_256_GCM, dataRef.Ref.EncryptionAlgo, msg)
+			require.NotEqual(t, "", dataRef.Ref.KeyId, msg)
+		}
+	}
+	// Check that encrypted chunks cannot be read without the data keys.
+	plainChunks := NewStorage(objC, chunks.mdstore, chunks.tracker)
+	r := plainChunks.NewReader(context.Background(), as[0].dataRefs)
+	require.YesError(t, r.Get(ioutil.Discard), msg)
+	// Check that the same data is deduplicated within a key domain, but not
+	// across key domains.
+	chunkIDs := func(domain string) []string {
+		as := cloneAnnotations(as)
+		writeAnnotations(t, chunks, as, msg, WithKeyDomain(domain))
+		var ids []string
+		for _, a := range as {
+			for _, dataRef := range a.dataRefs {
+				ids = append(ids, ID(dataRef.Ref.Id).HexString())
+			}
+		}
+		return ids
+	}
+	idsA := chunkIDs("a")
+	require.Equal(t, idsA, chunkIDs("a"), msg)
+	idsB := chunkIDs("b")
+	require.Equal(t, len(idsA), len(idsB), msg)
+	for i := range idsA {
+		require.NotEqual(t, idsA[i], idsB[i], msg)
+	}
+	// Check that the chunks can be read after the master key is rotated, and
+	// that the previous master key is not needed once the data keys are
+	// re-wrapped.
+	newMaster := newTestMasterKey(t)
+	rotatedChunks := NewStorage(objC, chunks.mdstore, chunks.tracker, WithEncryption(keys, newMaster, master))
+	readAnnotations(t, rotatedChunks, as, msg)
+	n, err := rotatedChunks.RewrapDataKeys(context.Background())
+	require.NoError(t, err, msg)
+	require.Equal(t, 3, n, msg)
+	rotatedChunks = NewStorage(objC, chunks.mdstore, chunks.tracker, WithEncryption(keys, newMaster))
+	readAnnotations(t, rotatedChunks, as, msg)
+	// Check that the chunks cannot be read with only the previous master key.
+	oldChunks := NewStorage(objC, chunks.mdstore, chunks.tracker, WithEncryption(keys, master))
+	r = oldChunks.NewReader(context.Background(), as[0].dataRefs)
+	require.YesError(t, r.Get(ioutil.Discard), msg)
+}
+
+func newTestMasterKey(t *testing.T) *MasterKey {
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	mk, err := NewMasterKey(key)
+	require.NoError(t, err)
+	return mk
+}
+
+func cloneAnnotations(as []*testAnnotation) []*testAnnotation {
+	var clones []*testAnnotation
+	for _, a := range as {
+		clones = append(clones, &testAnnotation{data: a.data})
+	}
+	return clones
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	objC, chunks := newTestStorage(t)
+	msg := random.SeedRand()
+	as := generateAnnotations(test{1 * units.KB, 1 * units.MB})
+	writeAnnotations(t, chunks, as, msg)
+	// Delete a chunk from object storage, and upload a chunk that is not tracked.
+	missing := ID(as[0].dataRefs[0].Ref.Id)
+	require.NoError(t, objC.Delete(ctx, chunkPath(missing)), msg)
+	data := RandSeq(100)
+	untracked := Hash(data)
+	w, err := objC.Writer(ctx, chunkPath(untracked))
+	require.NoError(t, err, msg)
+	_, err = w.Write(data)
+	require.NoError(t, err, msg)
+	require.NoError(t, w.Close(), msg)
+	reconcile := func(gracePeriod time.Duration, fix bool) map[OrphanType]*Orphan {
+		orphans := make(map[OrphanType]*Orphan)
+		require.NoError(t, chunks.Reconcile(ctx, gracePeriod, fix, func(orphan *Orphan) error {
+			orphans[orphan.Type] = orphan
+			return nil
+		}), msg)
+		return orphans
+	}
+	// The missing chunk is not reported until its tracker object is older
+	// than the grace period.
+	orphans := reconcile(time.Hour, false)
+	require.Equal(t, 1, len(orphans), msg)
+	require.Equal(t, untracked, orphans[UntrackedChunk].ChunkID, msg)
+	orphans = reconcile(0, false)
+	require.Equal(t, 2, len(orphans), msg)
+	require.Equal(t, untracked, orphans[UntrackedChunk].ChunkID, msg)
+	require.Equal(t, missing, orphans[MissingChunk].ChunkID, msg)
+	// The untracked chunk is fixed by tracking it, the missing chunk can't be
+	// fixed because it is still referenced.
+	orphans = reconcile(0, true)
+	require.Equal(t, 2, len(orphans), msg)
+	require.True(t, orphans[UntrackedChunk].Fixed, msg)
+	require.False(t, orphans[MissingChunk].Fixed, msg)
+	exists, err := chunks.tracker.Exists(ctx, ObjectID(untracked))
+	require.NoError(t, err, msg)
+	require.True(t, exists, msg)
+	orphans = reconcile(0, false)
+	require.Equal(t, 1, len(orphans), msg)
+	require.Equal(t, missing, orphans[MissingChunk].ChunkID, msg)
+}
+
+func TestCopyObjects(t *testing.T) {
+	ctx := context.Background()
+	src, dst := obj.NewTestClient(t), obj.NewTestClient(t)
+	writeObject := func(objC obj.Client, p string, data []byte) {
+		w, err := objC.Writer(ctx, p)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+	}
+	var ids []ID
+	for i := 0; i < 3; i++ {
+		data := RandSeq(100)
+		id := Hash(data)
+		writeObject(src, chunkPath(id), data)
+		ids = append(ids, id)
+	}
+	// Chunks that already exist in the destination are not copied.
+	writeObject(dst, path.Join("backup", chunkPath(ids[0])), []byte("existing"))
+	copied := make(map[string]bool)
+	require.NoError(t, CopyObjects(ctx, src, dst, "backup", func(p string) error {
+		copied[p] = true
+		return nil
+	}))
+	require.Equal(t, map[string]bool{chunkPath(ids[1]): true, chunkPath(ids[2]): true}, copied)
+	for _, id := range ids[1:] {
+		buf := &bytes.Buffer{}
+		r, err := dst.Reader(ctx, path.Join("backup", chunkPath(id)), 0, 0)
+		require.NoError(t, err)
+		_, err = io.Copy(buf, r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, id, Hash(buf.Bytes()))
+	}
+}
+
 func TestCopy(t *testing.T) {
 	_, chunks := newTestStorage(t)
 	msg := random.SeedRand()
@@ -143,7 +381,7 @@ func generateAnnotations(t test) []*testAnnotation {
 	return as
 }
 
-func writeAnnotations(t *testing.T, chunks *Storage, annotations []*testAnnotation, msg string) {
+func writeAnnotations(t *testing.T, chunks *Storage, annotations []*testAnnotation, msg string, opts ...WriterOption) {
 	t.Run("Write", func(t *testing.T) {
 		cb := func(annotations []*Annotation) error {
 			for _, a := range annotations {
@@ -155,7 +393,7 @@ func writeAnnotations(t *testing.T, chunks *Storage, annotations []*testAnnotati
 			}
 			return nil
 		}
-		w := chunks.NewWriter(context.Background(), uuid.NewWithoutDashes(), cb)
+		w := chunks.NewWriter(context.Background(), uuid.NewWithoutDashes(), cb, opts...)
 		for _, a := range annotations {
 			require.NoError(t, w.Annotate(&Annotation{
 				Data: a,
@@ -195,8 +433,8 @@ func readAnnotations(t *testing.T, chunks *Storage, annotations []*testAnnotatio
 
 // newTestStorage is like NewTestStorage except it doesn't need an external tracker
 // it is for testing this package, not for reuse.
-func newTestStorage(t testing.TB) (obj.Client, *Storage) {
+func newTestStorage(t testing.TB, opts ...StorageOption) (obj.Client, *Storage) {
 	db := dbutil.NewTestDB(t)
 	tr := track.NewTestTracker(t, db)
-	return NewTestStorage(t, db, tr)
+	return NewTestStorage(t, db, tr, opts...)
 }
@@ -1,6 +1,7 @@
 package chunk
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"io/ioutil"
@@ -11,6 +12,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/track"
+	"github.com/sirupsen/logrus"
 )
 
 // Client allows manipulation of individual chunks, by maintaining consistency between
@@ -21,6 +23,12 @@ type Client struct {
 	tracker track.Tracker
 	renewer *track.Renewer
 	ttl     time.Duration
+	// keys is the keyring used for encrypting and decrypting chunks, it is
+	// nil if encryption is not set up.
+	keys *keyring
+	// cache is the local disk cache for chunks, it is nil if caching is not
+	// set up.
+	cache *Cache
 }
 
 // NewClient returns a client which will write to objc, mdstore, and tracker.  Name is used
@@ -86,6 +94,13 @@ func (c *Client) Create(ctx context.Context, md Metadata, r io.Reader) (_ ID, re
 
 // Get writes data for a chunk with ID chunkID to w.
 func (c *Client) Get(ctx context.Context, chunkID ID, w io.Writer) (retErr error) {
+	if c.cache != nil {
+		return c.getCached(ctx, chunkID, w)
+	}
+	return c.getObject(ctx, chunkID, w)
+}
+
+func (c *Client) getObject(ctx context.Context, chunkID ID, w io.Writer) (retErr error) {
 	p := chunkPath(chunkID)
 	objR, err := c.objc.Reader(ctx, p, 0, 0)
 	if err != nil {
@@ -100,6 +115,22 @@ func (c *Client) Get(ctx context.Context, chunkID ID, w io.Writer) (retErr error
 	return err
 }
 
+func (c *Client) getCached(ctx context.Context, chunkID ID, w io.Writer) error {
+	data, ok := c.cache.Get(chunkID)
+	if !ok {
+		buf := &bytes.Buffer{}
+		if err := c.getObject(ctx, chunkID, buf); err != nil {
+			return err
+		}
+		data = buf.Bytes()
+		if err := c.cache.Put(chunkID, data); err != nil {
+			logrus.Warnf("could not add chunk %v to the chunk cache: %v", chunkID.HexString(), err)
+		}
+	}
+	_, err := w.Write(data)
+	return err
+}
+
 // Close closes the client, stopping the background renewal of created objects
 func (c *Client) Close() error {
 	if c.renewer != nil {
@@ -121,12 +152,32 @@ func ObjectID(chunkID ID) string {
 }
 
 var _ track.Deleter = &deleter{}
+var _ track.Sizer = &deleter{}
 
 type deleter struct {
 	mdstore MetadataStore
 	objc    obj.Client
 }
 
+// Size returns the size of the chunk's data (before compression).
+func (d *deleter) Size(ctx context.Context, id string) (int64, error) {
+	if !strings.HasPrefix(id, prefix+"/") {
+		return 0, errors.Errorf("cannot size (%s)", id)
+	}
+	chunkID, err := IDFromHex(id[len(TrackerPrefix):])
+	if err != nil {
+		return 0, err
+	}
+	md, err := d.mdstore.Get(ctx, chunkID)
+	if err != nil {
+		if errors.Is(err, ErrChunkNotExists) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return int64(md.Size), nil
+}
+
 func (d *deleter) Delete(ctx context.Context, id string) error {
 	if !strings.HasPrefix(id, prefix+"/") {
 		return errors.Errorf("cannot delete (%s)", id)
@@ -135,7 +186,9 @@ func (d *deleter) Delete(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
-	if err := d.objc.Delete(ctx, chunkPath(chunkID)); err != nil {
+	// The chunk may already be missing from object storage, in which case
+	// only the metadata needs to be deleted.
+	if err := d.objc.Delete(ctx, chunkPath(chunkID)); err != nil && !d.objc.IsNotExist(err) {
 		return err
 	}
 	return d.mdstore.Delete(ctx, chunkID)
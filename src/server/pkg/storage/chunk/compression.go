@@ -0,0 +1,81 @@
+package chunk
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"sync"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// compress compresses a chunk's data with the passed in algorithm.
+func compress(algo CompressionAlgo, data []byte) ([]byte, error) {
+	switch algo {
+	case CompressionAlgo_NONE:
+		return data, nil
+	case CompressionAlgo_GZIP_BEST_SPEED:
+		buf := &bytes.Buffer{}
+		gw, err := gzip.NewWriterLevel(buf, gzip.BestSpeed)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := gw.Write(data); err != nil {
+			return nil, err
+		}
+		if err := gw.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, errors.Errorf("unrecognized compression algorithm: %v", algo)
+	}
+}
+
+// decompress decompresses a chunk's data with the passed in algorithm.
+func decompress(algo CompressionAlgo, data []byte) ([]byte, error) {
+	switch algo {
+	case CompressionAlgo_NONE:
+		return data, nil
+	case CompressionAlgo_GZIP_BEST_SPEED:
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		return ioutil.ReadAll(gr)
+	default:
+		return nil, errors.Errorf("unrecognized compression algorithm: %v", algo)
+	}
+}
+
+var (
+	uploadBytes         *prometheus.CounterVec
+	registerUploadBytes sync.Once
+)
+
+// reportUpload records the size of an uploaded chunk's data before (logical)
+// and after (stored) compression.
+func reportUpload(algo CompressionAlgo, logicalSize, storedSize int) {
+	registerUploadBytes.Do(func() {
+		uploadBytes = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "pachyderm",
+				Subsystem: "storage_chunk",
+				Name:      "upload_bytes",
+				Help:      "bytes of chunk data uploaded, count by compression algorithm and size type (logical or stored)",
+			},
+			[]string{"compression", "size"},
+		)
+		if err := prometheus.Register(uploadBytes); err != nil {
+			// metrics may be redundantly registered; ignore these errors
+			if !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+				logrus.Infof("error registering prometheus metric: %v", err)
+			}
+		}
+	})
+	uploadBytes.WithLabelValues(algo.String(), "logical").Add(float64(logicalSize))
+	uploadBytes.WithLabelValues(algo.String(), "stored").Add(float64(storedSize))
+}
@@ -0,0 +1,50 @@
+package chunk
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+)
+
+// CopyObjects copies the chunk objects in src to dst, under dstPrefix. The
+// chunks that already exist in dst are skipped, and cb is called with the
+// path of each chunk that is copied. The copied chunks are not tracked in
+// dst, so they are a copy of the objects rather than of a chunk storage.
+func CopyObjects(ctx context.Context, src, dst obj.Client, dstPrefix string, cb func(string) error) error {
+	return src.Walk(ctx, prefix, func(p string) error {
+		dstPath := path.Join(dstPrefix, p)
+		if dst.Exists(ctx, dstPath) {
+			return nil
+		}
+		if err := copyObject(ctx, src, dst, p, dstPath); err != nil {
+			return err
+		}
+		return cb(p)
+	})
+}
+
+func copyObject(ctx context.Context, src, dst obj.Client, srcPath, dstPath string) (retErr error) {
+	r, err := src.Reader(ctx, srcPath, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	w, err := dst.Writer(ctx, dstPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := w.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = io.Copy(w, r)
+	return errors.EnsureStack(err)
+}
@@ -0,0 +1,257 @@
+package chunk
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+const (
+	keySize = 32
+	// DefaultKeyDomain is the key domain of chunks written without a key
+	// domain.
+	DefaultKeyDomain = "default"
+)
+
+// MasterKey is a key that wraps (encrypts) the data keys that chunks are
+// encrypted with. The master key is never stored, only the ID derived from it.
+type MasterKey struct {
+	ID  string
+	key []byte
+}
+
+// NewMasterKey creates a master key from 32 bytes of key material.
+func NewMasterKey(key []byte) (*MasterKey, error) {
+	if len(key) != keySize {
+		return nil, errors.Errorf("master key must be %v bytes, got %v", keySize, len(key))
+	}
+	h := sha256.Sum256(key)
+	return &MasterKey{
+		ID:  hex.EncodeToString(h[:8]),
+		key: key,
+	}, nil
+}
+
+// ParseMasterKey creates a master key from base64 encoded key material.
+func ParseMasterKey(s string) (*MasterKey, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding master key")
+	}
+	return NewMasterKey(key)
+}
+
+func (mk *MasterKey) wrap(dataKey []byte) ([]byte, error) {
+	gcm, err := newGCM(mk.key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+func (mk *MasterKey) unwrap(wrappedKey []byte) ([]byte, error) {
+	gcm, err := newGCM(mk.key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < gcm.NonceSize() {
+		return nil, errors.Errorf("wrapped data key is too short")
+	}
+	nonce, ciphertext := wrappedKey[:gcm.NonceSize()], wrappedKey[gcm.NonceSize():]
+	dataKey, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unwrapping data key with master key %v", mk.ID)
+	}
+	return dataKey, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encrypt encrypts a chunk's data with a data key. The nonce is derived from
+// the data (and the key), so the same data encrypted with the same key
+// results in the same chunk, which allows chunks to be deduplicated within a
+// key domain.
+func encrypt(dataKey, data []byte) ([]byte, error) {
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, dataKey)
+	mac.Write(data)
+	nonce := mac.Sum(nil)[:gcm.NonceSize()]
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+
+// decrypt decrypts a chunk's data with a data key.
+func decrypt(dataKey, data []byte) ([]byte, error) {
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.Errorf("encrypted chunk is too short")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// keyring manages the data keys that chunks are encrypted with. There is a
+// data key for each key domain, which is created when it is first used.
+type keyring struct {
+	store    KeyStore
+	master   *MasterKey
+	previous map[string]*MasterKey
+
+	mu       sync.Mutex
+	keys     map[string][]byte
+	domainID map[string]string
+}
+
+func newKeyring(store KeyStore, master *MasterKey, previous ...*MasterKey) *keyring {
+	kr := &keyring{
+		store:    store,
+		master:   master,
+		previous: make(map[string]*MasterKey),
+		keys:     make(map[string][]byte),
+		domainID: make(map[string]string),
+	}
+	for _, mk := range previous {
+		kr.previous[mk.ID] = mk
+	}
+	return kr
+}
+
+// domainKey returns the ID and data key for a key domain, creating the data
+// key if it does not exist.
+func (kr *keyring) domainKey(ctx context.Context, domain string) (string, []byte, error) {
+	if domain == "" {
+		domain = DefaultKeyDomain
+	}
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	if id, ok := kr.domainID[domain]; ok {
+		return id, kr.keys[id], nil
+	}
+	dk, err := kr.store.GetByDomain(ctx, domain)
+	if err != nil {
+		if !errors.Is(err, ErrDataKeyNotExists) {
+			return "", nil, err
+		}
+		dk, err = kr.createDataKey(ctx, domain)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	key, err := kr.unwrap(dk)
+	if err != nil {
+		return "", nil, err
+	}
+	kr.domainID[domain] = dk.ID
+	kr.keys[dk.ID] = key
+	return dk.ID, key, nil
+}
+
+func (kr *keyring) createDataKey(ctx context.Context, domain string) (*DataKey, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	wrappedKey, err := kr.master.wrap(key)
+	if err != nil {
+		return nil, err
+	}
+	dk := &DataKey{
+		ID:          uuid.NewWithoutDashes(),
+		Domain:      domain,
+		MasterKeyID: kr.master.ID,
+		WrappedKey:  wrappedKey,
+	}
+	if err := kr.store.Create(ctx, dk); err != nil {
+		if !errors.Is(err, ErrDataKeyExists) {
+			return nil, err
+		}
+		// Another writer created the data key for the domain first.
+		return kr.store.GetByDomain(ctx, domain)
+	}
+	return dk, nil
+}
+
+// key returns the data key with the passed in ID.
+func (kr *keyring) key(ctx context.Context, id string) ([]byte, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	if key, ok := kr.keys[id]; ok {
+		return key, nil
+	}
+	dk, err := kr.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	key, err := kr.unwrap(dk)
+	if err != nil {
+		return nil, err
+	}
+	kr.keys[id] = key
+	return key, nil
+}
+
+func (kr *keyring) unwrap(dk *DataKey) ([]byte, error) {
+	mk := kr.master
+	if dk.MasterKeyID != mk.ID {
+		var ok bool
+		mk, ok = kr.previous[dk.MasterKeyID]
+		if !ok {
+			return nil, errors.Errorf("data key %v is wrapped with unknown master key %v", dk.ID, dk.MasterKeyID)
+		}
+	}
+	return mk.unwrap(dk.WrappedKey)
+}
+
+// rewrap re-wraps the data keys that are wrapped with a previous master key
+// with the current master key. Chunks are encrypted with the data keys, so
+// they do not need to be rewritten.
+func (kr *keyring) rewrap(ctx context.Context) (int, error) {
+	var dks []*DataKey
+	if err := kr.store.List(ctx, func(dk *DataKey) error {
+		if dk.MasterKeyID != kr.master.ID {
+			dks = append(dks, dk)
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	for _, dk := range dks {
+		key, err := kr.unwrap(dk)
+		if err != nil {
+			return 0, err
+		}
+		wrappedKey, err := kr.master.wrap(key)
+		if err != nil {
+			return 0, err
+		}
+		if err := kr.store.Rewrap(ctx, dk.ID, dk.MasterKeyID, kr.master.ID, wrappedKey); err != nil {
+			return 0, err
+		}
+	}
+	return len(dks), nil
+}
@@ -0,0 +1,126 @@
+package chunk
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+// DataKey is a key that chunks are encrypted with, wrapped (encrypted) with a
+// master key.
+type DataKey struct {
+	ID          string `db:"id"`
+	Domain      string `db:"domain"`
+	MasterKeyID string `db:"master_key_id"`
+	WrappedKey  []byte `db:"wrapped_key"`
+}
+
+var (
+	// ErrDataKeyExists data key exists
+	ErrDataKeyExists = errors.Errorf("data key exists")
+	// ErrDataKeyNotExists data key does not exist
+	ErrDataKeyNotExists = errors.Errorf("data key does not exist")
+)
+
+// KeyStore stores wrapped data keys
+type KeyStore interface {
+	// Create adds a data key. It errors with ErrDataKeyExists if a data key
+	// already exists for the domain.
+	Create(ctx context.Context, dk *DataKey) error
+	// Get returns the data key with the passed in ID.
+	Get(ctx context.Context, id string) (*DataKey, error)
+	// GetByDomain returns the data key for a domain.
+	GetByDomain(ctx context.Context, domain string) (*DataKey, error)
+	// List calls cb with each data key.
+	List(ctx context.Context, cb func(*DataKey) error) error
+	// Rewrap replaces the wrapped key of a data key wrapped with oldMasterKeyID.
+	Rewrap(ctx context.Context, id, oldMasterKeyID, newMasterKeyID string, wrappedKey []byte) error
+}
+
+var _ KeyStore = &postgresKeyStore{}
+
+type postgresKeyStore struct {
+	db *sqlx.DB
+}
+
+// NewPostgresKeyStore returns a KeyStore backed by db
+func NewPostgresKeyStore(db *sqlx.DB) KeyStore {
+	return &postgresKeyStore{db: db}
+}
+
+func (s *postgresKeyStore) Create(ctx context.Context, dk *DataKey) error {
+	res, err := s.db.ExecContext(ctx,
+		`INSERT INTO storage.data_keys (id, domain, master_key_id, wrapped_key) VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING
+		`, dk.ID, dk.Domain, dk.MasterKeyID, dk.WrappedKey)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrDataKeyExists
+	}
+	return nil
+}
+
+func (s *postgresKeyStore) Get(ctx context.Context, id string) (*DataKey, error) {
+	return s.get(ctx, `SELECT id, domain, master_key_id, wrapped_key FROM storage.data_keys WHERE id = $1`, id)
+}
+
+func (s *postgresKeyStore) GetByDomain(ctx context.Context, domain string) (*DataKey, error) {
+	return s.get(ctx, `SELECT id, domain, master_key_id, wrapped_key FROM storage.data_keys WHERE domain = $1`, domain)
+}
+
+func (s *postgresKeyStore) get(ctx context.Context, query string, arg interface{}) (*DataKey, error) {
+	dk := &DataKey{}
+	if err := s.db.GetContext(ctx, dk, query, arg); err != nil {
+		if err == sql.ErrNoRows {
+			err = ErrDataKeyNotExists
+		}
+		return nil, err
+	}
+	return dk, nil
+}
+
+func (s *postgresKeyStore) List(ctx context.Context, cb func(*DataKey) error) error {
+	rows, err := s.db.QueryxContext(ctx, `SELECT id, domain, master_key_id, wrapped_key FROM storage.data_keys`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		dk := &DataKey{}
+		if err := rows.StructScan(dk); err != nil {
+			return err
+		}
+		if err := cb(dk); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (s *postgresKeyStore) Rewrap(ctx context.Context, id, oldMasterKeyID, newMasterKeyID string, wrappedKey []byte) error {
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE storage.data_keys SET master_key_id = $1, wrapped_key = $2 WHERE id = $3 AND master_key_id = $4`,
+		newMasterKeyID, wrappedKey, id, oldMasterKeyID)
+	return err
+}
+
+// SetupPostgresKeyStoreV0 sets up the table for the postgres key store
+func SetupPostgresKeyStoreV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE storage.data_keys (
+		id VARCHAR(64) PRIMARY KEY,
+		domain VARCHAR(250) NOT NULL UNIQUE,
+		master_key_id VARCHAR(64) NOT NULL,
+		wrapped_key BYTEA NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);`)
+	return err
+}
@@ -32,6 +32,17 @@ func (id ID) HexString() string {
 	return hex.EncodeToString(id)
 }
 
+// contentHash returns the hash of the chunk's data, which is the hash of data
+// references to the whole chunk.
+func (r *Ref) contentHash() string {
+	if len(r.Hash) == 0 {
+		// Chunks written before the hash of their data was recorded are
+		// neither compressed nor encrypted, so their ID is the hash.
+		return ID(r.Id).HexString()
+	}
+	return ID(r.Hash).HexString()
+}
+
 // Metadata holds metadata about a chunk
 type Metadata struct {
 	Size     int
@@ -86,7 +97,7 @@ func (s *postgresStore) Set(ctx context.Context, chunkID ID, md Metadata) error
 
 func (s *postgresStore) Get(ctx context.Context, chunkID ID) (*Metadata, error) {
 	type chunkRow struct {
-		size int `db:"size"`
+		Size int `db:"size"`
 	}
 	var x chunkRow
 	if err := s.db.GetContext(ctx, &x, `SELECT size FROM storage.chunks WHERE hash_id = $1`, chunkID); err != nil {
@@ -96,7 +107,7 @@ func (s *postgresStore) Get(ctx context.Context, chunkID ID) (*Metadata, error)
 		return nil, err
 	}
 	return &Metadata{
-		Size: x.size,
+		Size: x.Size,
 	}, nil
 }
 
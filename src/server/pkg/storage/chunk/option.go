@@ -33,6 +33,35 @@ func WithObjectCache(fastLayer obj.Client, size int) StorageOption {
 	}
 }
 
+// WithCache sets up a local disk cache for the chunks read by this Storage
+// instance.
+func WithCache(cache *Cache) StorageOption {
+	return func(s *Storage) {
+		s.cache = cache
+	}
+}
+
+// WithCompression sets the algorithm that chunks written by this Storage
+// instance are compressed with. Chunks are decompressed based on the
+// algorithm recorded in their reference, so this can be changed without
+// affecting existing chunks.
+func WithCompression(algo CompressionAlgo) StorageOption {
+	return func(s *Storage) {
+		s.compression = algo
+	}
+}
+
+// WithEncryption sets up encryption of the chunks written by this Storage
+// instance. Chunks are encrypted with a data key for their key domain (see
+// WithKeyDomain), and the data keys are stored in store wrapped with master.
+// Data keys that are wrapped with one of the previous master keys can still
+// be used, and are re-wrapped with master by RewrapDataKeys.
+func WithEncryption(store KeyStore, master *MasterKey, previous ...*MasterKey) StorageOption {
+	return func(s *Storage) {
+		s.keys = newKeyring(store, master, previous...)
+	}
+}
+
 // WriterOption configures a chunk writer.
 type WriterOption func(w *Writer)
 
@@ -59,3 +88,24 @@ func WithNoUpload() WriterOption {
 		w.noUpload = true
 	}
 }
+
+// WithKeyDomain sets the key domain of the chunks written by the writer, which
+// determines the data key they are encrypted with (if encryption is set up).
+// Chunks are only deduplicated within a key domain.
+func WithKeyDomain(domain string) WriterOption {
+	return func(w *Writer) {
+		w.keyDomain = domain
+	}
+}
+
+// ReaderOption configures a chunk reader.
+type ReaderOption func(r *Reader)
+
+// WithRange restricts the reader to the sizeBytes bytes starting at
+// offsetBytes in the referenced data (or the rest of the data if sizeBytes is
+// 0). Only the chunks that overlap the range are read.
+func WithRange(offsetBytes, sizeBytes int64) ReaderOption {
+	return func(r *Reader) {
+		r.dataRefs = sliceDataRefs(r.dataRefs, offsetBytes, sizeBytes)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"math"
 	"sync"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
@@ -17,12 +18,53 @@ type Reader struct {
 	dataRefs []*DataRef
 }
 
-func newReader(ctx context.Context, client *Client, dataRefs []*DataRef) *Reader {
-	return &Reader{
+func newReader(ctx context.Context, client *Client, dataRefs []*DataRef, opts ...ReaderOption) *Reader {
+	r := &Reader{
 		ctx:      ctx,
 		client:   client,
 		dataRefs: dataRefs,
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// sliceDataRefs returns the data references for the sizeBytes bytes starting
+// at offsetBytes in the concatenation of the data referenced by dataRefs.
+// Data references that do not overlap the range are dropped, so their chunks
+// are never fetched. A sizeBytes of 0 means the rest of the data.
+func sliceDataRefs(dataRefs []*DataRef, offsetBytes, sizeBytes int64) []*DataRef {
+	if sizeBytes == 0 {
+		sizeBytes = math.MaxInt64
+	}
+	var result []*DataRef
+	for _, dataRef := range dataRefs {
+		if sizeBytes <= 0 {
+			break
+		}
+		if offsetBytes >= dataRef.SizeBytes {
+			offsetBytes -= dataRef.SizeBytes
+			continue
+		}
+		n := dataRef.SizeBytes - offsetBytes
+		if n > sizeBytes {
+			n = sizeBytes
+		}
+		if offsetBytes == 0 && n == dataRef.SizeBytes {
+			result = append(result, dataRef)
+		} else {
+			// The hash of a partial data reference is unknown.
+			result = append(result, &DataRef{
+				Ref:         dataRef.Ref,
+				OffsetBytes: dataRef.OffsetBytes + offsetBytes,
+				SizeBytes:   n,
+			})
+		}
+		offsetBytes = 0
+		sizeBytes -= n
+	}
+	return result
 }
 
 // Iterate iterates over the data readers for the data references.
@@ -105,6 +147,24 @@ func (dr *DataReader) getChunk() error {
 	if err := dr.client.Get(dr.ctx, chunkID, buf); err != nil {
 		return err
 	}
-	dr.chunk = buf.Bytes()
+	chunk := buf.Bytes()
+	if dr.dataRef.Ref.EncryptionAlgo != EncryptionAlgo_UNENCRYPTED {
+		if dr.client.keys == nil {
+			return errors.Errorf("chunk %v is encrypted, but encryption is not set up", ID(chunkID).HexString())
+		}
+		key, err := dr.client.keys.key(dr.ctx, dr.dataRef.Ref.KeyId)
+		if err != nil {
+			return err
+		}
+		chunk, err = decrypt(key, chunk)
+		if err != nil {
+			return err
+		}
+	}
+	chunk, err := decompress(dr.dataRef.Ref.CompressionAlgo, chunk)
+	if err != nil {
+		return err
+	}
+	dr.chunk = chunk
 	return nil
 }
@@ -0,0 +1,120 @@
+package chunk
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/track"
+)
+
+// OrphanType is the type of inconsistency between object storage and the
+// tracker.
+type OrphanType int
+
+const (
+	// UntrackedChunk is a chunk in object storage without a tracker object.
+	UntrackedChunk OrphanType = iota
+	// MissingChunk is a tracker object for a chunk that is not in object
+	// storage.
+	MissingChunk
+)
+
+func (t OrphanType) String() string {
+	switch t {
+	case UntrackedChunk:
+		return "untracked chunk"
+	case MissingChunk:
+		return "missing chunk"
+	default:
+		return "unknown"
+	}
+}
+
+// Orphan is a chunk that is in object storage or the tracker, but not both.
+type Orphan struct {
+	Type    OrphanType
+	ChunkID ID
+	// Fixed is true if the orphan was fixed. Untracked chunks are fixed by
+	// adding a tracker object that expires after the grace period, so they are
+	// deleted by garbage collection. Missing chunks are fixed by deleting their
+	// tracker object and metadata, which is only possible if no other tracker
+	// object references them.
+	Fixed bool
+}
+
+// Reconcile compares the chunks in object storage against the chunks in the
+// tracker, and calls cb with each chunk that is only in one of them. Tracker
+// objects for chunks are created before the chunks are uploaded, so a missing
+// chunk is only reported if its tracker object is older than gracePeriod. If
+// fix is true, the orphans are fixed (see Orphan.Fixed).
+func (s *Storage) Reconcile(ctx context.Context, gracePeriod time.Duration, fix bool, cb func(*Orphan) error) error {
+	if err := s.List(ctx, func(p string) error {
+		chunkID, err := IDFromHex(strings.TrimPrefix(p, prefix+"/"))
+		if err != nil {
+			// Not a chunk.
+			return nil
+		}
+		exists, err := s.tracker.Exists(ctx, ObjectID(chunkID))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+		orphan := &Orphan{Type: UntrackedChunk, ChunkID: chunkID}
+		if fix {
+			if err := s.tracker.CreateObject(ctx, ObjectID(chunkID), []string{}, gracePeriod); err != nil {
+				// The chunk is either being deleted, or was tracked since it
+				// was checked.
+				if errors.Is(err, track.ErrObjectExists) || errors.Is(err, track.ErrTombstone) {
+					return nil
+				}
+				return err
+			}
+			orphan.Fixed = true
+		}
+		return cb(orphan)
+	}); err != nil {
+		return err
+	}
+	return s.tracker.IterateObjects(ctx, TrackerPrefix, func(id string, createdAt time.Time) error {
+		if time.Since(createdAt) < gracePeriod {
+			return nil
+		}
+		chunkID, err := IDFromHex(strings.TrimPrefix(id, TrackerPrefix))
+		if err != nil {
+			return err
+		}
+		if s.objClient.Exists(ctx, chunkPath(chunkID)) {
+			return nil
+		}
+		orphan := &Orphan{Type: MissingChunk, ChunkID: chunkID}
+		if fix {
+			fixed, err := s.deleteMissing(ctx, chunkID)
+			if err != nil {
+				return err
+			}
+			orphan.Fixed = fixed
+		}
+		return cb(orphan)
+	})
+}
+
+// deleteMissing deletes the tracker object and metadata for a chunk that is
+// missing from object storage. It returns false if the chunk is still
+// referenced.
+func (s *Storage) deleteMissing(ctx context.Context, chunkID ID) (bool, error) {
+	id := ObjectID(chunkID)
+	if err := s.tracker.MarkTombstone(ctx, id); err != nil {
+		if errors.Is(err, track.ErrDanglingRef) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := s.mdstore.Delete(ctx, chunkID); err != nil {
+		return false, err
+	}
+	return true, s.tracker.FinishDelete(ctx, id)
+}
@@ -22,6 +22,9 @@ type Storage struct {
 	mdstore   MetadataStore
 
 	defaultChunkTTL time.Duration
+	compression     CompressionAlgo
+	keys            *keyring
+	cache           *Cache
 }
 
 // NewStorage creates a new Storage.
@@ -39,10 +42,12 @@ func NewStorage(objClient obj.Client, mdstore MetadataStore, tracker track.Track
 }
 
 // NewReader creates a new Reader.
-func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef) *Reader {
+func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef, opts ...ReaderOption) *Reader {
 	// using the empty string for the tmp id to disable the renewer
 	client := NewClient(s.objClient, s.mdstore, s.tracker, "")
-	return newReader(ctx, client, dataRefs)
+	client.keys = s.keys
+	client.cache = s.cache
+	return newReader(ctx, client, dataRefs, opts...)
 }
 
 // NewWriter creates a new Writer for a stream of bytes to be chunked.
@@ -50,7 +55,9 @@ func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef) *Reader {
 // object storage.
 func (s *Storage) NewWriter(ctx context.Context, tmpID string, cb WriterCallback, opts ...WriterOption) *Writer {
 	client := NewClient(s.objClient, s.mdstore, s.tracker, tmpID)
-	return newWriter(ctx, client, cb, opts...)
+	client.keys = s.keys
+	client.cache = s.cache
+	return newWriter(ctx, client, s.compression, cb, opts...)
 }
 
 // List lists all of the chunks in object storage.
@@ -58,6 +65,16 @@ func (s *Storage) List(ctx context.Context, cb func(string) error) error {
 	return s.objClient.Walk(ctx, prefix, cb)
 }
 
+// RewrapDataKeys re-wraps the data keys that are wrapped with a previous
+// master key with the current master key, and returns the number of data keys
+// that were re-wrapped. Chunks are not rewritten.
+func (s *Storage) RewrapDataKeys(ctx context.Context) (int, error) {
+	if s.keys == nil {
+		return 0, nil
+	}
+	return s.keys.rewrap(ctx)
+}
+
 // NewDeleter creates a deleter for use with a tracker.GC
 func (s *Storage) NewDeleter() track.Deleter {
 	return &deleter{
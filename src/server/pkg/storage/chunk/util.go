@@ -29,6 +29,16 @@ func NewTestStore(t testing.TB, db *sqlx.DB) MetadataStore {
 	return NewPostgresStore(db)
 }
 
+// NewTestKeyStore creates a key store for testing.
+func NewTestKeyStore(t testing.TB, db *sqlx.DB) KeyStore {
+	ctx := context.Background()
+	tx := db.MustBegin()
+	tx.MustExec(`CREATE SCHEMA IF NOT EXISTS STORAGE`)
+	require.NoError(t, SetupPostgresKeyStoreV0(ctx, tx))
+	require.NoError(t, tx.Commit())
+	return NewPostgresKeyStore(db)
+}
+
 var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
 
 // RandSeq generates a random sequence of data (n is number of bytes)
@@ -50,11 +50,13 @@ type chunkSize struct {
 // Writer splits a byte stream into content defined chunks that are hashed and deduplicated/uploaded to object storage.
 // Chunk split points are determined by a bit pattern in a rolling hash function (buzhash64 at https://github.com/chmduquesne/rollinghash).
 type Writer struct {
-	client    *Client
-	cb        WriterCallback
-	chunkSize *chunkSize
-	splitMask uint64
-	noUpload  bool
+	client      *Client
+	cb          WriterCallback
+	chunkSize   *chunkSize
+	splitMask   uint64
+	noUpload    bool
+	compression CompressionAlgo
+	keyDomain   string
 
 	ctx                     context.Context
 	cancel                  context.CancelFunc
@@ -69,13 +71,14 @@ type Writer struct {
 	first, last             bool
 }
 
-func newWriter(ctx context.Context, client *Client, cb WriterCallback, opts ...WriterOption) *Writer {
+func newWriter(ctx context.Context, client *Client, compression CompressionAlgo, cb WriterCallback, opts ...WriterOption) *Writer {
 	cancelCtx, cancel := context.WithCancel(ctx)
 	w := &Writer{
-		cb:     cb,
-		client: client,
-		ctx:    cancelCtx,
-		cancel: cancel,
+		cb:          cb,
+		client:      client,
+		compression: compression,
+		ctx:         cancelCtx,
+		cancel:      cancel,
 		chunkSize: &chunkSize{
 			min: defaultMinChunkSize,
 			max: defaultMaxChunkSize,
@@ -232,9 +235,8 @@ func (w *Writer) processChunk(ctx context.Context, chunkBytes []byte, edge bool,
 		return err
 	}
 	ref.Edge = edge
-	contentHash := Hash(chunkBytes)
 	chunkDataRef := &DataRef{
-		Hash:      contentHash.HexString(),
+		Hash:      ref.contentHash(),
 		Ref:       ref,
 		SizeBytes: int64(len(chunkBytes)),
 	}
@@ -252,22 +254,42 @@ func (w *Writer) maybeUpload(ctx context.Context, chunkBytes []byte, pointsTo []
 		PointsTo: pointsTo,
 		Size:     len(chunkBytes),
 	}
+	ref := &Ref{
+		SizeBytes:       int64(len(chunkBytes)),
+		CompressionAlgo: w.compression,
+		Hash:            Hash(chunkBytes),
+	}
+	// The chunk is compressed and encrypted even if no upload is configured,
+	// so that the chunk ID is the same as it would be if it were uploaded.
+	storedBytes, err := compress(w.compression, chunkBytes)
+	if err != nil {
+		return nil, err
+	}
+	if w.client.keys != nil {
+		keyID, key, err := w.client.keys.domainKey(ctx, w.keyDomain)
+		if err != nil {
+			return nil, err
+		}
+		storedBytes, err = encrypt(key, storedBytes)
+		if err != nil {
+			return nil, err
+		}
+		ref.EncryptionAlgo = EncryptionAlgo_AES_256_GCM
+		ref.KeyId = keyID
+	}
 	var chunkID ID
-	var err error
 	// Skip the upload if no upload is configured.
 	if !w.noUpload {
-		chunkID, err = w.client.Create(ctx, md, bytes.NewReader(chunkBytes))
+		chunkID, err = w.client.Create(ctx, md, bytes.NewReader(storedBytes))
 		if err != nil {
 			return nil, err
 		}
+		reportUpload(w.compression, len(chunkBytes), len(storedBytes))
 	} else {
-		// TODO: this has to also deal with compression and encryption
-		chunkID = Hash(chunkBytes)
+		chunkID = Hash(storedBytes)
 	}
-	return &Ref{
-		Id:        chunkID,
-		SizeBytes: int64(len(chunkBytes)),
-	}, nil
+	ref.Id = chunkID
+	return ref, nil
 }
 
 func (w *Writer) getPointsTo(annotations []*Annotation) (pointsTo []ID) {
@@ -360,7 +382,7 @@ func mergeDataRef(dr1, dr2 *DataRef) *DataRef {
 	}
 	dr1.SizeBytes += dr2.SizeBytes
 	if dr1.SizeBytes == dr1.Ref.SizeBytes {
-		dr1.Hash = ID(dr1.Ref.Id).HexString()
+		dr1.Hash = dr1.Ref.contentHash()
 	}
 	return dr1
 }
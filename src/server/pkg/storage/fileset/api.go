@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset/index"
 )
 
@@ -11,8 +12,9 @@ import (
 type File interface {
 	// Index returns the index for the file.
 	Index() *index.Index
-	// Content writes the content of the file.
-	Content(w io.Writer) error
+	// Content writes the content of the file. The content can be restricted
+	// to a byte range with chunk.WithRange.
+	Content(w io.Writer, opts ...chunk.ReaderOption) error
 }
 
 var _ File = &MergeFileReader{}
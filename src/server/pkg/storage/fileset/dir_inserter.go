@@ -6,6 +6,7 @@ import (
 	"path"
 	"strings"
 
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset/index"
 )
 
@@ -67,6 +68,6 @@ func (d dirFile) Index() *index.Index {
 	}
 }
 
-func (d dirFile) Content(w io.Writer) error {
+func (d dirFile) Content(w io.Writer, opts ...chunk.ReaderOption) error {
 	return nil
 }
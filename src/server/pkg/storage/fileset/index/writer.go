@@ -30,6 +30,7 @@ type Writer struct {
 	ctx    context.Context
 	chunks *chunk.Storage
 	tmpID  string
+	opts   []chunk.WriterOption
 
 	mu     sync.Mutex
 	levels []*levelWriter
@@ -38,11 +39,12 @@ type Writer struct {
 }
 
 // NewWriter create a new Writer.
-func NewWriter(ctx context.Context, chunks *chunk.Storage, tmpID string) *Writer {
+func NewWriter(ctx context.Context, chunks *chunk.Storage, tmpID string, opts ...chunk.WriterOption) *Writer {
 	return &Writer{
 		ctx:    ctx,
 		chunks: chunks,
 		tmpID:  tmpID,
+		opts:   opts,
 	}
 }
 
@@ -58,7 +60,7 @@ func (w *Writer) WriteIndex(idx *Index) error {
 func (w *Writer) setupLevels() {
 	// Setup the first index level.
 	if w.levels == nil {
-		cw := w.chunks.NewWriter(w.ctx, w.tmpID, w.callback(0), chunk.WithRollingHashConfig(averageBits, 0))
+		cw := w.chunks.NewWriter(w.ctx, w.tmpID, w.callback(0), append([]chunk.WriterOption{chunk.WithRollingHashConfig(averageBits, 0)}, w.opts...)...)
 		w.levels = append(w.levels, &levelWriter{
 			cw:  cw,
 			pbw: pbutil.NewWriter(cw),
@@ -125,7 +127,7 @@ func (w *Writer) callback(level int) chunk.WriterCallback {
 		}
 		// Create next index level if it does not exist.
 		if level == len(w.levels)-1 {
-			cw := w.chunks.NewWriter(w.ctx, uuid.NewWithoutDashes(), w.callback(level+1), chunk.WithRollingHashConfig(averageBits, int64(level+1)))
+			cw := w.chunks.NewWriter(w.ctx, uuid.NewWithoutDashes(), w.callback(level+1), append([]chunk.WriterOption{chunk.WithRollingHashConfig(averageBits, int64(level+1))}, w.opts...)...)
 			w.levels = append(w.levels, &levelWriter{
 				cw:  cw,
 				pbw: pbutil.NewWriter(cw),
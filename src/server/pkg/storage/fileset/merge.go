@@ -206,9 +206,9 @@ func (mfr *MergeFileReader) Index() *index.Index {
 }
 
 // Content returns the content of the merged file.
-func (mfr *MergeFileReader) Content(w io.Writer) error {
+func (mfr *MergeFileReader) Content(w io.Writer, opts ...chunk.ReaderOption) error {
 	dataRefs := getDataRefs(mfr.idx.File.Parts)
-	r := mfr.chunks.NewReader(mfr.ctx, dataRefs)
+	r := mfr.chunks.NewReader(mfr.ctx, dataRefs, opts...)
 	return r.Get(w)
 }
 
@@ -63,6 +63,14 @@ func WithRenewal(ttl time.Duration, r *renew.StringSet) UnorderedWriterOption {
 	}
 }
 
+// WithUnorderedKeyDomain sets the key domain of the chunks written by the
+// UnorderedWriter (see WithKeyDomain).
+func WithUnorderedKeyDomain(domain string) UnorderedWriterOption {
+	return func(uw *UnorderedWriter) {
+		uw.keyDomain = domain
+	}
+}
+
 // WriterOption configures a file set writer.
 type WriterOption func(w *Writer)
 
@@ -87,3 +95,12 @@ func WithTTL(ttl time.Duration) WriterOption {
 		w.ttl = ttl
 	}
 }
+
+// WithKeyDomain sets the key domain of the chunks written by the writer,
+// which determines the data key they are encrypted with (if chunk encryption
+// is set up). If it is not set, the chunks are in the default key domain.
+func WithKeyDomain(domain string) WriterOption {
+	return func(w *Writer) {
+		w.keyDomain = domain
+	}
+}
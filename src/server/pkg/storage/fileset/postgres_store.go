@@ -62,6 +62,25 @@ func (s *postgresStore) Get(ctx context.Context, p string) (*Metadata, error) {
 	return md, nil
 }
 
+func (s *postgresStore) Update(ctx context.Context, p string, md *Metadata) error {
+	data, err := proto.Marshal(md)
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, `UPDATE storage.filesets SET metadata_pb = $2 WHERE path = $1`, p, data)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrPathNotExists
+	}
+	return nil
+}
+
 func (s *postgresStore) Walk(ctx context.Context, prefix string, cb func(string) error) (retErr error) {
 	rows, err := s.db.QueryContext(ctx, `SELECT path from storage.filesets WHERE path LIKE $1 || '%' ORDER BY path`, prefix)
 	if err != nil {
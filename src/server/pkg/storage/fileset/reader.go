@@ -66,8 +66,8 @@ func (fr *FileReader) Index() *index.Index {
 }
 
 // Content writes the content of the file.
-func (fr *FileReader) Content(w io.Writer) error {
+func (fr *FileReader) Content(w io.Writer, opts ...chunk.ReaderOption) error {
 	dataRefs := getDataRefs(fr.idx.File.Parts)
-	r := fr.chunks.NewReader(fr.ctx, dataRefs)
+	r := fr.chunks.NewReader(fr.ctx, dataRefs, opts...)
 	return r.Get(w)
 }
@@ -178,10 +178,11 @@ type CompactStats struct {
 	OutputSize int64
 }
 
-// Compact compacts a set of filesets into an output fileset.
-func (s *Storage) Compact(ctx context.Context, outputFileSet string, inputFileSets []string, ttl time.Duration, opts ...index.Option) (*CompactStats, error) {
+// Compact compacts a set of filesets into an output fileset, with the chunks
+// written in keyDomain.
+func (s *Storage) Compact(ctx context.Context, outputFileSet string, inputFileSets []string, keyDomain string, ttl time.Duration, opts ...index.Option) (*CompactStats, error) {
 	var size int64
-	w := s.newWriter(ctx, outputFileSet, WithTTL(ttl), WithIndexCallback(func(idx *index.Index) error {
+	w := s.newWriter(ctx, outputFileSet, WithKeyDomain(keyDomain), WithTTL(ttl), WithIndexCallback(func(idx *index.Index) error {
 		size += index.SizeBytes(idx)
 		return nil
 	}))
@@ -289,6 +290,70 @@ func (s *Storage) Delete(ctx context.Context, fileSet string) error {
 	})
 }
 
+// Quarantine removes the files for which quarantined returns true from the
+// file sets under prefix. The original file sets are copied to
+// quarantinePrefix (which replaces prefix in their paths) without a ttl, so the
+// removed files can still be inspected. Each filtered file set is written in
+// keyDomain with ttl, and then replaces the original file set in a single
+// update, so the original file set is left as is if quarantining fails.
+func (s *Storage) Quarantine(ctx context.Context, prefix, quarantinePrefix, keyDomain string, ttl time.Duration, quarantined func(*index.Index) bool) error {
+	var paths []string
+	if err := s.store.Walk(ctx, prefix, func(p string) error {
+		paths = append(paths, p)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, p := range paths {
+		quarantinePath := quarantinePrefix + p[len(prefix):]
+		if err := copyPath(ctx, s.store, s.store, p, quarantinePath, s.tracker, 0); err != nil {
+			return err
+		}
+		filteredPath := quarantinePath + "~filtered"
+		w := s.newWriter(ctx, filteredPath, WithKeyDomain(keyDomain), WithTTL(ttl))
+		r := s.newReader(quarantinePath)
+		if err := r.Iterate(ctx, func(f File) error {
+			return deleteIndex(w, f.Index())
+		}, true); err != nil {
+			return err
+		}
+		if err := r.Iterate(ctx, func(f File) error {
+			if quarantined(f.Index()) {
+				return nil
+			}
+			return w.Copy(f)
+		}); err != nil {
+			return err
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+		if err := s.replace(ctx, p, filteredPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replace replaces the file set at p with the file set at src, which is then
+// deleted. The tracker object of p is pointed at the chunks of src before the
+// metadata of p is replaced, so the chunks that p references are always kept
+// alive.
+func (s *Storage) replace(ctx context.Context, p, src string) error {
+	md, err := s.store.Get(ctx, src)
+	if err != nil {
+		return err
+	}
+	if err := s.tracker.AddPointers(ctx, filesetObjectID(p), pointsTo([]*index.Index{md.Additive, md.Deletive})); err != nil {
+		return err
+	}
+	md.Path = p
+	if err := s.store.Update(ctx, p, md); err != nil {
+		return err
+	}
+	return s.Delete(ctx, src)
+}
+
 // SetTTL sets the time-to-live for the prefix p.
 func (s *Storage) SetTTL(ctx context.Context, p string, ttl time.Duration) (time.Time, error) {
 	oid := filesetObjectID(p)
@@ -306,6 +371,17 @@ func (s *Storage) WithRenewer(ctx context.Context, ttl time.Duration, cb func(co
 
 // GC creates a track.GarbageCollector with a Deleter that can handle deleting filesets and chunks
 func (s *Storage) GC(ctx context.Context) error {
+	return s.newGarbageCollector().Run(ctx)
+}
+
+// GCReport runs a garbage collection cycle, calling cb with the tracker id and
+// size of each object that is deleted. If dryRun is true, nothing is deleted,
+// and cb is called with each object that would be deleted.
+func (s *Storage) GCReport(ctx context.Context, dryRun bool, cb func(id string, size int64) error) error {
+	return s.newGarbageCollector().Report(ctx, dryRun, cb)
+}
+
+func (s *Storage) newGarbageCollector() *track.GarbageCollector {
 	const period = 10 * time.Second
 	tmpDeleter := track.NewTmpDeleter()
 	chunkDeleter := s.chunks.NewDeleter()
@@ -324,8 +400,7 @@ func (s *Storage) GC(ctx context.Context) error {
 			return nil
 		}
 	})
-	gc := track.NewGarbageCollector(s.tracker, period, mux)
-	return gc.Run(ctx)
+	return track.NewGarbageCollector(s.tracker, period, mux)
 }
 
 func (s *Storage) levelSize(i int) int64 {
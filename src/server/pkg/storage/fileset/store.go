@@ -25,6 +25,9 @@ var (
 type Store interface {
 	Set(ctx context.Context, p string, md *Metadata) error
 	Get(ctx context.Context, p string) (*Metadata, error)
+	// Update replaces the metadata at p. It errors with ErrPathNotExists if p
+	// does not exist.
+	Update(ctx context.Context, p string, md *Metadata) error
 	Delete(ctx context.Context, p string) error
 	Walk(ctx context.Context, prefix string, cb func(string) error) error
 }
@@ -49,6 +52,16 @@ func StoreTestSuite(t *testing.T, newStore func(t testing.TB) Store) {
 		_, err := x.Get(ctx, "test")
 		require.Equal(t, ErrPathNotExists, err)
 	})
+	t.Run("Update", func(t *testing.T) {
+		x := newStore(t)
+		require.Equal(t, ErrPathNotExists, x.Update(ctx, "test", &Metadata{}))
+		require.NoError(t, x.Set(ctx, "test", &Metadata{}))
+		md := &Metadata{SizeBytes: 1}
+		require.NoError(t, x.Update(ctx, "test", md))
+		actual, err := x.Get(ctx, "test")
+		require.NoError(t, err)
+		require.Equal(t, md, actual)
+	})
 	t.Run("Walk", func(t *testing.T) {
 		x := newStore(t)
 		md := &Metadata{}
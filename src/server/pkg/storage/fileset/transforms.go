@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset/index"
 )
 
@@ -62,6 +63,6 @@ func (im *indexMap) Index() *index.Index {
 	return im.idx
 }
 
-func (im *indexMap) Content(w io.Writer) error {
-	return im.inner.Content(w)
+func (im *indexMap) Content(w io.Writer, opts ...chunk.ReaderOption) error {
+	return im.inner.Content(w, opts...)
 }
@@ -151,6 +151,7 @@ type UnorderedWriter struct {
 	subFileSet                 int64
 	ttl                        time.Duration
 	renewer                    *renew.StringSet
+	keyDomain                  string
 }
 
 func newUnorderedWriter(ctx context.Context, storage *Storage, name string, memThreshold int64, defaultTag string, opts ...UnorderedWriterOption) (*UnorderedWriter, error) {
@@ -178,7 +179,18 @@ func (uw *UnorderedWriter) Append(p string, overwrite bool, r io.Reader, customT
 	//if err := ppath.ValidatePath(hdr.Name); err != nil {
 	//	return nil, err
 	//}
-	p = Clean(p, false)
+	return uw.append(Clean(p, false), overwrite, r, customTag...)
+}
+
+// AppendKey is like Append, except that key is used as is rather than being
+// cleaned as a file path. Keys that don't start with "/" are outside of the
+// file system's namespace, so they can store data about it that reads of
+// path prefixes don't return.
+func (uw *UnorderedWriter) AppendKey(key string, overwrite bool, r io.Reader, customTag ...string) error {
+	return uw.append(key, overwrite, r, customTag...)
+}
+
+func (uw *UnorderedWriter) append(p string, overwrite bool, r io.Reader, customTag ...string) error {
 	tag := uw.defaultTag
 	if len(customTag) > 0 && customTag[0] != "" {
 		tag = customTag[0]
@@ -226,6 +238,9 @@ func (uw *UnorderedWriter) serialize() error {
 	if uw.ttl > 0 {
 		writerOpts = append(writerOpts, WithTTL(uw.ttl))
 	}
+	if uw.keyDomain != "" {
+		writerOpts = append(writerOpts, WithKeyDomain(uw.keyDomain))
+	}
 	p := path.Join(uw.name, SubFileSetStr(uw.subFileSet))
 	w := uw.storage.newWriter(uw.ctx, p, writerOpts...)
 	if err := uw.memFileSet.serialize(w); err != nil {
@@ -52,12 +52,26 @@ func deleteIndex(w *Writer, idx *index.Index) error {
 
 // WriteTarEntry writes an tar entry for f to w
 func WriteTarEntry(w io.Writer, f File) error {
+	return WriteTarEntryRange(w, f, 0, 0)
+}
+
+// WriteTarEntryRange writes a tar entry to w for the sizeBytes bytes of f's
+// content starting at offsetBytes (or the rest of the content if sizeBytes is
+// 0).
+func WriteTarEntryRange(w io.Writer, f File, offsetBytes, sizeBytes int64) error {
 	idx := f.Index()
+	n := index.SizeBytes(idx) - offsetBytes
+	if n < 0 {
+		n = 0
+	}
+	if sizeBytes > 0 && sizeBytes < n {
+		n = sizeBytes
+	}
 	tw := tar.NewWriter(w)
-	if err := tw.WriteHeader(tarutil.NewHeader(idx.Path, index.SizeBytes(idx))); err != nil {
+	if err := tw.WriteHeader(tarutil.NewHeader(idx.Path, n)); err != nil {
 		return err
 	}
-	if err := f.Content(tw); err != nil {
+	if err := f.Content(tw, chunk.WithRange(offsetBytes, sizeBytes)); err != nil {
 		return err
 	}
 	return tw.Flush()
@@ -66,8 +80,15 @@ func WriteTarEntry(w io.Writer, f File) error {
 // WriteTarStream writes an entire tar stream to w
 // It will contain an entry for each File in fs
 func WriteTarStream(ctx context.Context, w io.Writer, fs FileSet) error {
+	return WriteTarStreamRange(ctx, w, fs, 0, 0)
+}
+
+// WriteTarStreamRange is like WriteTarStream, except each entry only contains
+// the range of the file's content described by offsetBytes and sizeBytes (see
+// WriteTarEntryRange).
+func WriteTarStreamRange(ctx context.Context, w io.Writer, fs FileSet, offsetBytes, sizeBytes int64) error {
 	if err := fs.Iterate(ctx, func(f File) error {
-		return WriteTarEntry(w, f)
+		return WriteTarEntryRange(w, f, offsetBytes, sizeBytes)
 	}); err != nil {
 		return err
 	}
@@ -158,14 +179,19 @@ func getDataRefs(parts []*index.Part) []*chunk.DataRef {
 }
 
 func createTrackerObject(ctx context.Context, p string, idxs []*index.Index, tracker track.Tracker, ttl time.Duration) error {
-	var pointsTo []string
+	if err := tracker.CreateObject(ctx, filesetObjectID(p), pointsTo(idxs), ttl); err != nil && err != track.ErrObjectExists {
+		return err
+	}
+	return nil
+}
+
+// pointsTo returns the tracker IDs of the chunks referenced by idxs.
+func pointsTo(idxs []*index.Index) []string {
+	var ids []string
 	for _, idx := range idxs {
 		for _, cid := range index.PointsTo(idx) {
-			pointsTo = append(pointsTo, chunk.ObjectID(cid))
+			ids = append(ids, chunk.ObjectID(cid))
 		}
 	}
-	if err := tracker.CreateObject(ctx, filesetObjectID(p), pointsTo, ttl); err != nil && err != track.ErrObjectExists {
-		return err
-	}
-	return nil
+	return ids
 }
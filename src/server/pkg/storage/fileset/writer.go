@@ -51,6 +51,7 @@ type Writer struct {
 	noUpload           bool
 	indexFunc          func(*index.Index) error
 	ttl                time.Duration
+	keyDomain          string
 }
 
 func newWriter(ctx context.Context, store Store, tracker track.Tracker, chunks *chunk.Storage, path string, opts ...WriterOption) *Writer {
@@ -64,12 +65,13 @@ func newWriter(ctx context.Context, store Store, tracker track.Tracker, chunks *
 	for _, opt := range opts {
 		opt(w)
 	}
-	var chunkWriterOpts []chunk.WriterOption
+	chunkWriterOpts := []chunk.WriterOption{chunk.WithKeyDomain(w.keyDomain)}
+	indexWriterOpts := []chunk.WriterOption{chunk.WithKeyDomain(w.keyDomain)}
 	if w.noUpload {
 		chunkWriterOpts = append(chunkWriterOpts, chunk.WithNoUpload())
 	}
-	w.additive = index.NewWriter(ctx, chunks, "additive-index-writer-"+uuidStr)
-	w.deletive = index.NewWriter(ctx, chunks, "deletive-index-writer-"+uuidStr)
+	w.additive = index.NewWriter(ctx, chunks, "additive-index-writer-"+uuidStr, indexWriterOpts...)
+	w.deletive = index.NewWriter(ctx, chunks, "deletive-index-writer-"+uuidStr, indexWriterOpts...)
 	w.cw = chunks.NewWriter(ctx, "chunk-writer-"+uuidStr, w.callback, chunkWriterOpts...)
 	return w
 }
@@ -25,6 +25,25 @@ func (dm DeleterMux) Delete(ctx context.Context, id string) error {
 	return deleter.Delete(ctx, id)
 }
 
+// Sizer is implemented by Deleters that can report the number of bytes that
+// deleting the data associated with a tracked object reclaims
+type Sizer interface {
+	Size(ctx context.Context, id string) (int64, error)
+}
+
+// Size implements Sizer, objects without a Sizer have a size of 0
+func (dm DeleterMux) Size(ctx context.Context, id string) (int64, error) {
+	return size(ctx, dm(id), id)
+}
+
+func size(ctx context.Context, deleter Deleter, id string) (int64, error) {
+	sizer, ok := deleter.(Sizer)
+	if !ok {
+		return 0, nil
+	}
+	return sizer.Size(ctx, id)
+}
+
 // GarbageCollector periodically runs garbage collection on tracker objects
 type GarbageCollector struct {
 	tracker Tracker
@@ -88,6 +107,93 @@ func (gc *GarbageCollector) runOnce(ctx context.Context) (int, error) {
 	return n, err
 }
 
+// Report runs a garbage collection cycle until there is nothing left to
+// delete, calling cb with the id and size of each object that is deleted.
+// If dryRun is true, nothing is deleted (or marked as a tombstone), and cb is
+// called with each object that would be deleted, which includes the objects
+// that are only referenced by other objects that would be deleted.
+func (gc *GarbageCollector) Report(ctx context.Context, dryRun bool, cb func(id string, size int64) error) error {
+	if dryRun {
+		return gc.reportDryRun(ctx, cb)
+	}
+	for {
+		var n int
+		if err := gc.tracker.IterateDeletable(ctx, func(id string) error {
+			size, err := size(ctx, gc.deleter, id)
+			if err != nil {
+				return err
+			}
+			if err := gc.deleteObject(ctx, id); err != nil {
+				logrus.Errorf("error deleting object (%s): %v", id, err)
+				return nil
+			}
+			n++
+			return cb(id, size)
+		}); err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+	}
+}
+
+func (gc *GarbageCollector) reportDryRun(ctx context.Context, cb func(id string, size int64) error) error {
+	deletable := make(map[string]bool)
+	var frontier []string
+	if err := gc.tracker.IterateDeletable(ctx, func(id string) error {
+		deletable[id] = true
+		frontier = append(frontier, id)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for len(frontier) > 0 {
+		// Report the current frontier, then find the objects that would be
+		// deletable once it is deleted.
+		candidates := make(map[string]bool)
+		for _, id := range frontier {
+			size, err := size(ctx, gc.deleter, id)
+			if err != nil {
+				return err
+			}
+			if err := cb(id, size); err != nil {
+				return err
+			}
+			dwn, err := gc.tracker.GetDownstream(ctx, id)
+			if err != nil {
+				return err
+			}
+			for _, id := range dwn {
+				if !deletable[id] {
+					candidates[id] = true
+				}
+			}
+		}
+		frontier = nil
+		for id := range candidates {
+			ups, err := gc.tracker.GetUpstream(ctx, id)
+			if err != nil {
+				return err
+			}
+			if allDeletable(deletable, ups) {
+				deletable[id] = true
+				frontier = append(frontier, id)
+			}
+		}
+	}
+	return nil
+}
+
+func allDeletable(deletable map[string]bool, ids []string) bool {
+	for _, id := range ids {
+		if !deletable[id] {
+			return false
+		}
+	}
+	return true
+}
+
 func (gc *GarbageCollector) deleteObject(ctx context.Context, id string) error {
 	if err := gc.tracker.MarkTombstone(ctx, id); err != nil {
 		return err
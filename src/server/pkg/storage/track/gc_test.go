@@ -0,0 +1,67 @@
+package track
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+)
+
+type testDeleter struct {
+	deleted []string
+}
+
+func (d *testDeleter) Delete(_ context.Context, id string) error {
+	d.deleted = append(d.deleted, id)
+	return nil
+}
+
+func (d *testDeleter) Size(_ context.Context, id string) (int64, error) {
+	if strings.HasPrefix(id, "chunk") {
+		return 10, nil
+	}
+	return 0, nil
+}
+
+func TestGarbageCollectorReport(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tracker := NewTestTracker(t, dbutil.NewTestDB(t))
+	require.NoError(t, tracker.CreateObject(ctx, "chunk-1", []string{}, 0))
+	require.NoError(t, tracker.CreateObject(ctx, "chunk-2", []string{}, 0))
+	require.NoError(t, tracker.CreateObject(ctx, "keep", []string{"chunk-2"}, time.Hour))
+	require.NoError(t, tracker.CreateObject(ctx, "expire", []string{"chunk-1", "chunk-2"}, time.Microsecond))
+	time.Sleep(time.Millisecond)
+
+	deleter := &testDeleter{}
+	gc := NewGarbageCollector(tracker, time.Minute, deleter)
+	report := func(dryRun bool) map[string]int64 {
+		sizes := make(map[string]int64)
+		require.NoError(t, gc.Report(ctx, dryRun, func(id string, size int64) error {
+			sizes[id] = size
+			return nil
+		}))
+		return sizes
+	}
+	expected := map[string]int64{"expire": 0, "chunk-1": 10}
+	// A dry run reports the objects that would be deleted, without deleting them.
+	require.Equal(t, expected, report(true))
+	require.Equal(t, 0, len(deleter.deleted))
+	exists, err := tracker.Exists(ctx, "expire")
+	require.NoError(t, err)
+	require.True(t, exists)
+	// A real run deletes, and reports, the same objects.
+	require.Equal(t, expected, report(false))
+	require.ElementsEqual(t, []string{"expire", "chunk-1"}, deleter.deleted)
+	for _, id := range []string{"expire", "chunk-1"} {
+		exists, err := tracker.Exists(ctx, id)
+		require.NoError(t, err)
+		require.False(t, exists)
+	}
+	exists, err = tracker.Exists(ctx, "chunk-2")
+	require.NoError(t, err)
+	require.True(t, exists)
+}
@@ -65,6 +65,42 @@ func (t *postgresTracker) CreateObject(ctx context.Context, id string, pointsTo
 	})
 }
 
+func (t *postgresTracker) AddPointers(ctx context.Context, id string, pointsTo []string) error {
+	for _, dwn := range pointsTo {
+		if dwn == id {
+			return ErrSelfReference
+		}
+	}
+	return t.withTx(ctx, func(tx *sqlx.Tx) error {
+		var oid int
+		if err := tx.GetContext(ctx, &oid,
+			`SELECT int_id FROM storage.tracker_objects
+			WHERE str_id = $1 AND NOT tombstone
+			FOR UPDATE
+			`, id); err != nil {
+			if err == sql.ErrNoRows {
+				err = ErrObjectNotExists
+			}
+			return err
+		}
+		var pointsToInts []int
+		if err := tx.SelectContext(ctx, &pointsToInts,
+			`SELECT int_id FROM storage.tracker_objects WHERE str_id = ANY($1)`,
+			pq.StringArray(pointsTo)); err != nil {
+			return err
+		}
+		if len(pointsToInts) != len(pointsTo) {
+			return ErrDanglingRef
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO storage.tracker_refs (from_id, to_id)
+			SELECT $1, unnest($2::INT8[])
+			ON CONFLICT DO NOTHING`,
+			oid, pq.Array(pointsToInts))
+		return err
+	})
+}
+
 func (t *postgresTracker) SetTTLPrefix(ctx context.Context, prefix string, ttl time.Duration) (time.Time, error) {
 	var expiresAt time.Time
 	err := t.db.GetContext(ctx, &expiresAt,
@@ -78,6 +114,17 @@ func (t *postgresTracker) SetTTLPrefix(ctx context.Context, prefix string, ttl t
 	return expiresAt, nil
 }
 
+func (t *postgresTracker) Exists(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	if err := t.db.GetContext(ctx, &exists,
+		`SELECT EXISTS (
+			SELECT 1 FROM storage.tracker_objects WHERE str_id = $1 AND NOT tombstone
+		)`, id); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
 func (t *postgresTracker) GetDownstream(ctx context.Context, id string) ([]string, error) {
 	dwn := []string{}
 	if err := t.db.SelectContext(ctx, &dwn,
@@ -196,6 +243,31 @@ func (t *postgresTracker) IterateDeletable(ctx context.Context, cb func(id strin
 	return rows.Err()
 }
 
+func (t *postgresTracker) IterateObjects(ctx context.Context, prefix string, cb func(id string, createdAt time.Time) error) (retErr error) {
+	rows, err := t.db.QueryxContext(ctx,
+		`SELECT str_id, created_at FROM storage.tracker_objects
+		WHERE str_id LIKE $1 || '%' AND NOT tombstone`, prefix)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	for rows.Next() {
+		var id string
+		var createdAt time.Time
+		if err := rows.Scan(&id, &createdAt); err != nil {
+			return err
+		}
+		if err := cb(id, createdAt); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 func (t *postgresTracker) withTx(ctx context.Context, cb func(tx *sqlx.Tx) error) error {
 	tx, err := t.db.BeginTxx(ctx, &sql.TxOptions{})
 	if err != nil {
@@ -21,6 +21,8 @@ var (
 	ErrNotTombstone = errors.Errorf("object cannot be deleted because it is not marked as a tombstone")
 	// ErrSelfReference object cannot reference itself
 	ErrSelfReference = errors.Errorf("object cannot reference itself")
+	// ErrObjectNotExists the object does not exist
+	ErrObjectNotExists = errors.Errorf("object does not exist")
 )
 
 // Tracker tracks objects and their references to one another.
@@ -33,6 +35,11 @@ type Tracker interface {
 	// CreateObject should always be called *before* adding an object to an auxillary store.
 	CreateObject(ctx context.Context, id string, pointsTo []string, ttl time.Duration) error
 
+	// AddPointers adds pointers from the object with id to everything in pointsTo, which it may already point to.
+	// It errors with ErrObjectNotExists if the object does not exist, or is marked as a tombstone.
+	// It errors with ErrDanglingRef if any of the elements in pointsTo do not exist
+	AddPointers(ctx context.Context, id string, pointsTo []string) error
+
 	// SetTTLPrefix sets the expiration time to current_time + ttl for all objects with ids starting with prefix
 	SetTTLPrefix(ctx context.Context, prefix string, ttl time.Duration) (time.Time, error)
 
@@ -40,6 +47,9 @@ type Tracker interface {
 	// SetTTL(ctx context.Context, id string, ttl time.Duration) error
 	// SetTTLBatch(ctx context.Context, ids []string, ttl time.Duration) error
 
+	// Exists returns true if the object with id exists and is not marked as a tombstone
+	Exists(ctx context.Context, id string) (bool, error)
+
 	// GetDownstream gets all objects immediately downstream of (pointed to by) object with id
 	GetDownstream(ctx context.Context, id string) ([]string, error)
 
@@ -58,6 +68,9 @@ type Tracker interface {
 
 	// IterateDeletable calls cb with all the objects objects which are no longer referenced and have expired or are tombstoned
 	IterateDeletable(ctx context.Context, cb func(id string) error) error
+
+	// IterateObjects calls cb with the id and creation time of all the objects, which are not tombstoned, with ids starting with prefix
+	IterateObjects(ctx context.Context, prefix string, cb func(id string, createdAt time.Time) error) error
 }
 
 // TestTracker runs a TestSuite to ensure Tracker is properly implemented
@@ -110,6 +123,36 @@ func TestTracker(t *testing.T, newTracker func(testing.TB) Tracker) {
 				require.ElementsEqual(t, []string{"3"}, ups)
 			},
 		},
+		{
+			"AddPointers",
+			func(t *testing.T, tracker Tracker) {
+				require.Nil(t, tracker.CreateObject(ctx, "1", []string{}, 0))
+				require.Nil(t, tracker.CreateObject(ctx, "2", []string{}, 0))
+				require.Nil(t, tracker.CreateObject(ctx, "3", []string{"1"}, 0))
+				require.Nil(t, tracker.AddPointers(ctx, "3", []string{"1", "2"}))
+				dwn, err := tracker.GetDownstream(ctx, "3")
+				require.Nil(t, err)
+				require.ElementsEqual(t, []string{"1", "2"}, dwn)
+				require.Equal(t, ErrDanglingRef, tracker.AddPointers(ctx, "3", []string{"none"}))
+				require.Equal(t, ErrObjectNotExists, tracker.AddPointers(ctx, "none", []string{"1"}))
+			},
+		},
+		{
+			"Exists",
+			func(t *testing.T, tracker Tracker) {
+				require.Nil(t, tracker.CreateObject(ctx, "test-id", []string{}, 0))
+				exists, err := tracker.Exists(ctx, "test-id")
+				require.Nil(t, err)
+				require.True(t, exists)
+				exists, err = tracker.Exists(ctx, "none")
+				require.Nil(t, err)
+				require.False(t, exists)
+				require.Nil(t, tracker.MarkTombstone(ctx, "test-id"))
+				exists, err = tracker.Exists(ctx, "test-id")
+				require.Nil(t, err)
+				require.False(t, exists)
+			},
+		},
 		{
 			"DeleteSingleObject",
 			func(t *testing.T, tracker Tracker) {
@@ -135,6 +178,24 @@ func TestTracker(t *testing.T, newTracker func(testing.TB) Tracker) {
 				require.ElementsEqual(t, []string{"expire"}, toExpire)
 			},
 		},
+		{
+			"IterateObjects",
+			func(t *testing.T, tracker Tracker) {
+				require.Nil(t, tracker.CreateObject(ctx, "a/1", []string{}, 0))
+				require.Nil(t, tracker.CreateObject(ctx, "a/2", []string{}, 0))
+				require.Nil(t, tracker.CreateObject(ctx, "a/3", []string{}, 0))
+				require.Nil(t, tracker.CreateObject(ctx, "b/1", []string{}, 0))
+				require.Nil(t, tracker.MarkTombstone(ctx, "a/3"))
+
+				var ids []string
+				require.Nil(t, tracker.IterateObjects(ctx, "a/", func(id string, createdAt time.Time) error {
+					require.False(t, createdAt.IsZero())
+					ids = append(ids, id)
+					return nil
+				}))
+				require.ElementsEqual(t, []string{"a/1", "a/2"}, ids)
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
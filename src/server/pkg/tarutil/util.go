@@ -124,6 +124,25 @@ func Iterate(r io.Reader, cb func(File) error, stream ...bool) error {
 	}
 }
 
+// CopyTar copies the remaining entries of a tar reader to a tar writer.
+func CopyTar(tw *tar.Writer, tr *tar.Reader) error {
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return err
+		}
+	}
+}
+
 func Equal(file1, file2 File, full ...bool) (bool, error) {
 	buf1, buf2 := &bytes.Buffer{}, &bytes.Buffer{}
 	if len(full) > 0 && full[0] {
@@ -45,10 +45,16 @@ func linkServers(mockServerPtr interface{}, realServer interface{}) {
 /* Admin Server Mocks */
 
 type inspectClusterFunc func(context.Context, *types.Empty) (*admin.ClusterInfo, error)
+type extractFunc func(*admin.ExtractRequest, admin.API_ExtractServer) error
+type restoreFunc func(admin.API_RestoreServer) error
 
 type mockInspectCluster struct{ handler inspectClusterFunc }
+type mockExtract struct{ handler extractFunc }
+type mockRestore struct{ handler restoreFunc }
 
 func (mock *mockInspectCluster) Use(cb inspectClusterFunc) { mock.handler = cb }
+func (mock *mockExtract) Use(cb extractFunc)               { mock.handler = cb }
+func (mock *mockRestore) Use(cb restoreFunc)               { mock.handler = cb }
 
 type adminServerAPI struct {
 	mock *mockAdminServer
@@ -57,6 +63,8 @@ type adminServerAPI struct {
 type mockAdminServer struct {
 	api            adminServerAPI
 	InspectCluster mockInspectCluster
+	Extract        mockExtract
+	Restore        mockRestore
 }
 
 func (api *adminServerAPI) InspectCluster(ctx context.Context, req *types.Empty) (*admin.ClusterInfo, error) {
@@ -65,6 +73,18 @@ func (api *adminServerAPI) InspectCluster(ctx context.Context, req *types.Empty)
 	}
 	return nil, errors.Errorf("unhandled pachd mock admin.InspectCluster")
 }
+func (api *adminServerAPI) Extract(req *admin.ExtractRequest, serv admin.API_ExtractServer) error {
+	if api.mock.Extract.handler != nil {
+		return api.mock.Extract.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock admin.Extract")
+}
+func (api *adminServerAPI) Restore(serv admin.API_RestoreServer) error {
+	if api.mock.Restore.handler != nil {
+		return api.mock.Restore.handler(serv)
+	}
+	return errors.Errorf("unhandled pachd mock admin.Restore")
+}
 
 /* Auth Server Mocks */
 
@@ -411,6 +431,7 @@ type deleteCommitFunc func(context.Context, *pfs.DeleteCommitRequest) (*types.Em
 type flushCommitFunc func(*pfs.FlushCommitRequest, pfs.API_FlushCommitServer) error
 type subscribeCommitFunc func(*pfs.SubscribeCommitRequest, pfs.API_SubscribeCommitServer) error
 type clearCommitFunc func(context.Context, *pfs.ClearCommitRequest) (*types.Empty, error)
+type buildCommitFunc func(context.Context, *pfs.BuildCommitRequest) (*pfs.Commit, error)
 type createBranchFunc func(context.Context, *pfs.CreateBranchRequest) (*types.Empty, error)
 type inspectBranchFunc func(context.Context, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 type listBranchFunc func(context.Context, *pfs.ListBranchRequest) (*pfs.BranchInfos, error)
@@ -425,6 +446,10 @@ type globFileFunc func(*pfs.GlobFileRequest, pfs.API_GlobFileServer) error
 type diffFileFunc func(*pfs.DiffFileRequest, pfs.API_DiffFileServer) error
 type deleteAllPFSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type fsckFunc func(*pfs.FsckRequest, pfs.API_FsckServer) error
+type garbageCollectFunc func(context.Context, *pfs.GarbageCollectRequest) (*pfs.GarbageCollectResponse, error)
+type rewrapDataKeysFunc func(context.Context, *pfs.RewrapDataKeysRequest) (*pfs.RewrapDataKeysResponse, error)
+type reconcileStorageFunc func(*pfs.ReconcileStorageRequest, pfs.API_ReconcileStorageServer) error
+type migrateV1Func func(*pfs.MigrateV1Request, pfs.API_MigrateV1Server) error
 type createFilesetFunc func(pfs.API_CreateFilesetServer) error
 type renewFilesetFunc func(context.Context, *pfs.RenewFilesetRequest) (*types.Empty, error)
 
@@ -440,6 +465,7 @@ type mockDeleteCommit struct{ handler deleteCommitFunc }
 type mockFlushCommit struct{ handler flushCommitFunc }
 type mockSubscribeCommit struct{ handler subscribeCommitFunc }
 type mockClearCommit struct{ handler clearCommitFunc }
+type mockBuildCommit struct{ handler buildCommitFunc }
 type mockCreateBranch struct{ handler createBranchFunc }
 type mockInspectBranch struct{ handler inspectBranchFunc }
 type mockListBranch struct{ handler listBranchFunc }
@@ -454,72 +480,86 @@ type mockGlobFile struct{ handler globFileFunc }
 type mockDiffFile struct{ handler diffFileFunc }
 type mockDeleteAllPFS struct{ handler deleteAllPFSFunc }
 type mockFsck struct{ handler fsckFunc }
+type mockGarbageCollect struct{ handler garbageCollectFunc }
+type mockRewrapDataKeys struct{ handler rewrapDataKeysFunc }
+type mockReconcileStorage struct{ handler reconcileStorageFunc }
+type mockMigrateV1 struct{ handler migrateV1Func }
 type mockCreateFileset struct{ handler createFilesetFunc }
 type mockRenewFileset struct{ handler renewFilesetFunc }
 
-func (mock *mockCreateRepo) Use(cb createRepoFunc)           { mock.handler = cb }
-func (mock *mockInspectRepo) Use(cb inspectRepoFunc)         { mock.handler = cb }
-func (mock *mockListRepo) Use(cb listRepoFunc)               { mock.handler = cb }
-func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)           { mock.handler = cb }
-func (mock *mockStartCommit) Use(cb startCommitFunc)         { mock.handler = cb }
-func (mock *mockFinishCommit) Use(cb finishCommitFunc)       { mock.handler = cb }
-func (mock *mockInspectCommit) Use(cb inspectCommitFunc)     { mock.handler = cb }
-func (mock *mockListCommit) Use(cb listCommitFunc)           { mock.handler = cb }
-func (mock *mockDeleteCommit) Use(cb deleteCommitFunc)       { mock.handler = cb }
-func (mock *mockFlushCommit) Use(cb flushCommitFunc)         { mock.handler = cb }
-func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc) { mock.handler = cb }
-func (mock *mockClearCommit) Use(cb clearCommitFunc)         { mock.handler = cb }
-func (mock *mockCreateBranch) Use(cb createBranchFunc)       { mock.handler = cb }
-func (mock *mockInspectBranch) Use(cb inspectBranchFunc)     { mock.handler = cb }
-func (mock *mockListBranch) Use(cb listBranchFunc)           { mock.handler = cb }
-func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)       { mock.handler = cb }
-func (mock *mockModifyFile) Use(cb modifyFileFunc)           { mock.handler = cb }
-func (mock *mockCopyFile) Use(cb copyFileFunc)               { mock.handler = cb }
-func (mock *mockGetFile) Use(cb getFileFunc)                 { mock.handler = cb }
-func (mock *mockInspectFile) Use(cb inspectFileFunc)         { mock.handler = cb }
-func (mock *mockListFile) Use(cb listFileFunc)               { mock.handler = cb }
-func (mock *mockWalkFile) Use(cb walkFileFunc)               { mock.handler = cb }
-func (mock *mockGlobFile) Use(cb globFileFunc)               { mock.handler = cb }
-func (mock *mockDiffFile) Use(cb diffFileFunc)               { mock.handler = cb }
-func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)       { mock.handler = cb }
-func (mock *mockFsck) Use(cb fsckFunc)                       { mock.handler = cb }
-func (mock *mockCreateFileset) Use(cb createFilesetFunc)     { mock.handler = cb }
-func (mock *mockRenewFileset) Use(cb renewFilesetFunc)       { mock.handler = cb }
+func (mock *mockCreateRepo) Use(cb createRepoFunc)             { mock.handler = cb }
+func (mock *mockInspectRepo) Use(cb inspectRepoFunc)           { mock.handler = cb }
+func (mock *mockListRepo) Use(cb listRepoFunc)                 { mock.handler = cb }
+func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)             { mock.handler = cb }
+func (mock *mockStartCommit) Use(cb startCommitFunc)           { mock.handler = cb }
+func (mock *mockFinishCommit) Use(cb finishCommitFunc)         { mock.handler = cb }
+func (mock *mockInspectCommit) Use(cb inspectCommitFunc)       { mock.handler = cb }
+func (mock *mockListCommit) Use(cb listCommitFunc)             { mock.handler = cb }
+func (mock *mockDeleteCommit) Use(cb deleteCommitFunc)         { mock.handler = cb }
+func (mock *mockFlushCommit) Use(cb flushCommitFunc)           { mock.handler = cb }
+func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)   { mock.handler = cb }
+func (mock *mockClearCommit) Use(cb clearCommitFunc)           { mock.handler = cb }
+func (mock *mockBuildCommit) Use(cb buildCommitFunc)           { mock.handler = cb }
+func (mock *mockCreateBranch) Use(cb createBranchFunc)         { mock.handler = cb }
+func (mock *mockInspectBranch) Use(cb inspectBranchFunc)       { mock.handler = cb }
+func (mock *mockListBranch) Use(cb listBranchFunc)             { mock.handler = cb }
+func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)         { mock.handler = cb }
+func (mock *mockModifyFile) Use(cb modifyFileFunc)             { mock.handler = cb }
+func (mock *mockCopyFile) Use(cb copyFileFunc)                 { mock.handler = cb }
+func (mock *mockGetFile) Use(cb getFileFunc)                   { mock.handler = cb }
+func (mock *mockInspectFile) Use(cb inspectFileFunc)           { mock.handler = cb }
+func (mock *mockListFile) Use(cb listFileFunc)                 { mock.handler = cb }
+func (mock *mockWalkFile) Use(cb walkFileFunc)                 { mock.handler = cb }
+func (mock *mockGlobFile) Use(cb globFileFunc)                 { mock.handler = cb }
+func (mock *mockDiffFile) Use(cb diffFileFunc)                 { mock.handler = cb }
+func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)         { mock.handler = cb }
+func (mock *mockFsck) Use(cb fsckFunc)                         { mock.handler = cb }
+func (mock *mockGarbageCollect) Use(cb garbageCollectFunc)     { mock.handler = cb }
+func (mock *mockRewrapDataKeys) Use(cb rewrapDataKeysFunc)     { mock.handler = cb }
+func (mock *mockReconcileStorage) Use(cb reconcileStorageFunc) { mock.handler = cb }
+func (mock *mockMigrateV1) Use(cb migrateV1Func)               { mock.handler = cb }
+func (mock *mockCreateFileset) Use(cb createFilesetFunc)       { mock.handler = cb }
+func (mock *mockRenewFileset) Use(cb renewFilesetFunc)         { mock.handler = cb }
 
 type pfsServerAPI struct {
 	mock *mockPFSServer
 }
 
 type mockPFSServer struct {
-	api             pfsServerAPI
-	CreateRepo      mockCreateRepo
-	InspectRepo     mockInspectRepo
-	ListRepo        mockListRepo
-	DeleteRepo      mockDeleteRepo
-	StartCommit     mockStartCommit
-	FinishCommit    mockFinishCommit
-	InspectCommit   mockInspectCommit
-	ListCommit      mockListCommit
-	DeleteCommit    mockDeleteCommit
-	FlushCommit     mockFlushCommit
-	SubscribeCommit mockSubscribeCommit
-	ClearCommit     mockClearCommit
-	CreateBranch    mockCreateBranch
-	InspectBranch   mockInspectBranch
-	ListBranch      mockListBranch
-	DeleteBranch    mockDeleteBranch
-	ModifyFile      mockModifyFile
-	CopyFile        mockCopyFile
-	GetFile         mockGetFile
-	InspectFile     mockInspectFile
-	ListFile        mockListFile
-	WalkFile        mockWalkFile
-	GlobFile        mockGlobFile
-	DiffFile        mockDiffFile
-	DeleteAll       mockDeleteAllPFS
-	Fsck            mockFsck
-	CreateFileset   mockCreateFileset
-	RenewFileset    mockRenewFileset
+	api              pfsServerAPI
+	CreateRepo       mockCreateRepo
+	InspectRepo      mockInspectRepo
+	ListRepo         mockListRepo
+	DeleteRepo       mockDeleteRepo
+	StartCommit      mockStartCommit
+	FinishCommit     mockFinishCommit
+	InspectCommit    mockInspectCommit
+	ListCommit       mockListCommit
+	DeleteCommit     mockDeleteCommit
+	FlushCommit      mockFlushCommit
+	SubscribeCommit  mockSubscribeCommit
+	ClearCommit      mockClearCommit
+	BuildCommit      mockBuildCommit
+	CreateBranch     mockCreateBranch
+	InspectBranch    mockInspectBranch
+	ListBranch       mockListBranch
+	DeleteBranch     mockDeleteBranch
+	ModifyFile       mockModifyFile
+	CopyFile         mockCopyFile
+	GetFile          mockGetFile
+	InspectFile      mockInspectFile
+	ListFile         mockListFile
+	WalkFile         mockWalkFile
+	GlobFile         mockGlobFile
+	DiffFile         mockDiffFile
+	DeleteAll        mockDeleteAllPFS
+	Fsck             mockFsck
+	GarbageCollect   mockGarbageCollect
+	RewrapDataKeys   mockRewrapDataKeys
+	ReconcileStorage mockReconcileStorage
+	MigrateV1        mockMigrateV1
+	CreateFileset    mockCreateFileset
+	RenewFileset     mockRenewFileset
 }
 
 func (api *pfsServerAPI) CreateRepo(ctx context.Context, req *pfs.CreateRepoRequest) (*types.Empty, error) {
@@ -594,6 +634,12 @@ func (api *pfsServerAPI) ClearCommit(ctx context.Context, req *pfs.ClearCommitRe
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.ClearCommit")
 }
+func (api *pfsServerAPI) BuildCommit(ctx context.Context, req *pfs.BuildCommitRequest) (*pfs.Commit, error) {
+	if api.mock.BuildCommit.handler != nil {
+		return api.mock.BuildCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.BuildCommit")
+}
 func (api *pfsServerAPI) CreateBranch(ctx context.Context, req *pfs.CreateBranchRequest) (*types.Empty, error) {
 	if api.mock.CreateBranch.handler != nil {
 		return api.mock.CreateBranch.handler(ctx, req)
@@ -678,6 +724,30 @@ func (api *pfsServerAPI) Fsck(req *pfs.FsckRequest, serv pfs.API_FsckServer) err
 	}
 	return errors.Errorf("unhandled pachd mock pfs.Fsck")
 }
+func (api *pfsServerAPI) GarbageCollect(ctx context.Context, req *pfs.GarbageCollectRequest) (*pfs.GarbageCollectResponse, error) {
+	if api.mock.GarbageCollect.handler != nil {
+		return api.mock.GarbageCollect.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.GarbageCollect")
+}
+func (api *pfsServerAPI) RewrapDataKeys(ctx context.Context, req *pfs.RewrapDataKeysRequest) (*pfs.RewrapDataKeysResponse, error) {
+	if api.mock.RewrapDataKeys.handler != nil {
+		return api.mock.RewrapDataKeys.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RewrapDataKeys")
+}
+func (api *pfsServerAPI) ReconcileStorage(req *pfs.ReconcileStorageRequest, serv pfs.API_ReconcileStorageServer) error {
+	if api.mock.ReconcileStorage.handler != nil {
+		return api.mock.ReconcileStorage.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ReconcileStorage")
+}
+func (api *pfsServerAPI) MigrateV1(req *pfs.MigrateV1Request, serv pfs.API_MigrateV1Server) error {
+	if api.mock.MigrateV1.handler != nil {
+		return api.mock.MigrateV1.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.MigrateV1")
+}
 func (api *pfsServerAPI) CreateFileset(srv pfs.API_CreateFilesetServer) error {
 	if api.mock.CreateFileset.handler != nil {
 		return api.mock.CreateFileset.handler(srv)
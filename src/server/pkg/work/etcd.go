@@ -0,0 +1,286 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+)
+
+const (
+	taskPrefix    = "/task"
+	subtaskPrefix = "/subtask"
+	claimPrefix   = "/claim"
+	commitPrefix  = "/commit"
+)
+
+var _ taskStore = &etcdStore{}
+
+// etcdStore is a task store backed by etcd.
+// Workers watch the subtask and claim collections for subtasks that need to
+// be processed, and claim them with etcd leases.
+type etcdStore struct {
+	etcdClient                               *etcd.Client
+	taskCol, subtaskCol, claimCol, commitCol col.Collection
+}
+
+func newEtcdStore(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *etcdStore {
+	return &etcdStore{
+		etcdClient: etcdClient,
+		taskCol:    newCollection(etcdClient, path.Join(etcdPrefix, taskPrefix, taskNamespace), &Task{}),
+		subtaskCol: newCollection(etcdClient, path.Join(etcdPrefix, subtaskPrefix, taskNamespace), &TaskInfo{}),
+		claimCol:   newCollection(etcdClient, path.Join(etcdPrefix, claimPrefix, taskNamespace), &Claim{}),
+		commitCol:  newCollection(etcdClient, path.Join(etcdPrefix, commitPrefix, taskNamespace), &SubtaskCommit{}),
+	}
+}
+
+func newCollection(etcdClient *etcd.Client, etcdPrefix string, template proto.Message) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		etcdPrefix,
+		nil,
+		template,
+		nil,
+		nil,
+	)
+}
+
+func (s *etcdStore) createTask(ctx context.Context, task *Task) error {
+	_, err := col.NewSTM(ctx, s.etcdClient, func(stm col.STM) error {
+		return s.taskCol.ReadWrite(stm).Put(task.ID, task)
+	})
+	return err
+}
+
+func (s *etcdStore) deleteTask(taskID string) error {
+	_, err := col.NewSTM(context.Background(), s.etcdClient, func(stm col.STM) error {
+		s.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		s.commitCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		return s.taskCol.ReadWrite(stm).Delete(taskID)
+	})
+	return err
+}
+
+func (s *etcdStore) deleteAllTasks() error {
+	_, err := col.NewSTM(context.Background(), s.etcdClient, func(stm col.STM) error {
+		s.subtaskCol.ReadWrite(stm).DeleteAll()
+		s.commitCol.ReadWrite(stm).DeleteAll()
+		s.taskCol.ReadWrite(stm).DeleteAll()
+		return nil
+	})
+	return err
+}
+
+func (s *etcdStore) createSubtask(ctx context.Context, subtaskKey string, subtask *Task) error {
+	subtaskInfo := &TaskInfo{Task: subtask}
+	_, err := col.NewSTM(ctx, s.etcdClient, func(stm col.STM) error {
+		return s.subtaskCol.ReadWrite(stm).Put(subtaskKey, subtaskInfo)
+	})
+	return err
+}
+
+func (s *etcdStore) cancelSubtasks(ctx context.Context, subtaskKeys []string) error {
+	_, err := col.NewSTM(ctx, s.etcdClient, func(stm col.STM) error {
+		subtasks := s.subtaskCol.ReadWrite(stm)
+		claims := s.claimCol.ReadWrite(stm)
+		for _, subtaskKey := range subtaskKeys {
+			if err := subtasks.Delete(subtaskKey); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+			if err := claims.Delete(subtaskKey); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+func (s *etcdStore) commitSubtask(ctx context.Context, subtaskKey, subtaskID string, committed bool) (bool, error) {
+	var ok bool
+	_, err := col.NewSTM(ctx, s.etcdClient, func(stm col.STM) error {
+		ok = false
+		commits := s.commitCol.ReadWrite(stm)
+		commitKey := path.Join(path.Dir(subtaskKey), subtaskID)
+		commit := &SubtaskCommit{}
+		if err := commits.Get(commitKey, commit); err != nil {
+			if !col.IsErrNotFound(err) {
+				return err
+			}
+		} else if commit.SubtaskKey == subtaskKey {
+			committed = committed || commit.Committed
+		} else {
+			if commit.Committed {
+				return ErrSubtaskCommitted
+			}
+			// The other copy is still committing while it is running and holds
+			// its claim.
+			running, err := s.subtaskRunning(stm, commit.SubtaskKey)
+			if err != nil {
+				return err
+			}
+			if running {
+				return nil
+			}
+		}
+		ok = true
+		return commits.Put(commitKey, &SubtaskCommit{SubtaskKey: subtaskKey, Committed: committed})
+	})
+	return ok, err
+}
+
+func (s *etcdStore) subtaskRunning(stm col.STM, subtaskKey string) (bool, error) {
+	subtaskInfo := &TaskInfo{}
+	if err := s.subtaskCol.ReadWrite(stm).Get(subtaskKey, subtaskInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if subtaskInfo.State != State_RUNNING {
+		return false, nil
+	}
+	if err := s.claimCol.ReadWrite(stm).Get(subtaskKey, &Claim{}); err != nil {
+		if col.IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *etcdStore) deleteSubtasks(taskID string) error {
+	_, err := col.NewSTM(context.Background(), s.etcdClient, func(stm col.STM) error {
+		s.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		s.commitCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		return nil
+	})
+	return err
+}
+
+func (s *etcdStore) watchSubtasks(ctx context.Context, taskID string, f func(string, *TaskInfo) error) error {
+	return s.subtaskCol.ReadOnly(ctx).WatchOneF(taskID, func(e *watch.Event) error {
+		var key string
+		subtaskInfo := &TaskInfo{}
+		if err := e.Unmarshal(&key, subtaskInfo); err != nil {
+			return err
+		}
+		// Check that the subtask state is terminal.
+		if subtaskInfo.State == State_RUNNING {
+			return nil
+		}
+		return f(key, subtaskInfo)
+	})
+}
+
+func (s *etcdStore) watchTasks(ctx context.Context, f func(string, *Task, bool) error) error {
+	return s.taskCol.ReadOnly(ctx).WatchF(func(e *watch.Event) error {
+		var taskID string
+		task := &Task{}
+		if err := e.Unmarshal(&taskID, task); err != nil {
+			return err
+		}
+		return f(taskID, task, e.Type == watch.EventDelete)
+	})
+}
+
+func (s *etcdStore) runTask(task *Task, taskEntry *taskEntry, processFunc ProcessFunc) error {
+	claimWatch, err := s.claimCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.WithFilterPut())
+	if err != nil {
+		return err
+	}
+	defer claimWatch.Close()
+	subtaskWatch, err := s.subtaskCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.WithFilterDelete())
+	if err != nil {
+		return err
+	}
+	defer subtaskWatch.Close()
+	for {
+		select {
+		case e := <-claimWatch.Watch():
+			if e.Type == watch.EventError {
+				return e.Err
+			}
+			if e.Type != watch.EventDelete {
+				continue
+			}
+			var subtaskKey string
+			if err := e.Unmarshal(&subtaskKey, &Claim{}); err != nil {
+				return err
+			}
+			taskEntry.runSubtask(s.subtaskFunc(subtaskKey, processFunc))
+		case e := <-subtaskWatch.Watch():
+			if e.Type == watch.EventError {
+				return e.Err
+			}
+			var subtaskKey string
+			if err := e.Unmarshal(&subtaskKey, &TaskInfo{}); err != nil {
+				return err
+			}
+			taskEntry.runSubtask(s.subtaskFunc(subtaskKey, processFunc))
+		case <-taskEntry.ctx.Done():
+			return taskEntry.ctx.Err()
+		}
+	}
+}
+
+func (s *etcdStore) subtaskFunc(subtaskKey string, processFunc ProcessFunc) subtaskFunc {
+	return func(ctx context.Context) {
+		if err := func() error {
+			// (bryce) this should be refactored to have the check and claim in the same stm.
+			// there is a rare race condition that does not affect correctness, but it is less
+			// than ideal because a subtask could get run once more than necessary.
+			subtaskInfo := &TaskInfo{}
+			if _, err := col.NewSTM(ctx, s.etcdClient, func(stm col.STM) error {
+				return s.subtaskCol.ReadWrite(stm).Get(subtaskKey, subtaskInfo)
+			}); err != nil {
+				return err
+			}
+			if subtaskInfo.State != State_RUNNING {
+				return nil
+			}
+			return s.claimCol.Claim(ctx, subtaskKey, &Claim{}, func(claimCtx context.Context) (retErr error) {
+				subtask := subtaskInfo.Task
+				defer func() {
+					// If the task context was canceled or the claim was lost, just return with no error.
+					if errors.Is(claimCtx.Err(), context.Canceled) {
+						retErr = nil
+						return
+					}
+					subtaskInfo := &TaskInfo{}
+					if _, err := col.NewSTM(claimCtx, s.etcdClient, func(stm col.STM) error {
+						return s.subtaskCol.ReadWrite(stm).Update(subtaskKey, subtaskInfo, func() error {
+							// (bryce) remove when check and claim are in the same stm.
+							if subtaskInfo.State != State_RUNNING {
+								return nil
+							}
+							subtaskInfo.Task = subtask
+							subtaskInfo.State = State_SUCCESS
+							if retErr != nil {
+								subtaskInfo.State = State_FAILURE
+								subtaskInfo.Reason = retErr.Error()
+								retErr = nil
+							}
+							return nil
+						})
+					}); retErr == nil {
+						retErr = err
+					}
+				}()
+				return processFunc(withCommit(claimCtx, s, subtaskKey, subtask), subtask)
+			})
+		}(); err != nil {
+			// If the task context was canceled or the subtask was deleted / not claimed, then no error should be logged.
+			if errors.Is(ctx.Err(), context.Canceled) ||
+				col.IsErrNotFound(err) || errors.Is(err, col.ErrNotClaimed) {
+				return
+			}
+			fmt.Printf("errored in subtask callback: %v\n", err)
+		}
+	}
+}
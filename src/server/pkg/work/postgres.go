@@ -0,0 +1,448 @@
+package work
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+var (
+	// pollInterval is how often the postgres task store is polled for
+	// new tasks and subtasks.
+	pollInterval = 100 * time.Millisecond
+	// claimTTL is how long a subtask claim lasts without being renewed.
+	claimTTL = 30 * time.Second
+)
+
+var _ taskStore = &postgresStore{}
+
+// postgresStore is a task store backed by postgres.
+// Workers claim subtasks by locking them with SELECT ... FOR UPDATE SKIP LOCKED
+// and setting a claim that expires unless it is renewed.
+type postgresStore struct {
+	db            *sqlx.DB
+	taskNamespace string
+}
+
+// NewPostgresTaskQueue sets up a new task queue backed by postgres.
+func NewPostgresTaskQueue(ctx context.Context, db *sqlx.DB, taskNamespace string, opts ...TaskQueueOption) (*TaskQueue, error) {
+	return newTaskQueueWithStore(ctx, newPostgresStore(db, taskNamespace), taskNamespace, opts...)
+}
+
+// NewPostgresWorker creates a new worker backed by postgres.
+func NewPostgresWorker(db *sqlx.DB, taskNamespace string, opts ...WorkerOption) *Worker {
+	return newWorkerWithStore(newPostgresStore(db, taskNamespace), taskNamespace, opts...)
+}
+
+func newPostgresStore(db *sqlx.DB, taskNamespace string) *postgresStore {
+	return &postgresStore{
+		db:            db,
+		taskNamespace: taskNamespace,
+	}
+}
+
+type taskRow struct {
+	ID   string `db:"id"`
+	Task []byte `db:"task_pb"`
+}
+
+type subtaskRow struct {
+	Key  string `db:"subtask_key"`
+	Info []byte `db:"info_pb"`
+}
+
+func (s *postgresStore) createTask(ctx context.Context, task *Task) error {
+	data, err := proto.Marshal(task)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx,
+		`INSERT INTO work.tasks (namespace, id, task_pb)
+		VALUES ($1, $2, $3)`, s.taskNamespace, task.ID, data)
+	return err
+}
+
+func (s *postgresStore) deleteTask(taskID string) error {
+	return s.withTx(context.Background(), func(tx *sqlx.Tx) error {
+		if _, err := tx.Exec(`DELETE FROM work.subtasks WHERE namespace = $1 AND task_id = $2`, s.taskNamespace, taskID); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`DELETE FROM work.subtask_commits WHERE namespace = $1 AND task_id = $2`, s.taskNamespace, taskID); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`DELETE FROM work.tasks WHERE namespace = $1 AND id = $2`, s.taskNamespace, taskID)
+		return err
+	})
+}
+
+func (s *postgresStore) deleteAllTasks() error {
+	return s.withTx(context.Background(), func(tx *sqlx.Tx) error {
+		if _, err := tx.Exec(`DELETE FROM work.subtasks WHERE namespace = $1`, s.taskNamespace); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`DELETE FROM work.subtask_commits WHERE namespace = $1`, s.taskNamespace); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`DELETE FROM work.tasks WHERE namespace = $1`, s.taskNamespace)
+		return err
+	})
+}
+
+func (s *postgresStore) createSubtask(ctx context.Context, subtaskKey string, subtask *Task) error {
+	data, err := proto.Marshal(&TaskInfo{Task: subtask})
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx,
+		`INSERT INTO work.subtasks (namespace, task_id, subtask_key, info_pb)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (namespace, subtask_key) DO UPDATE
+		SET info_pb = EXCLUDED.info_pb, state = 0, collected = FALSE, claim = NULL, claim_expires_at = NULL
+		`, s.taskNamespace, path.Dir(subtaskKey), subtaskKey, data)
+	return err
+}
+
+func (s *postgresStore) cancelSubtasks(ctx context.Context, subtaskKeys []string) error {
+	_, err := s.db.ExecContext(ctx,
+		`DELETE FROM work.subtasks WHERE namespace = $1 AND subtask_key = ANY($2)`,
+		s.taskNamespace, pq.StringArray(subtaskKeys))
+	return err
+}
+
+func (s *postgresStore) commitSubtask(ctx context.Context, subtaskKey, subtaskID string, committed bool) (bool, error) {
+	taskID := path.Dir(subtaskKey)
+	var ok bool
+	if err := s.withTx(ctx, func(tx *sqlx.Tx) error {
+		ok = false
+		// The no-op update makes the insert lock and return the existing
+		// commit if there is one.
+		var commit struct {
+			Key       string `db:"subtask_key"`
+			Committed bool   `db:"committed"`
+		}
+		if err := tx.GetContext(ctx, &commit,
+			`INSERT INTO work.subtask_commits (namespace, task_id, subtask_id, subtask_key, committed)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (namespace, task_id, subtask_id) DO UPDATE
+			SET subtask_key = work.subtask_commits.subtask_key
+			RETURNING subtask_key, committed`, s.taskNamespace, taskID, subtaskID, subtaskKey, committed); err != nil {
+			return err
+		}
+		if commit.Key != subtaskKey {
+			if commit.Committed {
+				return ErrSubtaskCommitted
+			}
+			// The other copy is still committing while it is running and
+			// holds its claim.
+			var running bool
+			if err := tx.GetContext(ctx, &running,
+				`SELECT EXISTS (SELECT 1 FROM work.subtasks
+				WHERE namespace = $1 AND subtask_key = $2 AND state = 0 AND claim_expires_at > CURRENT_TIMESTAMP)`,
+				s.taskNamespace, commit.Key); err != nil {
+				return err
+			}
+			if running {
+				return nil
+			}
+		}
+		ok = true
+		_, err := tx.ExecContext(ctx,
+			`UPDATE work.subtask_commits SET subtask_key = $4, committed = committed OR $5
+			WHERE namespace = $1 AND task_id = $2 AND subtask_id = $3`,
+			s.taskNamespace, taskID, subtaskID, subtaskKey, committed)
+		return err
+	}); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+func (s *postgresStore) deleteSubtasks(taskID string) error {
+	return s.withTx(context.Background(), func(tx *sqlx.Tx) error {
+		if _, err := tx.Exec(`DELETE FROM work.subtasks WHERE namespace = $1 AND task_id = $2`, s.taskNamespace, taskID); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`DELETE FROM work.subtask_commits WHERE namespace = $1 AND task_id = $2`, s.taskNamespace, taskID)
+		return err
+	})
+}
+
+func (s *postgresStore) watchSubtasks(ctx context.Context, taskID string, f func(string, *TaskInfo) error) error {
+	return poll(ctx, func() (bool, error) {
+		// Terminal subtasks are marked as collected as they are read, so each
+		// terminal state is only seen once.
+		var rows []subtaskRow
+		if err := s.db.SelectContext(ctx, &rows,
+			`UPDATE work.subtasks SET collected = TRUE
+			WHERE namespace = $1 AND task_id = $2 AND state <> 0 AND NOT collected
+			RETURNING subtask_key, info_pb`, s.taskNamespace, taskID); err != nil {
+			return false, err
+		}
+		for _, row := range rows {
+			subtaskInfo := &TaskInfo{}
+			if err := proto.Unmarshal(row.Info, subtaskInfo); err != nil {
+				return false, err
+			}
+			if err := f(row.Key, subtaskInfo); err != nil {
+				return false, err
+			}
+		}
+		return len(rows) > 0, nil
+	})
+}
+
+func (s *postgresStore) watchTasks(ctx context.Context, f func(string, *Task, bool) error) error {
+	tasks := make(map[string]struct{})
+	return poll(ctx, func() (bool, error) {
+		var rows []taskRow
+		if err := s.db.SelectContext(ctx, &rows,
+			`SELECT id, task_pb FROM work.tasks WHERE namespace = $1 ORDER BY seq`, s.taskNamespace); err != nil {
+			return false, err
+		}
+		current := make(map[string]struct{})
+		for _, row := range rows {
+			current[row.ID] = struct{}{}
+			if _, ok := tasks[row.ID]; ok {
+				continue
+			}
+			task := &Task{}
+			if err := proto.Unmarshal(row.Task, task); err != nil {
+				return false, err
+			}
+			tasks[row.ID] = struct{}{}
+			if err := f(row.ID, task, false); err != nil {
+				return false, err
+			}
+		}
+		for taskID := range tasks {
+			if _, ok := current[taskID]; ok {
+				continue
+			}
+			delete(tasks, taskID)
+			if err := f(taskID, &Task{}, true); err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	})
+}
+
+func (s *postgresStore) runTask(task *Task, taskEntry *taskEntry, processFunc ProcessFunc) error {
+	return poll(taskEntry.ctx, func() (bool, error) {
+		var claimable bool
+		if err := s.db.GetContext(taskEntry.ctx, &claimable,
+			`SELECT EXISTS (
+				SELECT 1 FROM work.subtasks
+				WHERE namespace = $1 AND task_id = $2 AND state = 0
+				AND (claim_expires_at IS NULL OR claim_expires_at < CURRENT_TIMESTAMP)
+			)`, s.taskNamespace, task.ID); err != nil {
+			return false, err
+		}
+		if !claimable {
+			return false, nil
+		}
+		// Only one subtask is queued at a time, so that subtasks are left
+		// for other workers to claim.
+		done := make(chan struct{})
+		taskEntry.runSubtask(func(ctx context.Context) {
+			defer close(done)
+			if err := s.processSubtask(ctx, task.ID, processFunc); err != nil && !errors.Is(ctx.Err(), context.Canceled) {
+				fmt.Printf("errored in subtask callback: %v\n", err)
+			}
+		})
+		select {
+		case <-done:
+			return true, nil
+		case <-taskEntry.ctx.Done():
+			return false, taskEntry.ctx.Err()
+		}
+	})
+}
+
+// processSubtask claims a subtask in a task (if one is available) and
+// processes it.
+func (s *postgresStore) processSubtask(ctx context.Context, taskID string, processFunc ProcessFunc) error {
+	claim := uuid.NewWithoutDashes()
+	var row subtaskRow
+	if err := s.db.GetContext(ctx, &row,
+		`UPDATE work.subtasks
+		SET claim = $3, claim_expires_at = CURRENT_TIMESTAMP + $4 * interval '1 microsecond'
+		WHERE namespace = $1 AND subtask_key = (
+			SELECT subtask_key FROM work.subtasks
+			WHERE namespace = $1 AND task_id = $2 AND state = 0
+			AND (claim_expires_at IS NULL OR claim_expires_at < CURRENT_TIMESTAMP)
+			ORDER BY seq
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING subtask_key, info_pb`, s.taskNamespace, taskID, claim, claimTTL.Microseconds()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	subtaskInfo := &TaskInfo{}
+	if err := proto.Unmarshal(row.Info, subtaskInfo); err != nil {
+		return err
+	}
+	claimCtx, cancel := context.WithCancel(ctx)
+	renewDone := make(chan struct{})
+	go func() {
+		defer close(renewDone)
+		s.renewClaim(claimCtx, cancel, row.Key, claim)
+	}()
+	defer func() {
+		cancel()
+		<-renewDone
+	}()
+	processErr := processFunc(withCommit(claimCtx, s, row.Key, subtaskInfo.Task), subtaskInfo.Task)
+	// If the task context was canceled or the claim was lost, release the
+	// claim so that another worker can process the subtask.
+	if claimCtx.Err() != nil {
+		_, err := s.db.Exec(
+			`UPDATE work.subtasks SET claim = NULL, claim_expires_at = NULL
+			WHERE namespace = $1 AND subtask_key = $2 AND claim = $3 AND state = 0`,
+			s.taskNamespace, row.Key, claim)
+		return err
+	}
+	subtaskInfo.State = State_SUCCESS
+	if processErr != nil {
+		subtaskInfo.State = State_FAILURE
+		subtaskInfo.Reason = processErr.Error()
+	}
+	data, err := proto.Marshal(subtaskInfo)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(claimCtx,
+		`UPDATE work.subtasks SET info_pb = $4, state = $5, claim = NULL, claim_expires_at = NULL
+		WHERE namespace = $1 AND subtask_key = $2 AND claim = $3 AND state = 0`,
+		s.taskNamespace, row.Key, claim, data, int32(subtaskInfo.State))
+	return err
+}
+
+// renewClaim renews a subtask claim until the context is canceled, and
+// cancels the claim context if the claim is lost (for example, because the
+// subtask was deleted).
+func (s *postgresStore) renewClaim(ctx context.Context, cancel context.CancelFunc, subtaskKey, claim string) {
+	ticker := time.NewTicker(claimTTL / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		res, err := s.db.ExecContext(ctx,
+			`UPDATE work.subtasks SET claim_expires_at = CURRENT_TIMESTAMP + $4 * interval '1 microsecond'
+			WHERE namespace = $1 AND subtask_key = $2 AND claim = $3 AND state = 0`,
+			s.taskNamespace, subtaskKey, claim, claimTTL.Microseconds())
+		if err != nil {
+			if ctx.Err() == nil {
+				fmt.Printf("errored renewing subtask claim: %v\n", err)
+				cancel()
+			}
+			return
+		}
+		n, err := res.RowsAffected()
+		if err != nil || n == 0 {
+			cancel()
+			return
+		}
+	}
+}
+
+func (s *postgresStore) withTx(ctx context.Context, cb func(tx *sqlx.Tx) error) error {
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	if err := cb(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			fmt.Printf("errored rolling back transaction: %v\n", rbErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// poll calls f until it returns an error or the context is canceled, waiting
+// pollInterval between calls unless f reports that it made progress.
+// An errutil.ErrBreak error from f stops polling without an error.
+func poll(ctx context.Context, f func() (bool, error)) error {
+	for {
+		progress, err := f()
+		if err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+		if progress {
+			continue
+		}
+		select {
+		case <-time.After(pollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+var schema = `
+	CREATE TABLE IF NOT EXISTS work.tasks (
+		seq BIGSERIAL,
+		namespace VARCHAR(4096) NOT NULL,
+		id VARCHAR(4096) NOT NULL,
+		task_pb BYTEA NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (namespace, id)
+	);
+
+	CREATE TABLE IF NOT EXISTS work.subtasks (
+		seq BIGSERIAL,
+		namespace VARCHAR(4096) NOT NULL,
+		task_id VARCHAR(4096) NOT NULL,
+		subtask_key VARCHAR(4096) NOT NULL,
+		info_pb BYTEA NOT NULL,
+		state SMALLINT NOT NULL DEFAULT 0,
+		collected BOOLEAN NOT NULL DEFAULT FALSE,
+		claim VARCHAR(64),
+		claim_expires_at TIMESTAMP,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (namespace, subtask_key)
+	);
+
+	CREATE INDEX IF NOT EXISTS subtasks_task ON work.subtasks (namespace, task_id, state, seq);
+`
+
+// SetupPostgresStoreV0 sets up the tables for the postgres task store.
+func SetupPostgresStoreV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, schema)
+	return err
+}
+
+// SetupPostgresCommitsV0 sets up the table for the subtask commits of the
+// postgres task store.
+func SetupPostgresCommitsV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS work.subtask_commits (
+		namespace VARCHAR(4096) NOT NULL,
+		task_id VARCHAR(4096) NOT NULL,
+		subtask_id VARCHAR(4096) NOT NULL,
+		subtask_key VARCHAR(4096) NOT NULL,
+		committed BOOLEAN NOT NULL DEFAULT FALSE,
+		PRIMARY KEY (namespace, task_id, subtask_id)
+	);
+	`)
+	return err
+}
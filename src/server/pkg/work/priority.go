@@ -0,0 +1,55 @@
+package work
+
+import "context"
+
+// TaskQueueOption configures a task queue.
+type TaskQueueOption func(*TaskQueue)
+
+// WithPriority sets the priority and weight of the tasks run by a task queue.
+// Workers run the subtasks of tasks with a higher priority first. Task queues
+// with the same priority share the workers in proportion to their weight
+// (a weight less than one is treated as one).
+func WithPriority(priority, weight int64) TaskQueueOption {
+	return func(tq *TaskQueue) {
+		tq.priority = priority
+		tq.weight = weight
+	}
+}
+
+// WithGroup sets the group of the tasks run by a task queue. The task queues
+// in a namespace are one group by default, so task queues that share a
+// namespace need different groups to share the workers by weight.
+func WithGroup(group string) TaskQueueOption {
+	return func(tq *TaskQueue) {
+		tq.group = group
+	}
+}
+
+// WorkerOption configures a worker.
+type WorkerOption func(*Worker)
+
+// WithScheduler sets the scheduler that a worker runs its subtasks through.
+// Workers in different namespaces that share a scheduler run one subtask at a
+// time between them, based on the priority and weight of their tasks.
+func WithScheduler(s *Scheduler) WorkerOption {
+	return func(w *Worker) {
+		w.scheduler = s
+	}
+}
+
+// Scheduler schedules the subtasks of the tasks run by one or more workers.
+type Scheduler struct {
+	taskQueue *taskQueue
+}
+
+// NewScheduler creates a new scheduler.
+// The scheduler will run subtasks until the context is canceled.
+func NewScheduler(ctx context.Context) *Scheduler {
+	return &Scheduler{taskQueue: newTaskQueue(ctx)}
+}
+
+// QueueDepth returns the number of subtasks that are waiting to be run at
+// each priority.
+func (s *Scheduler) QueueDepth() map[int64]int64 {
+	return s.taskQueue.queueDepth()
+}
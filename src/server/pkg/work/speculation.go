@@ -0,0 +1,240 @@
+package work
+
+import (
+	"context"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+var (
+	// speculationThreshold is the fraction of subtasks that must be collected
+	// before stragglers are speculatively re-issued.
+	speculationThreshold = 0.75
+	// speculationMultiplier is how many times longer than the median runtime
+	// a subtask must run before it is considered a straggler.
+	speculationMultiplier = 1.5
+	// speculationCopies is the maximum number of copies of a subtask
+	// (including the original) that will be issued.
+	speculationCopies = 2
+	// speculationInterval is how often stragglers are checked for.
+	speculationInterval = time.Second
+	// commitInterval is how often a copy of a subtask that is waiting for
+	// another copy to commit checks whether it has.
+	commitInterval = 100 * time.Millisecond
+)
+
+// ErrSubtaskCommitted is returned by PrepareCommit and CommitSubtask when
+// another copy of the subtask has already committed its result.
+var ErrSubtaskCommitted = errors.New("another copy of the subtask has already committed its result")
+
+// RunOption configures how a set of subtasks is run.
+type RunOption func(*runConfig)
+
+type runConfig struct {
+	speculative bool
+}
+
+// WithSpeculation enables speculative execution of the subtasks.
+// Once most of the subtasks have been collected, subtasks that have been
+// running much longer than the median runtime are re-issued to idle workers.
+// The first successful copy of a subtask is collected, and the other copies
+// are canceled. Subtasks with side effects should call PrepareCommit before
+// making them and CommitSubtask after, so that only one copy of each subtask
+// makes them.
+func WithSpeculation() RunOption {
+	return func(rc *runConfig) {
+		rc.speculative = true
+	}
+}
+
+type speculativeSubtask struct {
+	task      *Task
+	start     time.Time
+	keys      map[string]struct{}
+	copies    int
+	collected bool
+}
+
+// speculator tracks the copies and runtimes of the subtasks in a task.
+type speculator struct {
+	taskID    string
+	mu        sync.Mutex
+	subtasks  map[string]*speculativeSubtask
+	order     []*speculativeSubtask
+	runtimes  []time.Duration
+	collected int
+}
+
+func newSpeculator(taskID string) *speculator {
+	return &speculator{
+		taskID:   taskID,
+		subtasks: make(map[string]*speculativeSubtask),
+	}
+}
+
+func (s *speculator) add(subtaskKey string, subtask *Task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := &speculativeSubtask{
+		task:   subtask,
+		start:  time.Now(),
+		keys:   map[string]struct{}{subtaskKey: struct{}{}},
+		copies: 1,
+	}
+	s.subtasks[subtask.ID] = st
+	s.order = append(s.order, st)
+}
+
+// finish records a terminal state for the copy of a subtask at subtaskKey.
+// It returns whether the result should be collected, and the keys of the
+// copies that should be canceled.
+func (s *speculator) finish(subtaskKey string, subtaskInfo *TaskInfo) (bool, []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.subtasks[subtaskInfo.Task.ID]
+	if !ok || st.collected {
+		return false, nil
+	}
+	if _, ok := st.keys[subtaskKey]; !ok {
+		return false, nil
+	}
+	delete(st.keys, subtaskKey)
+	// A failed copy is ignored while other copies may still succeed.
+	if subtaskInfo.State == State_FAILURE && len(st.keys) > 0 {
+		return false, nil
+	}
+	st.collected = true
+	s.collected++
+	if subtaskInfo.State == State_SUCCESS {
+		s.runtimes = append(s.runtimes, time.Since(st.start))
+	}
+	var cancelKeys []string
+	for key := range st.keys {
+		cancelKeys = append(cancelKeys, key)
+	}
+	st.keys = nil
+	return true, cancelKeys
+}
+
+func (s *speculator) done() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.collected == len(s.order)
+}
+
+// reissue calls f with a new key for each straggling subtask.
+// It should only be called after all of the subtasks have been added.
+func (s *speculator) reissue(f func(string, *Task) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.runtimes) == 0 || float64(s.collected) < speculationThreshold*float64(len(s.order)) {
+		return nil
+	}
+	runtimes := make([]time.Duration, len(s.runtimes))
+	copy(runtimes, s.runtimes)
+	sort.Slice(runtimes, func(i, j int) bool { return runtimes[i] < runtimes[j] })
+	cutoff := time.Duration(float64(runtimes[len(runtimes)/2]) * speculationMultiplier)
+	// Subtasks are added in creation order, so the oldest stragglers are
+	// re-issued first.
+	for _, st := range s.order {
+		if st.collected || st.copies >= speculationCopies || time.Since(st.start) <= cutoff {
+			continue
+		}
+		subtaskKey := path.Join(s.taskID, uuid.NewWithoutDashes())
+		if err := f(subtaskKey, st.task); err != nil {
+			return err
+		}
+		st.keys[subtaskKey] = struct{}{}
+		st.copies++
+	}
+	return nil
+}
+
+// speculate periodically re-issues straggling subtasks until all of the
+// subtasks have been collected.
+func (m *Master) speculate(ctx context.Context, s *speculator, collectDone <-chan struct{}) error {
+	ticker := time.NewTicker(speculationInterval)
+	defer ticker.Stop()
+	for !s.done() {
+		select {
+		case <-ticker.C:
+		case <-collectDone:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+		if err := s.reissue(m.createSubtask); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cancelSubtasks deletes the subtasks at the passed in keys.
+func (m *Master) cancelSubtasks(subtaskKeys []string) error {
+	if len(subtaskKeys) == 0 {
+		return nil
+	}
+	return m.store.cancelSubtasks(m.taskEntry.ctx, subtaskKeys)
+}
+
+type commitKey struct{}
+
+type subtaskCommit struct {
+	store                 taskStore
+	subtaskKey, subtaskID string
+}
+
+// withCommit returns a context that CommitSubtask can commit the copy of
+// subtask at subtaskKey with.
+func withCommit(ctx context.Context, store taskStore, subtaskKey string, subtask *Task) context.Context {
+	return context.WithValue(ctx, commitKey{}, &subtaskCommit{
+		store:      store,
+		subtaskKey: subtaskKey,
+		subtaskID:  subtask.ID,
+	})
+}
+
+// PrepareCommit prepares to commit the copy of the subtask being processed
+// with ctx (the context passed to a ProcessFunc), before the copy makes its
+// result visible outside of the subtask. Only one copy of a speculatively
+// executed subtask can prepare at a time, so PrepareCommit waits while another
+// copy is committing. It returns ErrSubtaskCommitted if another copy committed.
+// If the copy that prepared fails or loses its claim before it commits,
+// another copy can prepare.
+func PrepareCommit(ctx context.Context) error {
+	return commitSubtask(ctx, false)
+}
+
+// CommitSubtask commits the copy of the subtask being processed with ctx,
+// after the copy made its result visible. It waits and fails like
+// PrepareCommit if the copy did not prepare. A copy that is retried after
+// committing can commit again.
+func CommitSubtask(ctx context.Context) error {
+	return commitSubtask(ctx, true)
+}
+
+func commitSubtask(ctx context.Context, committed bool) error {
+	sc, ok := ctx.Value(commitKey{}).(*subtaskCommit)
+	if !ok {
+		return errors.Errorf("no subtask to commit in context")
+	}
+	ticker := time.NewTicker(commitInterval)
+	defer ticker.Stop()
+	for {
+		ok, err := sc.store.commitSubtask(ctx, sc.subtaskKey, sc.subtaskID, committed)
+		if err != nil || ok {
+			return err
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
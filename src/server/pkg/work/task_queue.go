@@ -3,6 +3,7 @@ package work
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
@@ -25,14 +26,21 @@ type taskEntry struct {
 	ctx             context.Context
 	cancel          context.CancelFunc
 	subtaskFuncChan chan subtaskFunc
+	group           string
+	priority        int64
+	weight          int64
+	// pending is the number of subtasks that are waiting to be run.
+	pending int64
 }
 
 // runSubtask sends a subtask to be run in the task queue.
 // The task queue will not attempt to receive a subtask function until it will be processed.
 func (te *taskEntry) runSubtask(subtask subtaskFunc) {
+	atomic.AddInt64(&te.pending, 1)
 	select {
 	case te.subtaskFuncChan <- subtask:
 	case <-te.ctx.Done():
+		atomic.AddInt64(&te.pending, -1)
 	}
 }
 
@@ -45,59 +53,134 @@ func (te *taskEntry) runSubtaskBlock(subtask subtaskBlockFunc) error {
 	return <-errChan
 }
 
+type taskOption func(*taskEntry)
+
+// withPriority sets the group, priority, and weight of a task.
+// Subtasks from tasks with a higher priority are run first. Groups with the
+// same priority share the task queue in proportion to their weight, and the
+// tasks within a group are run in task creation order.
+func withPriority(group string, priority, weight int64) taskOption {
+	return func(te *taskEntry) {
+		te.group = group
+		te.priority = priority
+		if weight > 0 {
+			te.weight = weight
+		}
+	}
+}
+
+// taskGroup tracks the share of the task queue that a group has received.
+type taskGroup struct {
+	tasks  int
+	finish float64
+}
+
 // The task queue data structure is an ordered map that stores task entries.
 // Subtasks are sent through the subtask function channel in the task entries.
 // The reason this design was chosen (as compared to a priority queue where the subtasks are the entries) is because it
 // has a much lower memory footprint at scale, and our use case is such that the number of tasks in general will be
 // significantly lower than the number of subtasks. Also, we are not concerned with the ordering of subtasks within a task,
 // only the ordering of subtasks across tasks.
+// The share of each group is determined with start-time fair queueing, where
+// each subtask run advances the virtual time of its group by the inverse of
+// the group's weight.
 type taskQueue struct {
 	tasks                  *ordered_map.OrderedMap
+	groups                 map[string]*taskGroup
+	virtualTime            float64
 	mu                     sync.Mutex
 	tasksDeletedSinceRemap int
 }
 
 func newTaskQueue(ctx context.Context) *taskQueue {
 	tq := &taskQueue{
-		tasks: ordered_map.NewOrderedMap(),
+		tasks:  ordered_map.NewOrderedMap(),
+		groups: make(map[string]*taskGroup),
 	}
-	// The next subtask to process is determined by iterating through the ordered map and checking the
-	// subtask function channel for each task entry to see if the next subtask is ready to be processed.
-	// If a subtask function is received, then it is executed.
-	// After processing a subtask, the iteration starts from the beginning (new subtasks from earlier
+	// The next subtask to process is determined by iterating through the ordered map and selecting
+	// the task entry with pending subtasks that has the highest priority, then the earliest start
+	// time for its group, then the earliest creation time.
+	// The subtask function is then received from the selected task entry and executed.
+	// After processing a subtask, the selection starts from the beginning (new subtasks from earlier
 	// tasks should be processed first).
 	go func() {
-	NextSubtask:
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			default:
 			}
-			tq.mu.Lock()
-			iter := tq.tasks.IterFunc()
-			for kv, ok := iter(); ok; kv, ok = iter() {
-				te := kv.Value.(*taskEntry)
-				select {
-				case f := <-te.subtaskFuncChan:
-					tq.mu.Unlock()
-					f(te.ctx)
-					continue NextSubtask
-				default:
-				}
+			te := tq.next()
+			if te == nil {
+				time.Sleep(waitTime)
+				continue
+			}
+			select {
+			case f := <-te.subtaskFuncChan:
+				atomic.AddInt64(&te.pending, -1)
+				f(te.ctx)
+			case <-te.ctx.Done():
+			case <-ctx.Done():
+				return
 			}
-			tq.mu.Unlock()
-			time.Sleep(waitTime)
 		}
 	}()
 	return tq
 }
 
+// next selects the task entry to run the next subtask from, and advances the
+// virtual time of its group.
+func (tq *taskQueue) next() *taskEntry {
+	tq.mu.Lock()
+	defer tq.mu.Unlock()
+	var next *taskEntry
+	var nextStart float64
+	iter := tq.tasks.IterFunc()
+	for kv, ok := iter(); ok; kv, ok = iter() {
+		te := kv.Value.(*taskEntry)
+		if atomic.LoadInt64(&te.pending) == 0 {
+			continue
+		}
+		start := tq.start(te.group)
+		if next == nil || te.priority > next.priority || (te.priority == next.priority && start < nextStart) {
+			next = te
+			nextStart = start
+		}
+	}
+	if next != nil {
+		tq.virtualTime = nextStart
+		tq.groups[next.group].finish = nextStart + 1/float64(next.weight)
+	}
+	return next
+}
+
+// start returns the virtual start time of the next subtask in a group.
+func (tq *taskQueue) start(group string) float64 {
+	finish := tq.groups[group].finish
+	if finish > tq.virtualTime {
+		return finish
+	}
+	return tq.virtualTime
+}
+
+// queueDepth returns the number of subtasks that are waiting to be run at each priority.
+func (tq *taskQueue) queueDepth() map[int64]int64 {
+	tq.mu.Lock()
+	defer tq.mu.Unlock()
+	depth := make(map[int64]int64)
+	iter := tq.tasks.IterFunc()
+	for kv, ok := iter(); ok; kv, ok = iter() {
+		te := kv.Value.(*taskEntry)
+		depth[te.priority] += atomic.LoadInt64(&te.pending)
+	}
+	return depth
+}
+
 // runTask runs a new task in the task queue.
 // The task code should be contained within the passed in callback.
 // The callback will receive a taskEntry, which should be used for running subtasks in the task queue.
 // The task state will be cleaned up upon return of the callback.
-func (tq *taskQueue) runTask(ctx context.Context, taskID string, f func(*taskEntry)) error {
+func (tq *taskQueue) runTask(ctx context.Context, taskID string, f func(*taskEntry), opts ...taskOption) error {
 	tq.mu.Lock()
 	defer tq.mu.Unlock()
 	if _, ok := tq.tasks.Get(taskID); ok {
@@ -108,7 +191,15 @@ func (tq *taskQueue) runTask(ctx context.Context, taskID string, f func(*taskEnt
 		ctx:             ctx,
 		cancel:          cancel,
 		subtaskFuncChan: make(chan subtaskFunc, 1),
+		weight:          1,
 	}
+	for _, opt := range opts {
+		opt(te)
+	}
+	if _, ok := tq.groups[te.group]; !ok {
+		tq.groups[te.group] = &taskGroup{}
+	}
+	tq.groups[te.group].tasks++
 	tq.tasks.Set(taskID, te)
 	go func() {
 		defer tq.deleteTask(taskID)
@@ -140,7 +231,13 @@ func (tq *taskQueue) deleteTask(taskID string) {
 	if !ok {
 		return
 	}
-	tc.(*taskEntry).cancel()
+	te := tc.(*taskEntry)
+	te.cancel()
 	tq.tasks.Delete(taskID)
+	// Groups without tasks are removed, and rejoin at the current virtual time.
+	tq.groups[te.group].tasks--
+	if tq.groups[te.group].tasks == 0 {
+		delete(tq.groups, te.group)
+	}
 	tq.maybeRemap()
 }
@@ -3,9 +3,11 @@ package work
 import (
 	"context"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
 )
 
@@ -63,3 +65,100 @@ func TestTaskQueue(t *testing.T) {
 		}
 	}
 }
+
+// runOrder queues numSubtasks subtasks for each task (created with the passed
+// in options), and returns the order that the subtasks of the tasks ran in once
+// they were all queued.
+func runOrder(t *testing.T, numSubtasks int, opts ...taskOption) []int {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tq := newTaskQueue(ctx)
+	// The gate task blocks the task queue until all of the subtasks are queued.
+	started, release := make(chan struct{}), make(chan struct{})
+	require.NoError(t, tq.runTask(ctx, "gate", func(te *taskEntry) {
+		require.NoError(t, te.runSubtaskBlock(func(_ context.Context) error {
+			close(started)
+			<-release
+			return nil
+		}))
+	}))
+	<-started
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	wg.Add(len(opts) * numSubtasks)
+	for i, opt := range opts {
+		i := i
+		require.NoError(t, tq.runTask(ctx, strconv.Itoa(i), func(te *taskEntry) {
+			for j := 0; j < numSubtasks; j++ {
+				go te.runSubtask(func(_ context.Context) {
+					mu.Lock()
+					defer mu.Unlock()
+					order = append(order, i)
+					wg.Done()
+				})
+			}
+			<-te.ctx.Done()
+		}, opt))
+	}
+	for {
+		var queued int64
+		for _, depth := range tq.queueDepth() {
+			queued += depth
+		}
+		if queued == int64(len(opts)*numSubtasks) {
+			break
+		}
+		time.Sleep(waitTime)
+	}
+	close(release)
+	wg.Wait()
+	return order
+}
+
+func TestTaskQueuePriority(t *testing.T) {
+	order := runOrder(t, 3, withPriority("a", 0, 1), withPriority("b", 1, 1))
+	require.Equal(t, []int{1, 1, 1, 0, 0, 0}, order)
+}
+
+func TestTaskQueueWeight(t *testing.T) {
+	order := runOrder(t, 3, withPriority("a", 0, 1), withPriority("b", 0, 2))
+	require.Equal(t, []int{0, 1, 1, 0, 1, 0}, order)
+}
+
+func TestTaskQueueGroupOrder(t *testing.T) {
+	// Tasks in the same group run in task creation order.
+	order := runOrder(t, 3, withPriority("a", 0, 1), withPriority("a", 0, 1))
+	require.Equal(t, []int{0, 0, 0, 1, 1, 1}, order)
+}
+
+func TestTaskQueueDepth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tq := newTaskQueue(ctx)
+	started, release := make(chan struct{}), make(chan struct{})
+	require.NoError(t, tq.runTask(ctx, "gate", func(te *taskEntry) {
+		require.NoError(t, te.runSubtaskBlock(func(_ context.Context) error {
+			close(started)
+			<-release
+			return nil
+		}))
+	}))
+	<-started
+	defer close(release)
+	for i, priority := range []int64{0, 1, 1} {
+		require.NoError(t, tq.runTask(ctx, strconv.Itoa(i), func(te *taskEntry) {
+			for j := 0; j < 2; j++ {
+				go te.runSubtask(func(_ context.Context) {})
+			}
+			<-te.ctx.Done()
+		}, withPriority(strconv.Itoa(i), priority, 1)))
+	}
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		depth := tq.queueDepth()
+		if depth[0] != 2 || depth[1] != 4 {
+			return errors.Errorf("unexpected queue depth: %v", depth)
+		}
+		return nil
+	})
+}
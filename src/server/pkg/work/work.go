@@ -7,96 +7,119 @@ import (
 	"sync/atomic"
 
 	etcd "github.com/coreos/etcd/clientv3"
-	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
-	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
-	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 	"golang.org/x/sync/errgroup"
 )
 
 const (
-	taskPrefix    = "/task"
-	subtaskPrefix = "/subtask"
-	claimPrefix   = "/claim"
+	// EtcdBackend is the name of the etcd task store backend.
+	EtcdBackend = "etcd"
+	// PostgresBackend is the name of the postgres task store backend.
+	PostgresBackend = "postgres"
 )
 
+// ValidateBackend returns an error if backend is not the name of a task store
+// backend. An empty backend is the etcd backend.
+func ValidateBackend(backend string) error {
+	switch backend {
+	case "", EtcdBackend, PostgresBackend:
+		return nil
+	default:
+		return errors.Errorf("unknown work backend %q (must be %q or %q)", backend, EtcdBackend, PostgresBackend)
+	}
+}
+
+// taskStore stores the tasks and subtasks in a task namespace.
+type taskStore interface {
+	createTask(ctx context.Context, task *Task) error
+	deleteTask(taskID string) error
+	deleteAllTasks() error
+	createSubtask(ctx context.Context, subtaskKey string, subtask *Task) error
+	// cancelSubtasks deletes the subtasks at the passed in keys.
+	// Workers processing a deleted subtask stop when they lose their claim.
+	cancelSubtasks(ctx context.Context, subtaskKeys []string) error
+	// commitSubtask records the copy of a subtask at subtaskKey as the copy
+	// that is committing its result (or that committed it, if committed is
+	// set). It returns false if another copy that still holds its claim is
+	// committing, and ErrSubtaskCommitted if another copy committed.
+	commitSubtask(ctx context.Context, subtaskKey, subtaskID string, committed bool) (bool, error)
+	deleteSubtasks(taskID string) error
+	// watchSubtasks calls f with the key and info of each subtask in a task
+	// that reaches a terminal state, until f returns an error.
+	watchSubtasks(ctx context.Context, taskID string, f func(string, *TaskInfo) error) error
+	// watchTasks calls f with each task that is created or deleted.
+	watchTasks(ctx context.Context, f func(taskID string, task *Task, deleted bool) error) error
+	// runTask claims and processes the subtasks of a task in the passed in
+	// task entry until the task entry is canceled.
+	runTask(task *Task, taskEntry *taskEntry, processFunc ProcessFunc) error
+}
+
 // TaskQueue manages a set of parallel tasks, and provides an interface for running tasks.
 // Priority of tasks (and therefore subtasks) is based on task creation time, so tasks created
 // earlier will be prioritized over tasks that were created later.
+// The tasks of task queues in different namespaces (or groups, see WithGroup) are scheduled
+// by workers based on the priority and weight of the task queues (see WithPriority).
 type TaskQueue struct {
-	*taskEtcd
-	taskQueue *taskQueue
+	store            taskStore
+	taskQueue        *taskQueue
+	priority, weight int64
+	group            string
 }
 
-type taskEtcd struct {
-	etcdClient                    *etcd.Client
-	taskCol, subtaskCol, claimCol col.Collection
+// NewTaskQueue sets up a new task queue backed by etcd.
+func NewTaskQueue(ctx context.Context, etcdClient *etcd.Client, etcdPrefix string, taskNamespace string, opts ...TaskQueueOption) (*TaskQueue, error) {
+	return newTaskQueueWithStore(ctx, newEtcdStore(etcdClient, etcdPrefix, taskNamespace), taskNamespace, opts...)
 }
 
-// NewTaskQueue sets up a new task queue.
-func NewTaskQueue(ctx context.Context, etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) (*TaskQueue, error) {
+func newTaskQueueWithStore(ctx context.Context, store taskStore, taskNamespace string, opts ...TaskQueueOption) (*TaskQueue, error) {
 	tq := &TaskQueue{
-		taskEtcd:  newTaskEtcd(etcdClient, etcdPrefix, taskNamespace),
+		store:     store,
 		taskQueue: newTaskQueue(ctx),
 	}
-	// Clear etcd key space.
+	for _, opt := range opts {
+		opt(tq)
+	}
+	// Clear the task namespace.
 	// TODO: Multiple storage task queues are setup, so deleting the existing tasks is problematic.
 	if taskNamespace != "storage" {
-		if err := tq.deleteAllTasks(); err != nil {
+		if err := tq.store.deleteAllTasks(); err != nil {
 			return nil, err
 		}
 	}
 	return tq, nil
 }
 
-func newTaskEtcd(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *taskEtcd {
-	return &taskEtcd{
-		etcdClient: etcdClient,
-		taskCol:    newCollection(etcdClient, path.Join(etcdPrefix, taskPrefix, taskNamespace), &Task{}),
-		subtaskCol: newCollection(etcdClient, path.Join(etcdPrefix, subtaskPrefix, taskNamespace), &TaskInfo{}),
-		claimCol:   newCollection(etcdClient, path.Join(etcdPrefix, claimPrefix, taskNamespace), &Claim{}),
-	}
-}
-
-func newCollection(etcdClient *etcd.Client, etcdPrefix string, template proto.Message) col.Collection {
-	return col.NewCollection(
-		etcdClient,
-		etcdPrefix,
-		nil,
-		template,
-		nil,
-		nil,
-	)
-}
-
 // RunTask runs a task in the task queue.
 // The task code should be contained within the passed in callback.
 // The callback will receive a Master, which should be used for running subtasks in the task queue.
 // The task state will be cleaned up upon return of the callback.
 func (tq *TaskQueue) RunTask(ctx context.Context, f func(*Master)) (retErr error) {
-	task := &Task{ID: uuid.NewWithoutDashes()}
-	if _, err := col.NewSTM(ctx, tq.etcdClient, func(stm col.STM) error {
-		return tq.taskCol.ReadWrite(stm).Put(task.ID, task)
-	}); err != nil {
+	task := &Task{
+		ID:       uuid.NewWithoutDashes(),
+		Priority: tq.priority,
+		Weight:   tq.weight,
+		Group:    tq.group,
+	}
+	if err := tq.store.createTask(ctx, task); err != nil {
 		return err
 	}
 	defer func() {
 		if retErr != nil {
-			if err := tq.deleteTask(task.ID); err != nil {
+			if err := tq.store.deleteTask(task.ID); err != nil {
 				fmt.Printf("errored deleting task %v: %v\n", task.ID, err)
 			}
 		}
 	}()
 	return tq.taskQueue.runTask(ctx, task.ID, func(te *taskEntry) {
 		defer func() {
-			if err := tq.deleteTask(task.ID); err != nil {
+			if err := tq.store.deleteTask(task.ID); err != nil {
 				fmt.Printf("errored deleting task %v: %v\n", task.ID, err)
 			}
 		}()
 		f(&Master{
-			taskEtcd:  tq.taskEtcd,
+			store:     tq.store,
 			taskID:    task.ID,
 			taskEntry: te,
 		})
@@ -116,7 +139,7 @@ func (tq *TaskQueue) RunTaskBlock(ctx context.Context, f func(*Master) error) er
 
 // Master manages subtasks in the task queue, and provides an interface for running subtasks.
 type Master struct {
-	*taskEtcd
+	store     taskStore
 	taskID    string
 	taskEntry *taskEntry
 }
@@ -131,11 +154,11 @@ func (m *Master) Ctx() context.Context {
 type CollectFunc func(context.Context, *TaskInfo) error
 
 // RunSubtasks runs a set of subtasks and collects the results with the passed in callback.
-func (m *Master) RunSubtasks(subtasks []*Task, collectFunc CollectFunc) (retErr error) {
+func (m *Master) RunSubtasks(subtasks []*Task, collectFunc CollectFunc, opts ...RunOption) (retErr error) {
 	var eg errgroup.Group
 	subtaskChan := make(chan *Task)
 	eg.Go(func() error {
-		return m.RunSubtasksChan(subtaskChan, collectFunc)
+		return m.RunSubtasksChan(subtaskChan, collectFunc, opts...)
 	})
 	defer func() {
 		close(subtaskChan)
@@ -154,21 +177,31 @@ func (m *Master) RunSubtasks(subtasks []*Task, collectFunc CollectFunc) (retErr
 }
 
 // RunSubtasksChan runs a set of subtasks (provided through a channel) and collects the results with the passed in callback.
-func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc) (retErr error) {
+func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc, opts ...RunOption) (retErr error) {
+	config := &runConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	var spec *speculator
+	if config.speculative {
+		spec = newSpeculator(m.taskID)
+	}
 	var eg errgroup.Group
 	var count int64
 	done := make(chan struct{})
+	collectDone := make(chan struct{})
 	ctx, cancel := context.WithCancel(m.taskEntry.ctx)
 	eg.Go(func() error {
-		return m.subtaskCol.ReadOnly(ctx).WatchOneF(m.taskID, func(e *watch.Event) error {
-			var key string
-			subtaskInfo := &TaskInfo{}
-			if err := e.Unmarshal(&key, subtaskInfo); err != nil {
-				return err
-			}
-			// Check that the subtask state is terminal.
-			if subtaskInfo.State == State_RUNNING {
-				return nil
+		defer close(collectDone)
+		return m.store.watchSubtasks(ctx, m.taskID, func(key string, subtaskInfo *TaskInfo) error {
+			if spec != nil {
+				collect, cancelKeys := spec.finish(key, subtaskInfo)
+				if err := m.cancelSubtasks(cancelKeys); err != nil {
+					return err
+				}
+				if !collect {
+					return nil
+				}
 			}
 			if collectFunc != nil {
 				if err := m.taskEntry.runSubtaskBlock(func(ctx context.Context) error {
@@ -198,191 +231,91 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 		if err := eg.Wait(); retErr == nil && !errors.Is(ctx.Err(), context.Canceled) {
 			retErr = err
 		}
-		if err := m.deleteSubtasks(); err != nil {
+		if err := m.store.deleteSubtasks(m.taskID); err != nil {
 			fmt.Printf("errored deleting subtasks for task %v: %v\n", m.taskID, err)
 		}
 	}()
 
 	for subtask := range subtaskChan {
-		if err := m.createSubtask(subtask); err != nil {
+		if subtask.ID == "" {
+			subtask.ID = uuid.NewWithoutDashes()
+		}
+		subtaskKey := path.Join(m.taskID, subtask.ID)
+		if spec != nil {
+			// The subtask is tracked before it is created, so that it can't
+			// finish before it is tracked.
+			spec.add(subtaskKey, subtask)
+		}
+		if err := m.createSubtask(subtaskKey, subtask); err != nil {
 			return err
 		}
 		atomic.AddInt64(&count, 1)
 	}
-	return nil
-}
-
-func (m *Master) createSubtask(subtask *Task) error {
-	if subtask.ID == "" {
-		subtask.ID = uuid.NewWithoutDashes()
-	}
-	subtaskKey := path.Join(m.taskID, subtask.ID)
-	subtaskInfo := &TaskInfo{Task: subtask}
-	if _, err := col.NewSTM(m.taskEntry.ctx, m.etcdClient, func(stm col.STM) error {
-		return m.subtaskCol.ReadWrite(stm).Put(subtaskKey, subtaskInfo)
-	}); err != nil {
-		return err
+	if spec != nil {
+		eg.Go(func() error {
+			return m.speculate(ctx, spec, collectDone)
+		})
 	}
 	return nil
 }
 
-func (m *Master) deleteSubtasks() error {
-	_, err := col.NewSTM(context.Background(), m.etcdClient, func(stm col.STM) error {
-		m.subtaskCol.ReadWrite(stm).DeleteAllPrefix(m.taskID)
-		return nil
-	})
-	return err
-}
-
-func (tq *TaskQueue) deleteTask(taskID string) error {
-	_, err := col.NewSTM(context.Background(), tq.etcdClient, func(stm col.STM) error {
-		tq.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
-		return tq.taskCol.ReadWrite(stm).Delete(taskID)
-	})
-	return err
-}
-
-func (tq *TaskQueue) deleteAllTasks() error {
-	_, err := col.NewSTM(context.Background(), tq.etcdClient, func(stm col.STM) error {
-		tq.subtaskCol.ReadWrite(stm).DeleteAll()
-		tq.taskCol.ReadWrite(stm).DeleteAll()
-		return nil
-	})
-	return err
+func (m *Master) createSubtask(subtaskKey string, subtask *Task) error {
+	return m.store.createSubtask(m.taskEntry.ctx, subtaskKey, subtask)
 }
 
 // Worker is a worker that will process subtasks in a task.
-// A worker watches the task collection for tasks to be created / deleted and appropriately
-// runs / deletes tasks in the internal task queue with a function that watches the
-// subtask and claim collections for subtasks that need to be processed.
+// A worker watches the task store for tasks to be created / deleted and appropriately
+// runs / deletes tasks in the internal task queue with a function that claims the
+// subtasks that need to be processed.
 // The processFunc callback will be called for each subtask that needs to be processed
 // in the task.
 type Worker struct {
-	*taskEtcd
+	store         taskStore
+	taskNamespace string
+	scheduler     *Scheduler
 }
 
-// NewWorker creates a new worker.
-func NewWorker(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *Worker {
-	return &Worker{taskEtcd: newTaskEtcd(etcdClient, etcdPrefix, taskNamespace)}
+// NewWorker creates a new worker backed by etcd.
+func NewWorker(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string, opts ...WorkerOption) *Worker {
+	return newWorkerWithStore(newEtcdStore(etcdClient, etcdPrefix, taskNamespace), taskNamespace, opts...)
+}
+
+func newWorkerWithStore(store taskStore, taskNamespace string, opts ...WorkerOption) *Worker {
+	w := &Worker{
+		store:         store,
+		taskNamespace: taskNamespace,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
 }
 
 // ProcessFunc is a callback that is used for processing a subtask in a task.
 type ProcessFunc func(context.Context, *Task) error
 
 // Run runs the worker with the given context.
-// The worker will continue to watch the task collection until the context is canceled.
+// The worker will continue to watch the task store until the context is canceled.
 func (w *Worker) Run(ctx context.Context, processFunc ProcessFunc) error {
-	taskQueue := newTaskQueue(ctx)
-	return w.taskCol.ReadOnly(ctx).WatchF(func(e *watch.Event) error {
-		var taskID string
-		task := &Task{}
-		if err := e.Unmarshal(&taskID, task); err != nil {
-			return err
-		}
-		if e.Type == watch.EventDelete {
-			taskQueue.deleteTask(taskID)
+	var taskQueue *taskQueue
+	if w.scheduler != nil {
+		taskQueue = w.scheduler.taskQueue
+	} else {
+		taskQueue = newTaskQueue(ctx)
+	}
+	// The task queue may be shared with workers in other namespaces.
+	taskKey := func(taskID string) string {
+		return path.Join(w.taskNamespace, taskID)
+	}
+	return w.store.watchTasks(ctx, func(taskID string, task *Task, deleted bool) error {
+		if deleted {
+			taskQueue.deleteTask(taskKey(taskID))
 			return nil
 		}
-		return taskQueue.runTask(ctx, taskID, func(taskEntry *taskEntry) {
-			if err := w.taskFunc(task, taskEntry, processFunc); err != nil && !errors.Is(taskEntry.ctx.Err(), context.Canceled) {
+		return taskQueue.runTask(ctx, taskKey(taskID), func(taskEntry *taskEntry) {
+			if err := w.store.runTask(task, taskEntry, processFunc); err != nil && !errors.Is(taskEntry.ctx.Err(), context.Canceled) {
 				fmt.Printf("errored in task callback: %v\n", err)
 			}
-		})
+		}, withPriority(path.Join(w.taskNamespace, task.Group), task.Priority, task.Weight))
 	})
 }
-
-func (w *Worker) taskFunc(task *Task, taskEntry *taskEntry, processFunc ProcessFunc) error {
-	claimWatch, err := w.claimCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.WithFilterPut())
-	if err != nil {
-		return err
-	}
-	defer claimWatch.Close()
-	subtaskWatch, err := w.subtaskCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.WithFilterDelete())
-	if err != nil {
-		return err
-	}
-	defer subtaskWatch.Close()
-	for {
-		select {
-		case e := <-claimWatch.Watch():
-			if e.Type == watch.EventError {
-				return e.Err
-			}
-			if e.Type != watch.EventDelete {
-				continue
-			}
-			var subtaskKey string
-			if err := e.Unmarshal(&subtaskKey, &Claim{}); err != nil {
-				return err
-			}
-			taskEntry.runSubtask(w.subtaskFunc(subtaskKey, processFunc))
-		case e := <-subtaskWatch.Watch():
-			if e.Type == watch.EventError {
-				return e.Err
-			}
-			var subtaskKey string
-			if err := e.Unmarshal(&subtaskKey, &TaskInfo{}); err != nil {
-				return err
-			}
-			taskEntry.runSubtask(w.subtaskFunc(subtaskKey, processFunc))
-		case <-taskEntry.ctx.Done():
-			return taskEntry.ctx.Err()
-		}
-	}
-}
-
-func (w *Worker) subtaskFunc(subtaskKey string, processFunc ProcessFunc) subtaskFunc {
-	return func(ctx context.Context) {
-		if err := func() error {
-			// (bryce) this should be refactored to have the check and claim in the same stm.
-			// there is a rare race condition that does not affect correctness, but it is less
-			// than ideal because a subtask could get run once more than necessary.
-			subtaskInfo := &TaskInfo{}
-			if _, err := col.NewSTM(ctx, w.etcdClient, func(stm col.STM) error {
-				return w.subtaskCol.ReadWrite(stm).Get(subtaskKey, subtaskInfo)
-			}); err != nil {
-				return err
-			}
-			if subtaskInfo.State != State_RUNNING {
-				return nil
-			}
-			return w.claimCol.Claim(ctx, subtaskKey, &Claim{}, func(claimCtx context.Context) (retErr error) {
-				subtask := subtaskInfo.Task
-				defer func() {
-					// If the task context was canceled or the claim was lost, just return with no error.
-					if errors.Is(claimCtx.Err(), context.Canceled) {
-						retErr = nil
-						return
-					}
-					subtaskInfo := &TaskInfo{}
-					if _, err := col.NewSTM(claimCtx, w.etcdClient, func(stm col.STM) error {
-						return w.subtaskCol.ReadWrite(stm).Update(subtaskKey, subtaskInfo, func() error {
-							// (bryce) remove when check and claim are in the same stm.
-							if subtaskInfo.State != State_RUNNING {
-								return nil
-							}
-							subtaskInfo.Task = subtask
-							subtaskInfo.State = State_SUCCESS
-							if retErr != nil {
-								subtaskInfo.State = State_FAILURE
-								subtaskInfo.Reason = retErr.Error()
-								retErr = nil
-							}
-							return nil
-						})
-					}); retErr == nil {
-						retErr = err
-					}
-				}()
-				return processFunc(claimCtx, subtask)
-			})
-		}(); err != nil {
-			// If the task context was canceled or the subtask was deleted / not claimed, then no error should be logged.
-			if errors.Is(ctx.Err(), context.Canceled) ||
-				col.IsErrNotFound(err) || errors.Is(err, col.ErrNotClaimed) {
-				return
-			}
-			fmt.Printf("errored in subtask callback: %v\n", err)
-		}
-	}
-}
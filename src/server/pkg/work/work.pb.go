@@ -53,11 +53,16 @@ func (State) EnumDescriptor() ([]byte, []int) {
 }
 
 type Task struct {
-	ID                   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Data                 *types.Any `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	ID   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data *types.Any `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// priority, weight and group determine how the subtasks of a task are
+	// scheduled by workers (only set for tasks).
+	Priority             int64    `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Weight               int64    `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Group                string   `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Task) Reset()         { *m = Task{} }
@@ -107,6 +112,27 @@ func (m *Task) GetData() *types.Any {
 	return nil
 }
 
+func (m *Task) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Task) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *Task) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
 type TaskInfo struct {
 	Task                 *Task    `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	State                State    `protobuf:"varint,2,opt,name=state,proto3,enum=work.State" json:"state,omitempty"`
@@ -209,6 +235,63 @@ func (m *Claim) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Claim proto.InternalMessageInfo
 
+// SubtaskCommit records the copy of a subtask that is committing its result,
+// or that committed it if committed is set.
+type SubtaskCommit struct {
+	SubtaskKey           string   `protobuf:"bytes,1,opt,name=subtask_key,json=subtaskKey,proto3" json:"subtask_key,omitempty"`
+	Committed            bool     `protobuf:"varint,2,opt,name=committed,proto3" json:"committed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubtaskCommit) Reset()         { *m = SubtaskCommit{} }
+func (m *SubtaskCommit) String() string { return proto.CompactTextString(m) }
+func (*SubtaskCommit) ProtoMessage()    {}
+func (*SubtaskCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58a68e4647f78187, []int{3}
+}
+func (m *SubtaskCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubtaskCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubtaskCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubtaskCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubtaskCommit.Merge(m, src)
+}
+func (m *SubtaskCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubtaskCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubtaskCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubtaskCommit proto.InternalMessageInfo
+
+func (m *SubtaskCommit) GetSubtaskKey() string {
+	if m != nil {
+		return m.SubtaskKey
+	}
+	return ""
+}
+
+func (m *SubtaskCommit) GetCommitted() bool {
+	if m != nil {
+		return m.Committed
+	}
+	return false
+}
+
 type TestData struct {
 	Processed            bool     `protobuf:"varint,1,opt,name=processed,proto3" json:"processed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -220,7 +303,7 @@ func (m *TestData) Reset()         { *m = TestData{} }
 func (m *TestData) String() string { return proto.CompactTextString(m) }
 func (*TestData) ProtoMessage()    {}
 func (*TestData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_58a68e4647f78187, []int{3}
+	return fileDescriptor_58a68e4647f78187, []int{4}
 }
 func (m *TestData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,34 +344,40 @@ func init() {
 	proto.RegisterType((*Task)(nil), "work.Task")
 	proto.RegisterType((*TaskInfo)(nil), "work.TaskInfo")
 	proto.RegisterType((*Claim)(nil), "work.Claim")
+	proto.RegisterType((*SubtaskCommit)(nil), "work.SubtaskCommit")
 	proto.RegisterType((*TestData)(nil), "work.TestData")
 }
 
 func init() { proto.RegisterFile("server/pkg/work/work.proto", fileDescriptor_58a68e4647f78187) }
 
 var fileDescriptor_58a68e4647f78187 = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcb, 0x6a, 0xe3, 0x30,
-	0x14, 0x86, 0xc7, 0x1e, 0xe7, 0x76, 0x0c, 0x43, 0x10, 0x21, 0x64, 0xc2, 0xe0, 0x49, 0xbd, 0x32,
-	0x5d, 0xd8, 0xe0, 0xbe, 0x40, 0x73, 0x6b, 0x6b, 0x28, 0x59, 0xc8, 0xc9, 0xa6, 0x3b, 0xc5, 0x56,
-	0x1c, 0xe3, 0xc4, 0x32, 0x92, 0xd2, 0x92, 0x37, 0xec, 0xb2, 0x4f, 0x50, 0x8a, 0x9f, 0xa4, 0x48,
-	0x6e, 0x69, 0xe9, 0x46, 0xfc, 0x97, 0xc3, 0xc7, 0x91, 0x04, 0x63, 0x41, 0xf9, 0x23, 0xe5, 0x41,
-	0x55, 0x64, 0xc1, 0x13, 0xe3, 0x85, 0x3e, 0xfc, 0x8a, 0x33, 0xc9, 0x90, 0xa5, 0xf4, 0x78, 0x90,
-	0xb1, 0x8c, 0xe9, 0x20, 0x50, 0xaa, 0xe9, 0xc6, 0x7f, 0x33, 0xc6, 0xb2, 0x03, 0x0d, 0xb4, 0xdb,
-	0x9e, 0x76, 0x01, 0x29, 0xcf, 0x4d, 0xe5, 0xde, 0x81, 0xb5, 0x26, 0xa2, 0x40, 0x43, 0x30, 0xf3,
-	0x74, 0x64, 0x4c, 0x0c, 0xaf, 0x37, 0x6b, 0xd7, 0xaf, 0xff, 0xcd, 0x68, 0x81, 0xcd, 0x3c, 0x45,
-	0x1e, 0x58, 0x29, 0x91, 0x64, 0x64, 0x4e, 0x0c, 0xcf, 0x0e, 0x07, 0x7e, 0x43, 0xf2, 0x3f, 0x49,
-	0xfe, 0xb4, 0x3c, 0x63, 0x3d, 0xe1, 0x52, 0xe8, 0x2a, 0x52, 0x54, 0xee, 0x18, 0x72, 0xc0, 0x92,
-	0x44, 0x14, 0x9a, 0x67, 0x87, 0xe0, 0xeb, 0x3d, 0x55, 0x8b, 0x75, 0x8e, 0x2e, 0xa0, 0x25, 0x24,
-	0x91, 0x54, 0x63, 0xff, 0x84, 0x76, 0x33, 0x10, 0xab, 0x08, 0x37, 0x0d, 0x1a, 0x42, 0x9b, 0x53,
-	0x22, 0x58, 0x39, 0xfa, 0xad, 0x96, 0xc2, 0x1f, 0xce, 0xed, 0x40, 0x6b, 0x7e, 0x20, 0xf9, 0xd1,
-	0xf5, 0xa0, 0xbb, 0xa6, 0x42, 0x2e, 0x88, 0x24, 0xe8, 0x1f, 0xf4, 0x2a, 0xce, 0x12, 0x2a, 0x04,
-	0x6d, 0x2e, 0xd1, 0xc5, 0x5f, 0xc1, 0xa5, 0x0f, 0x2d, 0x8d, 0x46, 0x36, 0x74, 0xf0, 0x66, 0xb5,
-	0x8a, 0x56, 0xb7, 0xfd, 0x5f, 0xca, 0xc4, 0x9b, 0xf9, 0x7c, 0x19, 0xc7, 0x7d, 0x43, 0x99, 0x9b,
-	0x69, 0x74, 0xbf, 0xc1, 0xcb, 0xbe, 0x39, 0xbb, 0x7e, 0xae, 0x1d, 0xe3, 0xa5, 0x76, 0x8c, 0xb7,
-	0xda, 0x31, 0x1e, 0xc2, 0x2c, 0x97, 0xfb, 0xd3, 0xd6, 0x4f, 0xd8, 0x31, 0xa8, 0x48, 0xb2, 0x3f,
-	0xa7, 0x94, 0x7f, 0x57, 0x82, 0x27, 0xc1, 0x8f, 0x7f, 0xd9, 0xb6, 0xf5, 0xfb, 0x5c, 0xbd, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0xec, 0xad, 0x45, 0x2a, 0xb1, 0x01, 0x00, 0x00,
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x86, 0x71, 0x96, 0x74, 0xe9, 0x89, 0x40, 0x95, 0x55, 0x4d, 0xa1, 0x9a, 0xba, 0x92, 0xab,
+	0x88, 0x8b, 0x44, 0x2a, 0x2f, 0xc0, 0xd6, 0x0d, 0x54, 0x81, 0x7a, 0xe1, 0xac, 0x37, 0xdc, 0x20,
+	0x37, 0xf1, 0x52, 0x2b, 0x6b, 0x1d, 0xd9, 0x2e, 0x53, 0x5e, 0x83, 0xa7, 0xe2, 0x92, 0x27, 0x40,
+	0x28, 0x4f, 0x82, 0x6c, 0x17, 0x8a, 0x76, 0x13, 0x9d, 0xff, 0xfb, 0x8f, 0xce, 0xf9, 0x73, 0x12,
+	0x98, 0x28, 0x26, 0xbf, 0x31, 0x99, 0xb7, 0x4d, 0x9d, 0x3f, 0x09, 0xd9, 0xd8, 0x47, 0xd6, 0x4a,
+	0xa1, 0x05, 0xf6, 0x4d, 0x3d, 0x19, 0xd7, 0xa2, 0x16, 0x16, 0xe4, 0xa6, 0x72, 0xde, 0xe4, 0x75,
+	0x2d, 0x44, 0xfd, 0xc8, 0x72, 0xab, 0x36, 0x87, 0x87, 0x9c, 0xee, 0x3b, 0x67, 0x25, 0xdf, 0x11,
+	0xf8, 0xf7, 0x54, 0x35, 0xf8, 0x02, 0x3c, 0x5e, 0xc5, 0x68, 0x86, 0xd2, 0xe1, 0xcd, 0xa0, 0xff,
+	0x75, 0xe5, 0x2d, 0x6f, 0x89, 0xc7, 0x2b, 0x9c, 0x82, 0x5f, 0x51, 0x4d, 0x63, 0x6f, 0x86, 0xd2,
+	0x68, 0x3e, 0xce, 0xdc, 0xa8, 0xec, 0xef, 0xa8, 0xec, 0x7a, 0xdf, 0x11, 0xdb, 0x81, 0x27, 0x10,
+	0xb6, 0x92, 0x0b, 0xc9, 0x75, 0x17, 0x9f, 0xcd, 0x50, 0x7a, 0x46, 0xfe, 0x69, 0x7c, 0x01, 0x83,
+	0x27, 0xc6, 0xeb, 0xad, 0x8e, 0x7d, 0xeb, 0x1c, 0x15, 0x1e, 0x43, 0x50, 0x4b, 0x71, 0x68, 0xe3,
+	0xc0, 0x2c, 0x26, 0x4e, 0x24, 0x0c, 0x42, 0x93, 0x69, 0xb9, 0x7f, 0x10, 0x78, 0x0a, 0xbe, 0xa6,
+	0xaa, 0xb1, 0xc9, 0xa2, 0x39, 0x64, 0xf6, 0x95, 0x8d, 0x4b, 0x2c, 0xc7, 0x6f, 0x20, 0x50, 0x9a,
+	0x6a, 0x66, 0x03, 0xbe, 0x9a, 0x47, 0xae, 0xa1, 0x30, 0x88, 0x38, 0xc7, 0x2c, 0x97, 0x8c, 0x2a,
+	0xb1, 0xb7, 0xb1, 0x86, 0xe4, 0xa8, 0x92, 0x73, 0x08, 0x16, 0x8f, 0x94, 0xef, 0x92, 0x15, 0xbc,
+	0x2c, 0x0e, 0x1b, 0x33, 0x6e, 0x21, 0x76, 0x3b, 0xae, 0xf1, 0x15, 0x44, 0xca, 0x81, 0xaf, 0x0d,
+	0xeb, 0xdc, 0x55, 0x08, 0x1c, 0xd1, 0x27, 0xd6, 0xe1, 0x4b, 0x18, 0x96, 0xb6, 0x55, 0xb3, 0xca,
+	0x6e, 0x0e, 0xc9, 0x09, 0x24, 0x29, 0x84, 0xf7, 0x4c, 0xe9, 0x5b, 0x73, 0x95, 0x4b, 0x18, 0xb6,
+	0x52, 0x94, 0x4c, 0x29, 0xe6, 0xce, 0x1b, 0x92, 0x13, 0x78, 0x9b, 0x41, 0x60, 0xa3, 0xe2, 0x08,
+	0xce, 0xc9, 0x7a, 0xb5, 0x5a, 0xae, 0x3e, 0x8e, 0x5e, 0x18, 0x51, 0xac, 0x17, 0x8b, 0xbb, 0xa2,
+	0x18, 0x21, 0x23, 0x3e, 0x5c, 0x2f, 0x3f, 0xaf, 0xc9, 0xdd, 0xc8, 0xbb, 0x79, 0xff, 0xa3, 0x9f,
+	0xa2, 0x9f, 0xfd, 0x14, 0xfd, 0xee, 0xa7, 0xe8, 0xcb, 0xbc, 0xe6, 0x7a, 0x7b, 0xd8, 0x64, 0xa5,
+	0xd8, 0xe5, 0x2d, 0x2d, 0xb7, 0x5d, 0xc5, 0xe4, 0xff, 0x95, 0x92, 0x65, 0xfe, 0xec, 0x97, 0xd9,
+	0x0c, 0xec, 0x97, 0x7b, 0xf7, 0x67, 0x00, 0x56, 0x03, 0x84, 0xc4, 0x4c, 0x02, 0x00, 0x00,
 }
 
 func (m *Task) Marshal() (dAtA []byte, err error) {
@@ -315,6 +404,23 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintWork(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Weight != 0 {
+		i = encodeVarintWork(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Priority != 0 {
+		i = encodeVarintWork(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -415,6 +521,50 @@ func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubtaskCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubtaskCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubtaskCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Committed {
+		i--
+		if m.Committed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SubtaskKey) > 0 {
+		i -= len(m.SubtaskKey)
+		copy(dAtA[i:], m.SubtaskKey)
+		i = encodeVarintWork(dAtA, i, uint64(len(m.SubtaskKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TestData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -477,6 +627,16 @@ func (m *Task) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovWork(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovWork(uint64(m.Priority))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovWork(uint64(m.Weight))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovWork(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -518,6 +678,25 @@ func (m *Claim) Size() (n int) {
 	return n
 }
 
+func (m *SubtaskCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubtaskKey)
+	if l > 0 {
+		n += 1 + l + sovWork(uint64(l))
+	}
+	if m.Committed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TestData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -636,6 +815,76 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWork(dAtA[iNdEx:])
@@ -847,6 +1096,109 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SubtaskCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubtaskCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubtaskCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtaskKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubtaskKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Committed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TestData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
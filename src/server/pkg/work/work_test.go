@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/testetcd"
 	"golang.org/x/sync/errgroup"
 )
@@ -75,11 +78,52 @@ func collectSubtask(subtaskInfo *TaskInfo, collected map[string]bool) error {
 	return nil
 }
 
+// testBackend creates task queues and workers that share a task store.
+type testBackend struct {
+	newTaskQueue func(ctx context.Context, taskNamespace string, opts ...TaskQueueOption) (*TaskQueue, error)
+	newWorker    func(taskNamespace string, opts ...WorkerOption) *Worker
+}
+
+// withBackends runs a test against each of the task store backends.
+func withBackends(t *testing.T, f func(*testing.T, *testBackend)) {
+	t.Run("Etcd", func(t *testing.T) {
+		require.NoError(t, testetcd.WithEnv(func(env *testetcd.Env) error {
+			f(t, &testBackend{
+				newTaskQueue: func(ctx context.Context, taskNamespace string, opts ...TaskQueueOption) (*TaskQueue, error) {
+					return NewTaskQueue(ctx, env.EtcdClient, "", taskNamespace, opts...)
+				},
+				newWorker: func(taskNamespace string, opts ...WorkerOption) *Worker {
+					return NewWorker(env.EtcdClient, "", taskNamespace, opts...)
+				},
+			})
+			return nil
+		}))
+	})
+	t.Run("Postgres", func(t *testing.T) {
+		defer func(ttl time.Duration) { claimTTL = ttl }(claimTTL)
+		claimTTL = 2 * time.Second
+		db := dbutil.NewTestDB(t)
+		tx := db.MustBegin()
+		tx.MustExec(`CREATE SCHEMA IF NOT EXISTS work`)
+		require.NoError(t, SetupPostgresStoreV0(context.Background(), tx))
+		require.NoError(t, SetupPostgresCommitsV0(context.Background(), tx))
+		require.NoError(t, tx.Commit())
+		f(t, &testBackend{
+			newTaskQueue: func(ctx context.Context, taskNamespace string, opts ...TaskQueueOption) (*TaskQueue, error) {
+				return NewPostgresTaskQueue(ctx, db, taskNamespace, opts...)
+			},
+			newWorker: func(taskNamespace string, opts ...WorkerOption) *Worker {
+				return NewPostgresWorker(db, taskNamespace, opts...)
+			},
+		})
+	})
+}
+
 func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64) {
 	seed := time.Now().UTC().UnixNano()
 	rand.Seed(seed)
 	msg := seedStr(seed)
-	require.NoError(t, testetcd.WithEnv(func(env *testetcd.Env) error {
+	withBackends(t, func(t *testing.T, b *testBackend) {
 		numTasks := 10
 		numSubtasks := 10
 		numWorkers := 5
@@ -88,7 +132,7 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 		workerEg, errCtx := errgroup.WithContext(workerCtx)
 		for i := 0; i < numWorkers; i++ {
 			workerEg.Go(func() error {
-				w := NewWorker(env.EtcdClient, "", "")
+				w := b.newWorker("")
 				for {
 					ctx, cancel := context.WithCancel(errCtx)
 					if err := w.Run(ctx, func(_ context.Context, subtask *Task) error {
@@ -115,7 +159,7 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 				}
 			})
 		}
-		tq, err := NewTaskQueue(errCtx, env.EtcdClient, "", "")
+		tq, err := b.newTaskQueue(errCtx, "")
 		require.NoError(t, err)
 		taskMapsFunc := func() []map[string]bool {
 			var taskMaps []map[string]bool
@@ -163,8 +207,7 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 		workerCancel()
 		require.NoError(t, workerEg.Wait(), msg)
 		require.Equal(t, created, collected, msg)
-		return nil
-	}), msg)
+	})
 }
 
 func TestBasic(t *testing.T) {
@@ -188,15 +231,443 @@ func TestEverything(t *testing.T) {
 }
 
 func TestRunZeroSubtasks(t *testing.T) {
-	require.NoError(t, testetcd.WithEnv(func(env *testetcd.Env) error {
-		tq, err := NewTaskQueue(context.Background(), env.EtcdClient, "", "")
-		if err != nil {
-			return err
-		}
-		return tq.RunTaskBlock(context.Background(), func(m *Master) error {
+	withBackends(t, func(t *testing.T, b *testBackend) {
+		tq, err := b.newTaskQueue(context.Background(), "")
+		require.NoError(t, err)
+		require.NoError(t, tq.RunTaskBlock(context.Background(), func(m *Master) error {
 			return m.RunSubtasks(nil, func(_ context.Context, _ *TaskInfo) error {
 				return nil
 			})
+		}))
+	})
+}
+
+func TestValidateBackend(t *testing.T) {
+	require.NoError(t, ValidateBackend(""))
+	require.NoError(t, ValidateBackend(EtcdBackend))
+	require.NoError(t, ValidateBackend(PostgresBackend))
+	require.YesError(t, ValidateBackend("mysql"))
+}
+
+func TestSpeculation(t *testing.T) {
+	defer func(interval time.Duration) { speculationInterval = interval }(speculationInterval)
+	speculationInterval = 50 * time.Millisecond
+	withBackends(t, func(t *testing.T, b *testBackend) {
+		numSubtasks := 8
+		numWorkers := 2
+		// The first attempt at subtask 0 straggles until it is canceled.
+		var straggled int64
+		workerCtx, workerCancel := context.WithCancel(context.Background())
+		workerEg, errCtx := errgroup.WithContext(workerCtx)
+		for i := 0; i < numWorkers; i++ {
+			workerEg.Go(func() error {
+				w := b.newWorker("")
+				err := w.Run(errCtx, func(ctx context.Context, subtask *Task) error {
+					if subtask.ID == "0" && atomic.CompareAndSwapInt64(&straggled, 0, 1) {
+						<-ctx.Done()
+						return ctx.Err()
+					}
+					time.Sleep(10 * time.Millisecond)
+					return processSubtask(t, subtask)
+				})
+				if errors.Is(workerCtx.Err(), context.Canceled) {
+					return nil
+				}
+				return err
+			})
+		}
+		tq, err := b.newTaskQueue(errCtx, "")
+		require.NoError(t, err)
+		var mu sync.Mutex
+		collected := make(map[string]int)
+		require.NoError(t, tq.RunTaskBlock(errCtx, func(m *Master) error {
+			var subtasks []*Task
+			for i := 0; i < numSubtasks; i++ {
+				data, err := serializeTestData(&TestData{})
+				if err != nil {
+					return err
+				}
+				subtasks = append(subtasks, &Task{
+					ID:   strconv.Itoa(i),
+					Data: data,
+				})
+			}
+			return m.RunSubtasks(subtasks, func(_ context.Context, subtaskInfo *TaskInfo) error {
+				if subtaskInfo.State != State_SUCCESS {
+					return errors.Errorf("subtask %v did not succeed", subtaskInfo.Task.ID)
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				collected[subtaskInfo.Task.ID]++
+				return nil
+			}, WithSpeculation())
+		}))
+		workerCancel()
+		require.NoError(t, workerEg.Wait())
+		require.Equal(t, int64(1), atomic.LoadInt64(&straggled))
+		require.Equal(t, numSubtasks, len(collected))
+		for ID, n := range collected {
+			require.Equal(t, 1, n, "subtask %v", ID)
+		}
+	})
+}
+
+func TestSpeculationCommit(t *testing.T) {
+	defer func(interval time.Duration) { speculationInterval = interval }(speculationInterval)
+	speculationInterval = 50 * time.Millisecond
+	withBackends(t, func(t *testing.T, b *testBackend) {
+		numSubtasks := 8
+		numWorkers := 2
+		// The first attempt at subtask 0 commits, then straggles until the
+		// speculative copy of it fails to commit.
+		var straggled int64
+		lost := make(chan struct{})
+		var lostOnce sync.Once
+		var mu sync.Mutex
+		commits := make(map[string]int)
+		workerCtx, workerCancel := context.WithCancel(context.Background())
+		workerEg, errCtx := errgroup.WithContext(workerCtx)
+		for i := 0; i < numWorkers; i++ {
+			workerEg.Go(func() error {
+				w := b.newWorker("")
+				err := w.Run(errCtx, func(ctx context.Context, subtask *Task) error {
+					if err := CommitSubtask(ctx); err != nil {
+						if errors.Is(err, ErrSubtaskCommitted) {
+							lostOnce.Do(func() { close(lost) })
+						}
+						return err
+					}
+					mu.Lock()
+					commits[subtask.ID]++
+					mu.Unlock()
+					if subtask.ID == "0" && atomic.CompareAndSwapInt64(&straggled, 0, 1) {
+						select {
+						case <-lost:
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+					time.Sleep(10 * time.Millisecond)
+					return processSubtask(t, subtask)
+				})
+				if errors.Is(workerCtx.Err(), context.Canceled) {
+					return nil
+				}
+				return err
+			})
+		}
+		tq, err := b.newTaskQueue(errCtx, "")
+		require.NoError(t, err)
+		collected := make(map[string]int)
+		require.NoError(t, tq.RunTaskBlock(errCtx, func(m *Master) error {
+			var subtasks []*Task
+			for i := 0; i < numSubtasks; i++ {
+				data, err := serializeTestData(&TestData{})
+				if err != nil {
+					return err
+				}
+				subtasks = append(subtasks, &Task{
+					ID:   strconv.Itoa(i),
+					Data: data,
+				})
+			}
+			return m.RunSubtasks(subtasks, func(_ context.Context, subtaskInfo *TaskInfo) error {
+				if subtaskInfo.State != State_SUCCESS {
+					return errors.Errorf("subtask %v did not succeed", subtaskInfo.Task.ID)
+				}
+				collected[subtaskInfo.Task.ID]++
+				return nil
+			}, WithSpeculation())
+		}))
+		workerCancel()
+		require.NoError(t, workerEg.Wait())
+		select {
+		case <-lost:
+		default:
+			t.Fatal("speculative copy of subtask 0 should have failed to commit")
+		}
+		require.Equal(t, numSubtasks, len(collected))
+		for ID, n := range collected {
+			require.Equal(t, 1, n, "subtask %v", ID)
+			require.Equal(t, 1, commits[ID], "subtask %v", ID)
+		}
+	})
+}
+
+func TestSpeculationCommitFailure(t *testing.T) {
+	defer func(interval time.Duration) { speculationInterval = interval }(speculationInterval)
+	speculationInterval = 50 * time.Millisecond
+	withBackends(t, func(t *testing.T, b *testBackend) {
+		numSubtasks := 8
+		numWorkers := 2
+		// The first attempt at subtask 0 prepares to commit, then fails once
+		// the speculative copy of it is waiting to prepare.
+		var attempts int64
+		waiting := make(chan struct{})
+		var failed int64
+		var mu sync.Mutex
+		commits := make(map[string]int)
+		workerCtx, workerCancel := context.WithCancel(context.Background())
+		workerEg, errCtx := errgroup.WithContext(workerCtx)
+		for i := 0; i < numWorkers; i++ {
+			workerEg.Go(func() error {
+				w := b.newWorker("")
+				err := w.Run(errCtx, func(ctx context.Context, subtask *Task) error {
+					var attempt int64
+					if subtask.ID == "0" {
+						attempt = atomic.AddInt64(&attempts, 1)
+					}
+					switch attempt {
+					case 1:
+						if err := PrepareCommit(ctx); err != nil {
+							return err
+						}
+						select {
+						case <-waiting:
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+						atomic.StoreInt64(&failed, 1)
+						return errors.Errorf("upload failed")
+					case 2:
+						prepareCtx, cancel := context.WithTimeout(ctx, 3*commitInterval)
+						defer cancel()
+						if err := PrepareCommit(prepareCtx); !errors.Is(err, context.DeadlineExceeded) {
+							return errors.Errorf("speculative copy should wait to prepare, got: %v", err)
+						}
+						close(waiting)
+					}
+					if err := PrepareCommit(ctx); err != nil {
+						return err
+					}
+					time.Sleep(10 * time.Millisecond)
+					if err := processSubtask(t, subtask); err != nil {
+						return err
+					}
+					if err := CommitSubtask(ctx); err != nil {
+						return err
+					}
+					mu.Lock()
+					commits[subtask.ID]++
+					mu.Unlock()
+					return nil
+				})
+				if errors.Is(workerCtx.Err(), context.Canceled) {
+					return nil
+				}
+				return err
+			})
+		}
+		tq, err := b.newTaskQueue(errCtx, "")
+		require.NoError(t, err)
+		collected := make(map[string]int)
+		require.NoError(t, tq.RunTaskBlock(errCtx, func(m *Master) error {
+			var subtasks []*Task
+			for i := 0; i < numSubtasks; i++ {
+				data, err := serializeTestData(&TestData{})
+				if err != nil {
+					return err
+				}
+				subtasks = append(subtasks, &Task{
+					ID:   strconv.Itoa(i),
+					Data: data,
+				})
+			}
+			return m.RunSubtasks(subtasks, func(_ context.Context, subtaskInfo *TaskInfo) error {
+				if subtaskInfo.State != State_SUCCESS {
+					return errors.Errorf("subtask %v did not succeed: %v", subtaskInfo.Task.ID, subtaskInfo.Reason)
+				}
+				collected[subtaskInfo.Task.ID]++
+				return nil
+			}, WithSpeculation())
+		}))
+		workerCancel()
+		require.NoError(t, workerEg.Wait())
+		require.Equal(t, int64(1), atomic.LoadInt64(&failed))
+		require.Equal(t, int64(2), atomic.LoadInt64(&attempts))
+		require.Equal(t, numSubtasks, len(collected))
+		for ID, n := range collected {
+			require.Equal(t, 1, n, "subtask %v", ID)
+			require.Equal(t, 1, commits[ID], "subtask %v", ID)
+		}
+	})
+}
+
+func TestSharedSchedulerPriority(t *testing.T) {
+	require.NoError(t, testetcd.WithEnv(func(env *testetcd.Env) error {
+		numSubtasks := 3
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s := NewScheduler(ctx)
+		// The first low priority subtask blocks the scheduler until the rest of
+		// the subtasks are queued.
+		started, release := make(chan struct{}), make(chan struct{})
+		var mu sync.Mutex
+		var order []string
+		workerEg, errCtx := errgroup.WithContext(ctx)
+		var workers []*Worker
+		for _, namespace := range []string{"low", "high"} {
+			w := NewWorker(env.EtcdClient, "", namespace, WithScheduler(s))
+			workers = append(workers, w)
+			workerEg.Go(func() error {
+				err := w.Run(errCtx, func(_ context.Context, subtask *Task) error {
+					if subtask.ID == "low-0" {
+						close(started)
+						<-release
+					}
+					mu.Lock()
+					defer mu.Unlock()
+					order = append(order, subtask.ID)
+					return nil
+				})
+				if errors.Is(ctx.Err(), context.Canceled) {
+					return nil
+				}
+				return err
+			})
+		}
+		runTask := func(namespace string, priority int64) error {
+			tq, err := NewTaskQueue(errCtx, env.EtcdClient, "", namespace, WithPriority(priority, 1))
+			if err != nil {
+				return err
+			}
+			return tq.RunTaskBlock(errCtx, func(m *Master) error {
+				var subtasks []*Task
+				for i := 0; i < numSubtasks; i++ {
+					subtasks = append(subtasks, &Task{ID: fmt.Sprintf("%v-%v", namespace, i)})
+				}
+				return m.RunSubtasks(subtasks, nil)
+			})
+		}
+		var taskEg errgroup.Group
+		taskEg.Go(func() error {
+			return runTask("low", 0)
+		})
+		<-started
+		taskEg.Go(func() error {
+			return runTask("high", 1)
+		})
+		// The subtasks are queued by the workers as they are created, so all of
+		// the subtasks need to be created before they can all be queued.
+		require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+			for _, w := range workers {
+				n, err := w.store.(*etcdStore).subtaskCol.ReadOnly(ctx).Count()
+				if err != nil {
+					return err
+				}
+				if n != int64(numSubtasks) {
+					return errors.Errorf("subtasks not created in %v", w.taskNamespace)
+				}
+			}
+			depth := s.QueueDepth()
+			if depth[0] < int64(numSubtasks-1) || depth[1] < int64(numSubtasks-1) {
+				return errors.Errorf("subtasks not queued: %v", depth)
+			}
+			return nil
 		})
+		close(release)
+		require.NoError(t, taskEg.Wait())
+		cancel()
+		require.NoError(t, workerEg.Wait())
+		require.Equal(t, []string{"low-0", "high-0", "high-1", "high-2", "low-1", "low-2"}, order)
+		return nil
 	}))
 }
+
+func TestSharedSchedulerWeight(t *testing.T) {
+	require.NoError(t, testetcd.WithEnv(func(env *testetcd.Env) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s := NewScheduler(ctx)
+		var workers []*Worker
+		for _, namespace := range []string{"light", "heavy"} {
+			workers = append(workers, NewWorker(env.EtcdClient, "", namespace, WithScheduler(s)))
+		}
+		testSchedulerWeight(t, ctx, s, workers, func(ctx context.Context, name string, weight int64) (*TaskQueue, error) {
+			return NewTaskQueue(ctx, env.EtcdClient, "", name, WithPriority(0, weight))
+		})
+		return nil
+	}))
+}
+
+func TestGroupWeight(t *testing.T) {
+	require.NoError(t, testetcd.WithEnv(func(env *testetcd.Env) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		// The task queues share a namespace (like the storage task queues), so
+		// they need different groups to share the worker by weight.
+		s := NewScheduler(ctx)
+		workers := []*Worker{NewWorker(env.EtcdClient, "", "storage", WithScheduler(s))}
+		testSchedulerWeight(t, ctx, s, workers, func(ctx context.Context, name string, weight int64) (*TaskQueue, error) {
+			return NewTaskQueue(ctx, env.EtcdClient, "", "storage", WithPriority(0, weight), WithGroup(name))
+		})
+		return nil
+	}))
+}
+
+// testSchedulerWeight runs a task with three subtasks from a "light" task
+// queue with a weight of one, and a task with four subtasks from a "heavy"
+// task queue with a weight of two, then checks that the workers ran twice as
+// many heavy subtasks as light subtasks while both had subtasks waiting.
+func testSchedulerWeight(t *testing.T, ctx context.Context, s *Scheduler, workers []*Worker, newTaskQueue func(context.Context, string, int64) (*TaskQueue, error)) {
+	// The first light subtask blocks the scheduler until the rest of the
+	// subtasks are queued.
+	started, release := make(chan struct{}), make(chan struct{})
+	var mu sync.Mutex
+	var order []string
+	workerCtx, workerCancel := context.WithCancel(ctx)
+	workerEg, errCtx := errgroup.WithContext(workerCtx)
+	for _, w := range workers {
+		w := w
+		workerEg.Go(func() error {
+			err := w.Run(errCtx, func(_ context.Context, subtask *Task) error {
+				if subtask.ID == "light-0" {
+					close(started)
+					<-release
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				order = append(order, subtask.ID)
+				return nil
+			})
+			if errors.Is(workerCtx.Err(), context.Canceled) {
+				return nil
+			}
+			return err
+		})
+	}
+	runTask := func(name string, weight int64, numSubtasks int) error {
+		tq, err := newTaskQueue(errCtx, name, weight)
+		if err != nil {
+			return err
+		}
+		return tq.RunTaskBlock(errCtx, func(m *Master) error {
+			var subtasks []*Task
+			for i := 0; i < numSubtasks; i++ {
+				subtasks = append(subtasks, &Task{ID: fmt.Sprintf("%v-%v", name, i)})
+			}
+			return m.RunSubtasks(subtasks, nil)
+		})
+	}
+	var taskEg errgroup.Group
+	taskEg.Go(func() error {
+		return runTask("light", 1, 3)
+	})
+	<-started
+	taskEg.Go(func() error {
+		return runTask("heavy", 2, 4)
+	})
+	// A task entry holds at most two waiting subtasks (one buffered and one
+	// being sent), so both tasks have subtasks waiting once the depth is four.
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		if depth := s.QueueDepth(); depth[0] != 4 {
+			return errors.Errorf("subtasks not queued: %v", depth)
+		}
+		return nil
+	})
+	close(release)
+	require.NoError(t, taskEg.Wait())
+	workerCancel()
+	require.NoError(t, workerEg.Wait())
+	require.Equal(t, []string{"light-0", "heavy-0", "heavy-1", "light-1", "heavy-2", "heavy-3", "light-2"}, order)
+}
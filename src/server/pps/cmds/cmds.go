@@ -375,10 +375,26 @@ each datum.`,
 	}
 	commands = append(commands, cmdutil.CreateAlias(restartDatum, "restart datum"))
 
+	var pipelineInputPath string
+	var pageSize int64
+	var page int64
 	listDatum := &cobra.Command{
 		Use:   "{{alias}} <job>",
 		Short: "Return the datums in a job.",
-		Long:  "Return the datums in a job.",
+		Long: `Return the datums in a job.
+
+With --file (-f), the datums that the pipeline in the file would process are
+returned instead, without creating the pipeline or a job, followed by the total
+number of datums in all pages.`,
+		Example: `
+# Return the datums in job 5f93d03b65fa421996185e53f7f8b1e4:
+$ {{alias}} 5f93d03b65fa421996185e53f7f8b1e4
+
+# Preview the datums of the pipeline in pipeline.json:
+$ {{alias}} -f pipeline.json
+
+# Return the second page of 100 datums of the pipeline in pipeline.json:
+$ {{alias}} -f pipeline.json --page-size 100 --page 1`,
 		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) (retErr error) {
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
@@ -386,32 +402,62 @@ each datum.`,
 			}
 			defer client.Close()
 			var printF func(*ppsclient.DatumInfo) error
+			var writer *tabwriter.Writer
 			if !raw {
 				if output != "" {
 					cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
 				}
-				writer := tabwriter.NewWriter(os.Stdout, pretty.DatumHeader)
+				writer = tabwriter.NewWriter(os.Stdout, pretty.DatumHeader)
 				printF = func(di *ppsclient.DatumInfo) error {
 					pretty.PrintDatumInfo(writer, di)
 					return nil
 				}
-				defer func() {
-					if err := writer.Flush(); retErr == nil {
-						retErr = err
-					}
-				}()
 			} else {
 				e := encoder(output)
 				printF = func(di *ppsclient.DatumInfo) error {
 					return e.EncodeProto(di)
 				}
 			}
+			if pipelineInputPath != "" {
+				if len(args) != 0 {
+					return errors.Errorf("cannot specify a job with --file (-f)")
+				}
+				pipelineReader, err := ppsutil.NewPipelineManifestReader(pipelineInputPath)
+				if err != nil {
+					return err
+				}
+				request, err := pipelineReader.NextCreatePipelineRequest()
+				if err != nil {
+					return err
+				}
+				total, err := client.ListDatumInput(request.Input, pageSize, page, printF)
+				if err != nil {
+					return err
+				}
+				if writer != nil {
+					if err := writer.Flush(); err != nil {
+						return err
+					}
+					fmt.Printf("total datums: %d\n", total)
+				}
+				return nil
+			}
 			if len(args) != 1 {
 				return errors.Errorf("must specify one job")
 			}
+			if writer != nil {
+				defer func() {
+					if err := writer.Flush(); retErr == nil {
+						retErr = err
+					}
+				}()
+			}
 			return client.ListDatum(args[0], printF)
 		}),
 	}
+	listDatum.Flags().StringVarP(&pipelineInputPath, "file", "f", "", "The JSON file containing the pipeline to list the datums of, it can be a url or local file. - reads from stdin.")
+	listDatum.Flags().Int64Var(&pageSize, "page-size", 0, "The number of datums in a page, used with --file (-f). All datums are returned if it is 0.")
+	listDatum.Flags().Int64Var(&page, "page", 0, "The page of datums to return (counting from 0), used with --file (-f).")
 	listDatum.Flags().AddFlagSet(outputFlags)
 	shell.RegisterCompletionFunc(listDatum, shell.JobCompletion)
 	commands = append(commands, cmdutil.CreateAlias(listDatum, "list datum"))
@@ -8,6 +8,7 @@ import (
 	"math"
 	"path"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/lokiutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	ppath "github.com/pachyderm/pachyderm/src/server/pkg/path"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
@@ -1032,9 +1034,96 @@ func (a *apiServer) ListDatum(request *pps.ListDatumRequest, server pps.API_List
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 	// TODO: Auth?
-	return a.collectDatums(server.Context(), request.Job, func(meta *datum.Meta, _ *pfs.File) error {
-		return server.Send(convertDatumMetaToInfo(meta))
+	if (request.Job == nil) == (request.Input == nil) {
+		return errors.Errorf("exactly one of job or input must be set")
+	}
+	if request.PageSize < 0 || request.Page < 0 {
+		return errors.Errorf("page_size and page cannot be negative")
+	}
+	// The datums outside of the page are counted, but not sent, so that the
+	// total can be returned in the trailer.
+	var n int64
+	send := func(di *pps.DatumInfo) error {
+		i := n
+		n++
+		if request.PageSize > 0 && (i < request.Page*request.PageSize || i >= (request.Page+1)*request.PageSize) {
+			return nil
+		}
+		return server.Send(di)
+	}
+	var err error
+	if request.Input != nil {
+		err = a.listDatumInput(server.Context(), request.Input, func(meta *datum.Meta) error {
+			di := convertDatumMetaToInfo(meta)
+			di.State = pps.DatumState_STARTING
+			return send(di)
+		})
+	} else {
+		err = a.collectDatums(server.Context(), request.Job, func(meta *datum.Meta, _ *pfs.File) error {
+			return send(convertDatumMetaToInfo(meta))
+		})
+	}
+	if err != nil {
+		return err
+	}
+	server.SetTrailer(metadata.Pairs(pps.ListDatumTotalKey, strconv.FormatInt(n, 10)))
+	return nil
+}
+
+// listDatumInput iterates over the datums that a pipeline with input would
+// process, on the current heads of its input branches. Inputs that don't name
+// a commit are resolved to the head of their branch (the trigger branch for
+// triggered inputs), inputs on branches without a head have no datums.
+func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb func(*datum.Meta) error) error {
+	pachClient := a.env.GetPachClient(ctx)
+	resolveHead := func(repo, branch string) (string, error) {
+		branchInfo, err := pachClient.InspectBranch(repo, branch)
+		if err != nil {
+			return "", err
+		}
+		if branchInfo.Head == nil {
+			return "", nil
+		}
+		return branchInfo.Head.ID, nil
+	}
+	var visitErr error
+	pps.VisitInput(input, func(input *pps.Input) {
+		if visitErr != nil {
+			return
+		}
+		switch {
+		case input.Pfs != nil:
+			if input.Pfs.Name == "" {
+				input.Pfs.Name = input.Pfs.Repo
+			}
+			if input.Pfs.Commit != "" {
+				return
+			}
+			branch := input.Pfs.Branch
+			if input.Pfs.Trigger != nil {
+				branch = input.Pfs.Trigger.Branch
+			}
+			if branch == "" {
+				branch = "master"
+			}
+			input.Pfs.Commit, visitErr = resolveHead(input.Pfs.Repo, branch)
+		case input.Cron != nil:
+			// The cron repo is named after the pipeline, so it is only known
+			// if it is set explicitly.
+			if input.Cron.Repo == "" || input.Cron.Commit != "" {
+				return
+			}
+			input.Cron.Commit, visitErr = resolveHead(input.Cron.Repo, "master")
+		}
 	})
+	if visitErr != nil {
+		return visitErr
+	}
+	di, err := datum.NewIterator(pachClient, input)
+	if err != nil {
+		return err
+	}
+	return di.Iterate(cb)
 }
 
 func convertDatumMetaToInfo(meta *datum.Meta) *pps.DatumInfo {
@@ -1417,6 +1506,22 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 	if request.S3Out && ((request.Service != nil) || (request.Spout != nil)) {
 		return errors.New("s3 output is not supported in spouts or services")
 	}
+	if request.Speculative && request.S3Out {
+		// Output written through the s3 gateway can't be discarded when a
+		// speculative copy of a datum set loses.
+		return errors.New("speculative execution is not supported with s3 output")
+	}
+	if request.PrioritySpec != nil && request.PrioritySpec.Weight < 0 {
+		return errors.Errorf("priority weight (%d) cannot be negative", request.PrioritySpec.Weight)
+	}
+	if request.Egress != nil {
+		if (request.Service != nil) || (request.Spout != nil) {
+			return errors.New("egress is not supported in spouts or services")
+		}
+		if _, err := obj.ParseURL(request.Egress.URL); err != nil {
+			return errors.Wrapf(err, "invalid egress URL")
+		}
+	}
 	if request.S3Out && request.EnableStats {
 		return errors.New("stats are not supported for pipelines that output via Pachyderm's S3 gateway")
 	}
@@ -1431,34 +1536,14 @@ func (a *apiServer) validateV2Features(request *pps.CreatePipelineRequest) (*pps
 	if request.TFJob != nil {
 		return nil, errors.Errorf("TFJob not implemented")
 	}
-	if request.Egress != nil {
-		return nil, errors.Errorf("Egress not implemented")
-	}
-	if request.S3Out {
-		return nil, errors.Errorf("S3Out not implemented")
-	}
-	var err error
-	pps.VisitInput(request.Input, func(input *pps.Input) {
-		if input.Join != nil {
-			err = errors.Errorf("Join input not implemented")
-		}
-	})
-	if err != nil {
-		return nil, err
-	}
-	if request.CacheSize != "" {
-		return nil, errors.Errorf("CacheSize not implemented")
+	// Spouts and services do not process datums, so there is no meta output to
+	// store in a stats branch.
+	if request.Spout == nil && request.Service == nil {
+		request.EnableStats = true
 	}
-	request.EnableStats = true
 	if request.MaxQueueSize != 0 {
 		return nil, errors.Errorf("MaxQueueSize not implemented")
 	}
-	if request.Service != nil {
-		return nil, errors.Errorf("Service not implemented")
-	}
-	if request.Spout != nil {
-		return nil, errors.Errorf("Spout not implemented")
-	}
 	return request, nil
 }
 
@@ -1893,10 +1978,12 @@ func (a *apiServer) CreatePipelineInTransaction(txnCtx *txnenv.TransactionContex
 		Standby:               request.Standby,
 		DatumTries:            request.DatumTries,
 		SchedulingSpec:        request.SchedulingSpec,
+		PrioritySpec:          request.PrioritySpec,
 		PodSpec:               request.PodSpec,
 		PodPatch:              request.PodPatch,
 		S3Out:                 request.S3Out,
 		Metadata:              request.Metadata,
+		Speculative:           request.Speculative,
 	}
 	if err := setPipelineDefaults(pipelineInfo); err != nil {
 		return err
@@ -2471,7 +2558,7 @@ func (a *apiServer) inspectPipelineInTransaction(txnCtx *txnenv.TransactionConte
 		if err != nil {
 			return nil, err
 		}
-		service, err := kubeClient.CoreV1().Services(a.namespace).Get(fmt.Sprintf("%s-user", rcName), metav1.GetOptions{})
+		service, err := kubeClient.CoreV1().Services(a.namespace).Get(userServiceName(rcName), metav1.GetOptions{})
 		if err != nil {
 			if !isNotFoundErr(err) {
 				return nil, err
@@ -162,6 +162,9 @@ func (op *pipelineOp) run() error {
 
 		op.stopCrashingPipelineMonitor()
 		op.startPipelineMonitor()
+		if err := op.ensureUserService(); err != nil {
+			return err
+		}
 		// default: scale up if pipeline start hasn't propagated to etcd yet
 		// Note: mostly this should do nothing, as this runs several times per job
 		return op.scaleUpPipeline()
@@ -214,6 +217,9 @@ func (op *pipelineOp) run() error {
 		// start a monitor to poll k8s and update us when it goes into a running state
 		op.startCrashingPipelineMonitor()
 		op.startPipelineMonitor()
+		if err := op.ensureUserService(); err != nil {
+			return err
+		}
 		// Surprisingly, scaleUpPipeline() is necessary, in case a pipelines is
 		// quickly transitioned to CRASHING after coming out of STANDBY. Because the
 		// pipeline controller reads the current state of the pipeline after each
@@ -431,6 +437,54 @@ func (op *pipelineOp) createPipelineResources() error {
 	})
 }
 
+// ensureUserService makes sure that, if op's pipeline has a service, the
+// kubernetes service exposing the user code exists and maps the service's
+// external_port to its internal_port. The service is created along with the
+// RC, but it may have been deleted or left with stale ports (creation ignores
+// services that already exist).
+//
+// Like setPipelineState, ensureUserService handles its own retries, and returns
+// an error if it can't eventually update the service.
+func (op *pipelineOp) ensureUserService() error {
+	service, err := getUserService(op.pipelineInfo)
+	if err != nil {
+		return op.failPipeline(fmt.Sprintf("could not get pipeline service: %v", err))
+	}
+	if service == nil || op.rc == nil {
+		return nil
+	}
+	expected := newUserService(op.rc.ObjectMeta.Name, op.rc.ObjectMeta.Labels, op.rc.ObjectMeta.Annotations, service)
+	services := op.m.a.env.GetKubeClient().CoreV1().Services(op.m.a.namespace)
+	var errCount int
+	return backoff.RetryNotify(func() error {
+		existing, err := services.Get(expected.ObjectMeta.Name, metav1.GetOptions{})
+		if err != nil {
+			if !isNotFoundErr(err) {
+				return err
+			}
+			log.Infof("PPS master: creating user service for pipeline %q", op.name)
+			if _, err := services.Create(expected); err != nil && !isAlreadyExistsErr(err) {
+				return err
+			}
+			return nil
+		}
+		if userServiceIsFresh(existing, expected) {
+			return nil
+		}
+		log.Infof("PPS master: updating ports of user service for pipeline %q", op.name)
+		existing.Spec.Type = expected.Spec.Type
+		existing.Spec.Ports = expected.Spec.Ports
+		_, err = services.Update(existing)
+		return err
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		if errCount++; errCount >= maxErrCount {
+			return errors.Wrapf(err, "could not update user service for %q", op.name)
+		}
+		log.Errorf("PPS master: error updating user service for %q: %v; retrying in %v", op.name, err, d)
+		return nil
+	})
+}
+
 // startPipelineMonitor spawns a monitorPipeline() goro for this pipeline (if
 // one doesn't exist already), which manages standby and cron inputs, and
 // updates the the pipeline state.
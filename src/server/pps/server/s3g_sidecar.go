@@ -0,0 +1,300 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pfs/s3"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+)
+
+// sidecarS3G serves an s3 gateway for each running job of the sidecar's
+// pipeline. All of the gateways share the sidecar's S3GatewayPort, and
+// requests are routed to a job's gateway by the name of the job's k8s
+// service (see ppsutil.SidecarS3GatewayService), which user code reaches
+// through $S3_ENDPOINT. For pipelines with s3_out, requests to the
+// pipeline's k8s service (see ppsutil.SidecarS3GatewayPipelineService) are
+// routed by bucket name, where the bucket "<job id>.out" is the output commit
+// of that job.
+type sidecarS3G struct {
+	apiServer    *apiServer
+	pipelineInfo *pps.PipelineInfo
+	pachClient   *client.APIClient
+
+	mu             sync.Mutex
+	gateways       map[string]http.Handler // k8s service name -> job's gateway
+	outputGateways map[string]http.Handler // job ID -> job's output gateway
+}
+
+// ServeSidecarS3G serves the s3 gateway for the jobs of the sidecar's
+// pipeline, if the pipeline has s3 inputs or an s3 output. It never returns.
+func (a *apiServer) ServeSidecarS3G() {
+	s := &sidecarS3G{
+		apiServer:      a,
+		pipelineInfo:   &pps.PipelineInfo{},
+		pachClient:     a.env.GetPachClient(context.Background()),
+		gateways:       make(map[string]http.Handler),
+		outputGateways: make(map[string]http.Handler),
+	}
+	specCommit := a.env.PPSSpecCommitID
+	if specCommit == "" {
+		// This error is not recoverable.
+		panic("cannot serve sidecar S3 gateway if no spec commit is set")
+	}
+	if err := backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		pachClient := s.pachClient.WithCtx(ctx)
+		if err := a.sudo(pachClient, func(superUserClient *client.APIClient) error {
+			buf := &bytes.Buffer{}
+			if err := superUserClient.GetFile(ppsconsts.SpecRepo, specCommit, ppsconsts.SpecFile, buf); err != nil {
+				return errors.Wrapf(err, "could not read PipelineInfo from PFS")
+			}
+			if err := s.pipelineInfo.Unmarshal(buf.Bytes()); err != nil {
+				return errors.Wrapf(err, "could not unmarshal PipelineInfo bytes from PFS")
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		// The gateways act on behalf of the pipeline.
+		pipelinePtr := &pps.EtcdPipelineInfo{}
+		if err := a.pipelines.ReadOnly(ctx).Get(s.pipelineInfo.Pipeline.Name, pipelinePtr); err != nil {
+			return errors.Wrapf(err, "could not get auth token for pipeline %q", s.pipelineInfo.Pipeline.Name)
+		}
+		s.pachClient.SetAuthToken(pipelinePtr.AuthToken)
+		return nil
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Errorf("error loading pipeline info for sidecar s3 gateway: %v; retrying in %v", err, d)
+		return nil
+	}); err != nil {
+		panic(fmt.Sprintf("could not load pipeline info for sidecar s3 gateway: %v", err))
+	}
+	if !ppsutil.ContainsS3Inputs(s.pipelineInfo.Input) && !s.pipelineInfo.S3Out {
+		return
+	}
+	if s.pipelineInfo.S3Out {
+		// Every sidecar of the pipeline serves every job, so the service may
+		// have been created by another worker. The service selects the workers
+		// of every version of the pipeline, and is deleted along with the
+		// pipeline.
+		service := ppsutil.SidecarS3GatewayPipelineService(s.pipelineInfo.Pipeline.Name)
+		selector := map[string]string{
+			"suite":           suite,
+			"component":       "worker",
+			pipelineNameLabel: s.pipelineInfo.Pipeline.Name,
+		}
+		if err := backoff.RetryNotify(func() error {
+			return s.createService(service, selector)
+		}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+			log.Errorf("error creating service for sidecar s3 gateway: %v; retrying in %v", err, d)
+			return nil
+		}); err != nil {
+			panic(fmt.Sprintf("could not create service for sidecar s3 gateway: %v", err))
+		}
+	}
+	go s.watchJobs()
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", a.env.S3GatewayPort),
+		Handler: s,
+	}
+	backoff.RetryNotify(server.ListenAndServe, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Errorf("error serving sidecar s3 gateway: %v; retrying in %v", err, d)
+		return nil
+	})
+}
+
+func (s *sidecarS3G) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	service := strings.SplitN(host, ".", 2)[0]
+	var gateway http.Handler
+	var ok bool
+	s.mu.Lock()
+	if service == ppsutil.SidecarS3GatewayPipelineService(s.pipelineInfo.Pipeline.Name) {
+		bucket := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[0]
+		if jobID := strings.TrimSuffix(bucket, ".out"); jobID != bucket {
+			gateway, ok = s.outputGateways[jobID]
+		}
+	} else {
+		gateway, ok = s.gateways[service]
+	}
+	s.mu.Unlock()
+	if !ok {
+		http.Error(w, fmt.Sprintf("no running job is served at %q", r.Host), http.StatusNotFound)
+		return
+	}
+	gateway.ServeHTTP(w, r)
+}
+
+// watchJobs starts a gateway for each job of the pipeline when it starts,
+// and stops it when the job finishes.
+func (s *sidecarS3G) watchJobs() {
+	backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		watcher, err := s.apiServer.jobs.ReadOnly(ctx).WatchByIndex(ppsdb.JobsPipelineIndex, s.pipelineInfo.Pipeline)
+		if err != nil {
+			return errors.Wrapf(err, "error creating watch")
+		}
+		defer watcher.Close()
+		for e := range watcher.Watch() {
+			switch e.Type {
+			case watch.EventError:
+				return errors.Wrapf(e.Err, "job watch error")
+			case watch.EventDelete:
+				if err := s.stopJob(string(e.Key)); err != nil {
+					return err
+				}
+			case watch.EventPut:
+				var jobID string
+				jobPtr := &pps.EtcdJobInfo{}
+				if err := e.Unmarshal(&jobID, jobPtr); err != nil {
+					return err
+				}
+				if ppsutil.IsTerminal(jobPtr.State) {
+					if err := s.stopJob(jobID); err != nil {
+						return err
+					}
+					continue
+				}
+				if err := s.startJob(ctx, jobID); err != nil {
+					return err
+				}
+			}
+		}
+		return errors.New("job watch closed unexpectedly")
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Errorf("error watching jobs for sidecar s3 gateway: %v; retrying in %v", err, d)
+		return nil
+	})
+}
+
+// startJob creates the gateway and k8s service for a job. It is idempotent.
+func (s *sidecarS3G) startJob(ctx context.Context, jobID string) error {
+	service := ppsutil.SidecarS3GatewayService(jobID)
+	s.mu.Lock()
+	_, ok := s.gateways[service]
+	s.mu.Unlock()
+	if ok {
+		return nil
+	}
+	jobInfo, err := s.pachClient.WithCtx(ctx).InspectJob(jobID, false)
+	if err != nil {
+		return err
+	}
+	// Inputs are read from the job's input commits, and the output is written
+	// to the job's output commit, which is finished when the job finishes.
+	var inputBuckets []*s3.Bucket
+	pps.VisitInput(jobInfo.Input, func(input *pps.Input) {
+		if input.Pfs != nil && input.Pfs.S3 {
+			inputBuckets = append(inputBuckets, &s3.Bucket{
+				Name:   input.Pfs.Name,
+				Repo:   input.Pfs.Repo,
+				Commit: input.Pfs.Commit,
+			})
+		}
+	})
+	var outputBucket *s3.Bucket
+	var outputGateway http.Handler
+	if s.pipelineInfo.S3Out {
+		outputBucket = &s3.Bucket{
+			Name:   "out",
+			Repo:   jobInfo.OutputCommit.Repo.Name,
+			Commit: jobInfo.OutputCommit.ID,
+		}
+		outputGateway = s.router(nil, &s3.Bucket{
+			Name:   jobID + ".out",
+			Repo:   jobInfo.OutputCommit.Repo.Name,
+			Commit: jobInfo.OutputCommit.ID,
+		})
+	}
+	gateway := s.router(inputBuckets, outputBucket)
+	// Every sidecar of the pipeline serves every job, so the service may
+	// have been created by another worker.
+	rcName := ppsutil.PipelineRcName(s.pipelineInfo.Pipeline.Name, s.pipelineInfo.Version)
+	if err := s.createService(service, labels(rcName)); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gateways[service] = gateway
+	if outputGateway != nil {
+		s.outputGateways[jobID] = outputGateway
+	}
+	return nil
+}
+
+func (s *sidecarS3G) router(inputBuckets []*s3.Bucket, outputBucket *s3.Bucket) http.Handler {
+	return s3.Router(s3.NewWorkerDriver(inputBuckets, outputBucket), func() (*client.APIClient, error) {
+		return s.pachClient.WithCtx(context.Background()), nil
+	})
+}
+
+// createService creates a k8s service for the sidecar s3 gateway, which
+// selects the pods with the given labels. It is idempotent.
+func (s *sidecarS3G) createService(service string, selector map[string]string) error {
+	serviceLabels := labels(ppsutil.PipelineRcName(s.pipelineInfo.Pipeline.Name, s.pipelineInfo.Version))
+	serviceLabels[pipelineNameLabel] = s.pipelineInfo.Pipeline.Name
+	if _, err := s.apiServer.env.GetKubeClient().CoreV1().Services(s.apiServer.namespace).Create(&v1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   service,
+			Labels: serviceLabels,
+		},
+		Spec: v1.ServiceSpec{
+			Selector:  selector,
+			ClusterIP: "None",
+			Ports: []v1.ServicePort{
+				{
+					Port: int32(s.apiServer.env.S3GatewayPort),
+					Name: "s3-gateway-port",
+				},
+			},
+		},
+	}); err != nil && !isAlreadyExistsErr(err) {
+		return errors.Wrapf(err, "could not create service %q", service)
+	}
+	return nil
+}
+
+// stopJob removes the gateway and k8s service for a job. It is idempotent.
+func (s *sidecarS3G) stopJob(jobID string) error {
+	service := ppsutil.SidecarS3GatewayService(jobID)
+	s.mu.Lock()
+	_, ok := s.gateways[service]
+	delete(s.gateways, service)
+	delete(s.outputGateways, jobID)
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	if err := s.apiServer.env.GetKubeClient().CoreV1().Services(s.apiServer.namespace).Delete(service, &metav1.DeleteOptions{
+		OrphanDependents: &falseVal,
+	}); err != nil && !isNotFoundErr(err) {
+		return errors.Wrapf(err, "could not delete service %q", service)
+	}
+	return nil
+}
@@ -35,6 +35,10 @@ const (
 	pachVersionAnnotation     = "version"
 	specCommitAnnotation      = "specCommit"
 	hashedAuthTokenAnnotation = "authTokenHash"
+	// chunkCacheVolume and chunkCacheRoot are the volume and path for the
+	// sidecar's chunk cache.
+	chunkCacheVolume = "pach-chunk-cache"
+	chunkCacheRoot   = "/pach-chunk-cache"
 )
 
 // Parameters used when creating the kubernetes replication controller in charge
@@ -103,6 +107,12 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 	}, {
 		Name:  "PFS_CACHE_SIZE",
 		Value: "16",
+	}, {
+		Name:  "STORAGE_CHUNK_CACHE_ROOT",
+		Value: chunkCacheRoot,
+	}, {
+		Name:  "STORAGE_CHUNK_CACHE_SIZE",
+		Value: options.cacheSize,
 	}, {
 		Name:  "STORAGE_BACKEND",
 		Value: a.storageBackend,
@@ -217,6 +227,10 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "LOKI_LOGGING", Value: "true"})
 		workerEnv = append(workerEnv, v1.EnvVar{Name: "LOKI_LOGGING", Value: "true"})
 	}
+	if a.env.WorkBackend != "" {
+		sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "WORK_BACKEND", Value: a.env.WorkBackend})
+		workerEnv = append(workerEnv, v1.EnvVar{Name: "WORK_BACKEND", Value: a.env.WorkBackend})
+	}
 
 	// This only happens in local deployment.  We want the workers to be
 	// able to read from/write to the hostpath volume as well.
@@ -255,6 +269,22 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		sidecarVolumeMounts = append(sidecarVolumeMounts, emptyDirVolumeMount)
 		userVolumeMounts = append(userVolumeMounts, emptyDirVolumeMount)
 	}
+	// The sidecar's chunk cache is in an emptyDir volume, so it survives
+	// container restarts, but not the worker pod being deleted, rescheduled or
+	// recreated (for example, when the pipeline is updated). A hostPath volume
+	// isn't used because the worker pods of a pipeline that are scheduled on
+	// the same node would share it, and the cache can't be shared between
+	// processes.
+	options.volumes = append(options.volumes, v1.Volume{
+		Name: chunkCacheVolume,
+		VolumeSource: v1.VolumeSource{
+			EmptyDir: &v1.EmptyDirVolumeSource{},
+		},
+	})
+	sidecarVolumeMounts = append(sidecarVolumeMounts, v1.VolumeMount{
+		Name:      chunkCacheVolume,
+		MountPath: chunkCacheRoot,
+	})
 	secretVolume, secretMount := assets.GetBackendSecretVolumeAndMount(a.storageBackend)
 	options.volumes = append(options.volumes, secretVolume)
 	sidecarVolumeMounts = append(sidecarVolumeMounts, secretMount)
@@ -288,6 +318,14 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 			ContainerPort: options.s3GatewayPort,
 		})
 	}
+	// expose the user code's port in the user container for service pipelines
+	var userPorts []v1.ContainerPort
+	if options.service != nil {
+		userPorts = append(userPorts, v1.ContainerPort{
+			ContainerPort: options.service.InternalPort,
+			Name:          "user-port",
+		})
+	}
 	if !a.noExposeDockerSocket {
 		options.volumes = append(options.volumes, v1.Volume{
 			Name: "docker",
@@ -345,6 +383,7 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 					},
 				},
 				VolumeMounts: userVolumeMounts,
+				Ports:        userPorts,
 			},
 			{
 				Name:            client.PPSWorkerSidecarContainerName,
@@ -443,15 +482,22 @@ func getStorageEnvVars(pipelineInfo *pps.PipelineInfo) ([]v1.EnvVar, error) {
 	if !ok {
 		return nil, errors.Errorf("%s not found", assets.UploadConcurrencyLimitEnvVar)
 	}
+	envVars := []v1.EnvVar{
+		{Name: assets.UploadConcurrencyLimitEnvVar, Value: uploadConcurrencyLimit},
+	}
 	if pipelineInfo.Spout != nil {
-		return []v1.EnvVar{
-			{Name: assets.UploadConcurrencyLimitEnvVar, Value: uploadConcurrencyLimit},
-			{Name: "SPOUT_PIPELINE_NAME", Value: pipelineInfo.Pipeline.Name},
-		}, nil
+		envVars = append(envVars, v1.EnvVar{Name: "SPOUT_PIPELINE_NAME", Value: pipelineInfo.Pipeline.Name})
+	}
+	// The storage tasks of the pipeline's commits are scheduled by the
+	// pipeline's priority on the storage workers that all pipelines share.
+	if prioritySpec := pipelineInfo.PrioritySpec; prioritySpec != nil {
+		envVars = append(envVars,
+			v1.EnvVar{Name: "STORAGE_TASK_GROUP", Value: pipelineInfo.Pipeline.Name},
+			v1.EnvVar{Name: "STORAGE_TASK_PRIORITY", Value: strconv.FormatInt(prioritySpec.Level, 10)},
+			v1.EnvVar{Name: "STORAGE_TASK_WEIGHT", Value: strconv.FormatInt(prioritySpec.Weight, 10)},
+		)
 	}
-	return []v1.EnvVar{
-		{Name: assets.UploadConcurrencyLimitEnvVar, Value: uploadConcurrencyLimit},
-	}, nil
+	return envVars, nil
 }
 
 // We don't want to expose pipeline auth tokens, so we hash it. This will be
@@ -613,15 +659,9 @@ func (a *apiServer) getWorkerOptions(ptr *pps.EtcdPipelineInfo, pipelineInfo *pp
 		}
 	}
 
-	// A service can be present either directly on the pipeline spec
-	// or on the spout field of the spec.
-	var service *pps.Service
-	if pipelineInfo.Spout != nil && pipelineInfo.Service != nil {
-		return nil, errors.New("only one of pipeline.service or pipeline.spout can be set")
-	} else if pipelineInfo.Spout != nil && pipelineInfo.Spout.Service != nil {
-		service = pipelineInfo.Spout.Service
-	} else {
-		service = pipelineInfo.Service
+	service, err := getUserService(pipelineInfo)
+	if err != nil {
+		return nil, err
 	}
 	var s3GatewayPort int32
 	if ppsutil.ContainsS3Inputs(pipelineInfo.Input) || pipelineInfo.S3Out {
@@ -791,33 +831,7 @@ func (a *apiServer) createWorkerSvcAndRc(ctx context.Context, ptr *pps.EtcdPipel
 	}
 
 	if options.service != nil {
-		var servicePort = []v1.ServicePort{
-			{
-				Port:       options.service.ExternalPort,
-				TargetPort: intstr.FromInt(int(options.service.InternalPort)),
-				Name:       "user-port",
-			},
-		}
-		var serviceType = v1.ServiceType(options.service.Type)
-		if serviceType == v1.ServiceTypeNodePort {
-			servicePort[0].NodePort = options.service.ExternalPort
-		}
-		service := &v1.Service{
-			TypeMeta: metav1.TypeMeta{
-				Kind:       "Service",
-				APIVersion: "v1",
-			},
-			ObjectMeta: metav1.ObjectMeta{
-				Name:        options.rcName + "-user",
-				Labels:      options.labels,
-				Annotations: options.annotations,
-			},
-			Spec: v1.ServiceSpec{
-				Selector: options.labels,
-				Type:     serviceType,
-				Ports:    servicePort,
-			},
-		}
+		service := newUserService(options.rcName, options.labels, options.annotations, options.service)
 		if _, err := a.env.GetKubeClient().CoreV1().Services(a.namespace).Create(service); err != nil {
 			if !isAlreadyExistsErr(err) {
 				return err
@@ -897,3 +911,71 @@ func getGithookService(kubeClient *kube.Clientset, namespace string) (*v1.Servic
 	}
 	return &serviceList.Items[0], nil
 }
+
+// getUserService returns the service spec for the pipeline's user code (or nil
+// if the pipeline has none). A service can be present either directly on the
+// pipeline spec or on the spout field of the spec.
+func getUserService(pipelineInfo *pps.PipelineInfo) (*pps.Service, error) {
+	if pipelineInfo.Spout != nil && pipelineInfo.Service != nil {
+		return nil, errors.New("only one of pipeline.service or pipeline.spout can be set")
+	} else if pipelineInfo.Spout != nil && pipelineInfo.Spout.Service != nil {
+		return pipelineInfo.Spout.Service, nil
+	}
+	return pipelineInfo.Service, nil
+}
+
+// userServiceName returns the name of the kubernetes service that exposes the
+// user code of the pipeline with the given RC.
+func userServiceName(rcName string) string {
+	return rcName + "-user"
+}
+
+// newUserService generates the kubernetes service that exposes the internal
+// port of the user code on the external port of the pipeline's service spec.
+func newUserService(rcName string, labels, annotations map[string]string, service *pps.Service) *v1.Service {
+	var servicePort = []v1.ServicePort{
+		{
+			Port:       service.ExternalPort,
+			TargetPort: intstr.FromInt(int(service.InternalPort)),
+			Name:       "user-port",
+		},
+	}
+	var serviceType = v1.ServiceType(service.Type)
+	if serviceType == v1.ServiceTypeNodePort {
+		servicePort[0].NodePort = service.ExternalPort
+	}
+	return &v1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        userServiceName(rcName),
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: v1.ServiceSpec{
+			Selector: labels,
+			Type:     serviceType,
+			Ports:    servicePort,
+		},
+	}
+}
+
+// userServiceIsFresh returns true if the existing kubernetes service exposes
+// the same ports as the expected service.
+func userServiceIsFresh(existing, expected *v1.Service) bool {
+	if existing.Spec.Type != expected.Spec.Type || len(existing.Spec.Ports) != len(expected.Spec.Ports) {
+		return false
+	}
+	for i, port := range expected.Spec.Ports {
+		existingPort := existing.Spec.Ports[i]
+		if existingPort.Port != port.Port || existingPort.TargetPort != port.TargetPort {
+			return false
+		}
+		if port.NodePort != 0 && existingPort.NodePort != port.NodePort {
+			return false
+		}
+	}
+	return true
+}
@@ -43,35 +43,72 @@ type AppendFileTarClient interface {
 	AppendFileTar(overwrite bool, r io.Reader, datum ...string) error
 }
 
-const defaultDatumsPerSet = 10
+const (
+	defaultDatumsPerSet = 10
+	// setsPerWorker is the number of datum sets that each worker gets when
+	// the datums are distributed evenly.
+	setsPerWorker = 4
+)
 
-// SetSpec specifies criteria for creating datum sets.
+// SetSpec specifies criteria for creating datum sets. A set is cut when it
+// reaches either of the nonzero limits.
 type SetSpec struct {
-	Number int
+	// Number is the number of datums in a set.
+	Number int64
+	// SizeBytes is the total size of the input files of the datums in a set.
+	SizeBytes int64
+}
+
+// NewEvenSetSpec returns a set spec that distributes numDatums datums evenly
+// over several sets for each of numWorkers workers.
+func NewEvenSetSpec(numDatums, numWorkers int64) *SetSpec {
+	if numWorkers < 1 {
+		numWorkers = 1
+	}
+	numSets := numWorkers * setsPerWorker
+	number := (numDatums + numSets - 1) / numSets
+	if number < 1 {
+		number = 1
+	}
+	return &SetSpec{Number: number}
 }
 
 // CreateSets creates datum sets from the passed in datum iterator.
 func CreateSets(dit Iterator, storageRoot string, setSpec *SetSpec, upload func(func(AppendFileTarClient) error) error) error {
-	var metas []*Meta
-	datumsPerSet := defaultDatumsPerSet
-	if setSpec != nil {
-		datumsPerSet = setSpec.Number
+	if setSpec == nil || (setSpec.Number <= 0 && setSpec.SizeBytes <= 0) {
+		setSpec = &SetSpec{Number: defaultDatumsPerSet}
 	}
+	var metas []*Meta
+	var sizeBytes int64
 	if err := dit.Iterate(func(meta *Meta) error {
 		metas = append(metas, meta)
-		if len(metas) >= datumsPerSet {
+		sizeBytes += inputSizeBytes(meta)
+		if (setSpec.Number > 0 && int64(len(metas)) >= setSpec.Number) ||
+			(setSpec.SizeBytes > 0 && sizeBytes >= setSpec.SizeBytes) {
 			if err := createSet(metas, storageRoot, upload); err != nil {
 				return err
 			}
 			metas = nil
+			sizeBytes = 0
 		}
 		return nil
 	}); err != nil {
 		return err
 	}
+	if len(metas) == 0 {
+		return nil
+	}
 	return createSet(metas, storageRoot, upload)
 }
 
+func inputSizeBytes(meta *Meta) int64 {
+	var size int64
+	for _, input := range meta.Inputs {
+		size += int64(input.FileInfo.SizeBytes)
+	}
+	return size
+}
+
 func createSet(metas []*Meta, storageRoot string, upload func(func(AppendFileTarClient) error) error) error {
 	return upload(func(aftc AppendFileTarClient) error {
 		return WithSet(nil, storageRoot, func(s *Set) error {
@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
@@ -132,3 +133,69 @@ func withTmpDir(cb func(string) error) (retErr error) {
 	}()
 	return cb(storageRoot)
 }
+
+type testIterator []*Meta
+
+func (ti testIterator) Iterate(cb func(*Meta) error) error {
+	for _, meta := range ti {
+		if err := cb(meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type countingClient struct {
+	count int
+}
+
+func (cc *countingClient) AppendFileTar(_ bool, _ io.Reader, _ ...string) error {
+	cc.count++
+	return nil
+}
+
+func testSetSizes(t *testing.T, sizes []uint64, setSpec *SetSpec) []int {
+	var dit testIterator
+	for i, size := range sizes {
+		dit = append(dit, &Meta{
+			Inputs: []*common.Input{
+				{
+					FileInfo: &pfs.FileInfo{
+						File:      client.NewFile("repo", "commit", fmt.Sprintf("/file%v", i)),
+						SizeBytes: size,
+					},
+					Name: "test",
+				},
+			},
+		})
+	}
+	var setSizes []int
+	require.NoError(t, CreateSets(dit, t.TempDir(), setSpec, func(upload func(AppendFileTarClient) error) error {
+		cc := &countingClient{}
+		if err := upload(cc); err != nil {
+			return err
+		}
+		setSizes = append(setSizes, cc.count)
+		return nil
+	}))
+	return setSizes
+}
+
+func TestCreateSets(t *testing.T) {
+	sizes := []uint64{1, 1, 1, 10, 1, 1, 1, 1, 1}
+	require.Equal(t, []int{4, 4, 1}, testSetSizes(t, sizes, &SetSpec{Number: 4}))
+	require.Equal(t, []int{3, 3, 3}, testSetSizes(t, sizes, &SetSpec{Number: 3}))
+	require.Equal(t, []int{3, 1, 3, 2}, testSetSizes(t, sizes, &SetSpec{SizeBytes: 3}))
+	require.Equal(t, []int{2, 2, 2, 2, 1}, testSetSizes(t, sizes, &SetSpec{Number: 2, SizeBytes: 3}))
+	require.Equal(t, []int{3, 2, 3, 1}, testSetSizes(t, sizes, &SetSpec{Number: 3, SizeBytes: 11}))
+	require.Equal(t, []int{9}, testSetSizes(t, sizes, &SetSpec{SizeBytes: 100}))
+	require.Equal(t, []int{9}, testSetSizes(t, sizes, nil))
+}
+
+func TestNewEvenSetSpec(t *testing.T) {
+	require.Equal(t, int64(1), NewEvenSetSpec(0, 1).Number)
+	require.Equal(t, int64(1), NewEvenSetSpec(3, 1).Number)
+	require.Equal(t, int64(25), NewEvenSetSpec(100, 1).Number)
+	require.Equal(t, int64(13), NewEvenSetSpec(100, 2).Number)
+	require.Equal(t, int64(25), NewEvenSetSpec(100, 0).Number)
+}
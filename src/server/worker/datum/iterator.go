@@ -2,15 +2,22 @@ package datum
 
 import (
 	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
 	"io"
 	"path"
 
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/jsonpb"
+	glob "github.com/pachyderm/ohmyglob"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	ppath "github.com/pachyderm/pachyderm/src/server/pkg/path"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/renew"
 	"github.com/pachyderm/pachyderm/src/server/pkg/stream"
 	"github.com/pachyderm/pachyderm/src/server/worker/common"
 )
@@ -45,15 +52,22 @@ func (pi *pfsIterator) Iterate(cb func(*Meta) error) error {
 	repo := pi.input.Repo
 	commit := pi.input.Commit
 	pattern := pi.input.Glob
+	// The glob pattern and file paths are cleaned the same way that GlobFile
+	// cleans them, so that the join and group capture groups line up with
+	// the matched paths.
+	g, err := glob.Compile(ppath.Clean(pattern), '/')
+	if err != nil {
+		return err
+	}
 	return pi.pachClient.GlobFile(repo, commit, pattern, func(fi *pfs.FileInfo) error {
-		// TODO: Implement joins.
-		//g := glob.MustCompile(pi.input.Glob, '/')
-		//joinOn := g.Replace(fi.File.Path, pi.input.JoinOn)
+		joinOn := g.Replace(ppath.Clean(fi.File.Path), pi.input.JoinOn)
+		groupBy := g.Replace(ppath.Clean(fi.File.Path), pi.input.GroupBy)
 		return cb(&Meta{
 			Inputs: []*common.Input{
 				&common.Input{
-					FileInfo: fi,
-					//JoinOn:     joinOn,
+					FileInfo:   fi,
+					JoinOn:     joinOn,
+					GroupBy:    groupBy,
 					Name:       pi.input.Name,
 					Lazy:       pi.input.Lazy,
 					Branch:     pi.input.Branch,
@@ -88,12 +102,22 @@ func (ui *unionIterator) Iterate(cb func(*Meta) error) error {
 	})
 }
 
+var (
+	// crossMemoryThreshold is the size (in bytes) of the datums of an inner
+	// cross input that are held in memory before they are spilled to a
+	// temporary fileset.
+	crossMemoryThreshold = 64 * units.MB
+)
+
 type crossIterator struct {
-	iterators []Iterator
+	pachClient *client.APIClient
+	iterators  []Iterator
 }
 
 func newCrossIterator(pachClient *client.APIClient, inputs []*pps.Input) (Iterator, error) {
-	ci := &crossIterator{}
+	ci := &crossIterator{
+		pachClient: pachClient,
+	}
 	for _, input := range inputs {
 		di, err := NewIterator(pachClient, input)
 		if err != nil {
@@ -104,20 +128,115 @@ func newCrossIterator(pachClient *client.APIClient, inputs []*pps.Input) (Iterat
 	return ci, nil
 }
 
+// Iterate iterates through the cross product of the inputs.
+// The datums of the inner inputs are materialized once, rather than being
+// re-iterated for each datum of the outer input, and the cross product is
+// streamed from the outer input.
 func (ci *crossIterator) Iterate(cb func(*Meta) error) error {
-	return iterate(nil, ci.iterators, cb)
+	if len(ci.iterators) < 2 {
+		return iterate(nil, ci.iterators, cb)
+	}
+	return ci.pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
+		pachClient := ci.pachClient.WithCtx(ctx)
+		iterators := []Iterator{ci.iterators[0]}
+		for _, dit := range ci.iterators[1:] {
+			mi, err := materialize(pachClient, renewer, dit)
+			if err != nil {
+				return err
+			}
+			// The cross product is empty if any of the inputs are empty.
+			if mi.empty() {
+				return nil
+			}
+			iterators = append(iterators, mi)
+		}
+		return iterate(nil, iterators, cb)
+	})
 }
 
 func iterate(crossInputs []*common.Input, iterators []Iterator, cb func(*Meta) error) error {
 	if len(iterators) == 0 {
-		return cb(&Meta{Inputs: crossInputs})
+		return cb(&Meta{Inputs: append([]*common.Input{}, crossInputs...)})
 	}
-	// TODO: Might want to exit fast for the zero datums case.
 	return iterators[0].Iterate(func(meta *Meta) error {
 		return iterate(append(crossInputs, meta.Inputs...), iterators[1:], cb)
 	})
 }
 
+// materializedIterator iterates through the datums read from another
+// iterator, which are either held in memory or spilled to a temporary
+// fileset.
+type materializedIterator struct {
+	metas   []*Meta
+	fileSet Iterator
+}
+
+// materialize reads the datums from an iterator, spilling them to a temporary
+// fileset (that is kept alive by the renewer) if they exceed the cross memory
+// threshold.
+// The spilled datums are written in iteration order, so they are read back in
+// the same order that the iterator emitted them.
+func materialize(pachClient *client.APIClient, renewer *renew.StringSet, dit Iterator) (*materializedIterator, error) {
+	mi := &materializedIterator{}
+	var size, n int
+	var ctfsc *client.CreateFilesetClient
+	spill := func(meta *Meta) error {
+		p := fmt.Sprintf("/%016d", n)
+		n++
+		return writeMeta(ctfsc, p, meta)
+	}
+	if err := dit.Iterate(func(meta *Meta) error {
+		if ctfsc != nil {
+			return spill(meta)
+		}
+		mi.metas = append(mi.metas, meta)
+		size += meta.Size()
+		if size <= crossMemoryThreshold {
+			return nil
+		}
+		var err error
+		ctfsc, err = pachClient.NewCreateFilesetClient()
+		if err != nil {
+			return err
+		}
+		for _, meta := range mi.metas {
+			if err := spill(meta); err != nil {
+				return err
+			}
+		}
+		mi.metas = nil
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if ctfsc == nil {
+		return mi, nil
+	}
+	resp, err := ctfsc.Close()
+	if err != nil {
+		return nil, err
+	}
+	renewer.Add(resp.FilesetId)
+	mi.fileSet = newFileSetIterator(pachClient, client.TmpRepoName, resp.FilesetId, "/*")
+	return mi, nil
+}
+
+func (mi *materializedIterator) empty() bool {
+	return mi.fileSet == nil && len(mi.metas) == 0
+}
+
+func (mi *materializedIterator) Iterate(cb func(*Meta) error) error {
+	if mi.fileSet != nil {
+		return mi.fileSet.Iterate(cb)
+	}
+	for _, meta := range mi.metas {
+		if err := cb(meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // TODO: Need inspect file.
 //type gitIterator struct {
 //	inputs []*common.Input
@@ -192,21 +311,26 @@ func (ji *jobIterator) Iterate(cb func(*Meta) error) error {
 }
 
 type fileSetIterator struct {
-	pachClient   *client.APIClient
-	repo, commit string
+	pachClient            *client.APIClient
+	repo, commit, pattern string
 }
 
 // NewFileSetIterator creates a new fileset iterator.
 func NewFileSetIterator(pachClient *client.APIClient, repo, commit string) Iterator {
+	return newFileSetIterator(pachClient, repo, commit, path.Join("/", MetaPrefix, "*", MetaFileName))
+}
+
+func newFileSetIterator(pachClient *client.APIClient, repo, commit, pattern string) Iterator {
 	return &fileSetIterator{
 		pachClient: pachClient,
 		repo:       repo,
 		commit:     commit,
+		pattern:    pattern,
 	}
 }
 
 func (fsi *fileSetIterator) Iterate(cb func(*Meta) error) error {
-	r, err := fsi.pachClient.GetTarFile(fsi.repo, fsi.commit, path.Join("/", MetaPrefix, "*", MetaFileName))
+	r, err := fsi.pachClient.GetTarFile(fsi.repo, fsi.commit, fsi.pattern)
 	if err != nil {
 		return err
 	}
@@ -231,28 +355,44 @@ func (fsi *fileSetIterator) Iterate(cb func(*Meta) error) error {
 
 // Merge merges multiple datum iterators (key is datum ID).
 func Merge(dits []Iterator, cb func([]*Meta) error) error {
+	return merge(dits, datumID, func(ss []*datumStream) error {
+		var metas []*Meta
+		for _, s := range ss {
+			metas = append(metas, s.meta)
+		}
+		return cb(metas)
+	})
+}
+
+// merge merges multiple datum iterators that are sorted by the passed in key.
+func merge(dits []Iterator, key func(*Meta) string, cb func([]*datumStream) error) error {
 	var ss []stream.Stream
 	for _, dit := range dits {
-		ss = append(ss, newDatumStream(dit, len(ss)))
+		ss = append(ss, newDatumStream(dit, key, len(ss)))
 	}
 	pq := stream.NewPriorityQueue(ss)
 	return pq.Iterate(func(ss []stream.Stream, _ ...string) error {
-		var metas []*Meta
+		var dss []*datumStream
 		for _, s := range ss {
-			metas = append(metas, s.(*datumStream).meta)
+			dss = append(dss, s.(*datumStream))
 		}
-		return cb(metas)
+		return cb(dss)
 	})
 }
 
+func datumID(meta *Meta) string {
+	return common.DatumID(meta.Inputs)
+}
+
 type datumStream struct {
 	meta     *Meta
 	metaChan chan *Meta
 	errChan  chan error
+	key      func(*Meta) string
 	priority int
 }
 
-func newDatumStream(dit Iterator, priority int) *datumStream {
+func newDatumStream(dit Iterator, key func(*Meta) string, priority int) *datumStream {
 	metaChan := make(chan *Meta)
 	errChan := make(chan error, 1)
 	go func() {
@@ -268,6 +408,7 @@ func newDatumStream(dit Iterator, priority int) *datumStream {
 	return &datumStream{
 		metaChan: metaChan,
 		errChan:  errChan,
+		key:      key,
 		priority: priority,
 	}
 }
@@ -286,7 +427,7 @@ func (ds *datumStream) Next() error {
 }
 
 func (ds *datumStream) Key() string {
-	return common.DatumID(ds.meta.Inputs)
+	return ds.key(ds.meta)
 }
 
 func (ds *datumStream) Priority() int {
@@ -302,8 +443,10 @@ func NewIterator(pachClient *client.APIClient, input *pps.Input) (Iterator, erro
 		return newUnionIterator(pachClient, input.Union)
 	case input.Cross != nil:
 		return newCrossIterator(pachClient, input.Cross)
-	//case input.Join != nil:
-	//	return newJoinIterator(pachClient, input.Join)
+	case input.Join != nil:
+		return newJoinIterator(pachClient, input.Join)
+	case input.Group != nil:
+		return newGroupIterator(pachClient, input.Group)
 	case input.Cron != nil:
 		return newCronIterator(pachClient, input.Cron), nil
 		//case input.Git != nil:
@@ -312,75 +455,223 @@ func NewIterator(pachClient *client.APIClient, input *pps.Input) (Iterator, erro
 	return nil, errors.Errorf("unrecognized input type: %v", input)
 }
 
-// TODO: Implement joins.
-//type joinIterator struct {
-//	datums   [][]*common.Input
-//	location int
-//}
-//
-//func newJoinIterator(pachClient *client.APIClient, join []*pps.Input) (Iterator, error) {
-//	result := &joinIterator{}
-//	om := ordered_map.NewOrderedMap()
-//
-//	for i, input := range join {
-//		datumIterator, err := NewIterator(pachClient, input)
-//		if err != nil {
-//			return nil, err
-//		}
-//		for datumIterator.Next() {
-//			x := datumIterator.Datum()
-//			for _, k := range x {
-//				tupleI, ok := om.Get(k.JoinOn)
-//				var tuple [][]*common.Input
-//				if !ok {
-//					tuple = make([][]*common.Input, len(join))
-//				} else {
-//					tuple = tupleI.([][]*common.Input)
-//				}
-//				tuple[i] = append(tuple[i], k)
-//				om.Set(k.JoinOn, tuple)
-//			}
-//		}
-//	}
-//
-//	iter := om.IterFunc()
-//	for kv, ok := iter(); ok; kv, ok = iter() {
-//		tuple := kv.Value.([][]*common.Input)
-//		cross, err := newCrossListIterator(pachClient, tuple)
-//		if err != nil {
-//			return nil, err
-//		}
-//		for cross.Next() {
-//			result.datums = append(result.datums, cross.Datum())
-//		}
-//	}
-//	result.location = -1
-//	return result, nil
-//}
-//
-//func (d *joinIterator) Reset() {
-//	d.location = -1
-//}
-//
-//func (d *joinIterator) Len() int {
-//	return len(d.datums)
-//}
-//
-//func (d *joinIterator) Next() bool {
-//	if d.location < len(d.datums) {
-//		d.location++
-//	}
-//	return d.location < len(d.datums)
-//}
-//
-//func (d *joinIterator) Datum() []*common.Input {
-//	var result []*common.Input
-//	result = append(result, d.datums[d.location]...)
-//	sortInputs(result)
-//	return result
-//}
-//
-//func (d *joinIterator) DatumN(n int) []*common.Input {
-//	d.location = n
-//	return d.Datum()
-//}
+type joinIterator struct {
+	pachClient *client.APIClient
+	iterators  []Iterator
+	outerJoins []bool
+}
+
+func newJoinIterator(pachClient *client.APIClient, join []*pps.Input) (Iterator, error) {
+	ji := &joinIterator{
+		pachClient: pachClient,
+	}
+	for _, input := range join {
+		di, err := NewIterator(pachClient, input)
+		if err != nil {
+			return nil, err
+		}
+		ji.iterators = append(ji.iterators, di)
+		ji.outerJoins = append(ji.outerJoins, input.Pfs != nil && input.Pfs.OuterJoin)
+	}
+	return ji, nil
+}
+
+// Iterate iterates through the joined datums.
+func (ji *joinIterator) Iterate(cb func(*Meta) error) error {
+	return iterateByKey(ji.pachClient, ji.iterators, joinKey, func(metas [][]*Meta, write func(*Meta) error) error {
+		var crossMetas [][]*Meta
+		var missing, outer bool
+		for i := range metas {
+			if len(metas[i]) == 0 {
+				missing = true
+				continue
+			}
+			outer = outer || ji.outerJoins[i]
+			crossMetas = append(crossMetas, metas[i])
+		}
+		// Join keys that are missing from some of the inputs are only
+		// emitted if one of the inputs present is an outer join.
+		if missing && !outer {
+			return nil
+		}
+		return cross(crossMetas, write)
+	}, cb)
+}
+
+func joinKey(meta *Meta) string {
+	var key string
+	for _, input := range meta.Inputs {
+		key += input.JoinOn
+	}
+	return key
+}
+
+// cross calls cb with the cross product of the passed in datums.
+func cross(metas [][]*Meta, cb func(*Meta) error) error {
+	var iterate func([]*common.Input, [][]*Meta) error
+	iterate = func(inputs []*common.Input, metas [][]*Meta) error {
+		if len(metas) == 0 {
+			return cb(&Meta{Inputs: append([]*common.Input{}, inputs...)})
+		}
+		for _, meta := range metas[0] {
+			if err := iterate(append(inputs, meta.Inputs...), metas[1:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return iterate(nil, metas)
+}
+
+type groupIterator struct {
+	pachClient *client.APIClient
+	iterators  []Iterator
+}
+
+func newGroupIterator(pachClient *client.APIClient, group []*pps.Input) (Iterator, error) {
+	gi := &groupIterator{
+		pachClient: pachClient,
+	}
+	for _, input := range group {
+		di, err := NewIterator(pachClient, input)
+		if err != nil {
+			return nil, err
+		}
+		gi.iterators = append(gi.iterators, di)
+	}
+	return gi, nil
+}
+
+// Iterate iterates through the grouped datums.
+func (gi *groupIterator) Iterate(cb func(*Meta) error) error {
+	return iterateByKey(gi.pachClient, gi.iterators, groupKey, func(metas [][]*Meta, write func(*Meta) error) error {
+		var inputs []*common.Input
+		for i := range metas {
+			for _, meta := range metas[i] {
+				inputs = append(inputs, meta.Inputs...)
+			}
+		}
+		return write(&Meta{Inputs: inputs})
+	}, cb)
+}
+
+func groupKey(meta *Meta) string {
+	var key string
+	for _, input := range meta.Inputs {
+		key += input.GroupBy
+	}
+	return key
+}
+
+// iterateByKey iterates through the datums created from the datums of the
+// passed in iterators that share the same key.
+// The datums of each iterator are first sorted by key (by writing them to
+// a temporary fileset keyed by key), then the sorted iterators are merged and
+// the datums created by cb for each key are written to another temporary
+// fileset keyed by datum ID. This keeps memory usage bounded by the number of
+// datums for a single key, and ensures that the datums are emitted in datum ID
+// order like the other iterators.
+func iterateByKey(pachClient *client.APIClient, dits []Iterator, key func(*Meta) string, cb func([][]*Meta, func(*Meta) error) error, iterateCb func(*Meta) error) error {
+	return pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
+		pachClient := pachClient.WithCtx(ctx)
+		keyedFileSet, err := createKeyedFileSet(pachClient, dits, key)
+		if err != nil {
+			return err
+		}
+		renewer.Add(keyedFileSet)
+		fileSet, err := createMetaFileSet(pachClient, func(write func(*Meta) error) error {
+			return mergeKeyedFileSet(pachClient, keyedFileSet, len(dits), key, func(metas [][]*Meta) error {
+				return cb(metas, write)
+			})
+		})
+		if err != nil {
+			return err
+		}
+		renewer.Add(fileSet)
+		return NewFileSetIterator(pachClient, client.TmpRepoName, fileSet).Iterate(iterateCb)
+	})
+}
+
+// createKeyedFileSet creates a temporary fileset with the datums from each
+// iterator sorted by the key computed from the datum.
+// The datums for iterator i can be read back in key order with a
+// keyedFileSetIterator.
+func createKeyedFileSet(pachClient *client.APIClient, dits []Iterator, key func(*Meta) string) (string, error) {
+	resp, err := pachClient.WithCreateFilesetClient(func(ctfsc *client.CreateFilesetClient) error {
+		for i, dit := range dits {
+			var n int
+			if err := dit.Iterate(func(meta *Meta) error {
+				p := path.Join(keyedPrefix(i), fmt.Sprintf("k%x", key(meta)), fmt.Sprintf("%016d", n))
+				n++
+				return writeMeta(ctfsc, p, meta)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.FilesetId, nil
+}
+
+func keyedPrefix(i int) string {
+	return fmt.Sprintf("/%08d", i)
+}
+
+// mergeKeyedFileSet merges the sorted iterators in a keyed fileset and calls
+// cb with the datums of each key, grouped by iterator.
+func mergeKeyedFileSet(pachClient *client.APIClient, fileSetID string, numIterators int, key func(*Meta) string, cb func([][]*Meta) error) error {
+	var dits []Iterator
+	for i := 0; i < numIterators; i++ {
+		dits = append(dits, newFileSetIterator(pachClient, client.TmpRepoName, fileSetID, path.Join(keyedPrefix(i), "*", "*")))
+	}
+	var currKey string
+	var metas [][]*Meta
+	if err := merge(dits, key, func(ss []*datumStream) error {
+		if metas != nil && ss[0].Key() != currKey {
+			if err := cb(metas); err != nil {
+				return err
+			}
+			metas = nil
+		}
+		if metas == nil {
+			currKey = ss[0].Key()
+			metas = make([][]*Meta, numIterators)
+		}
+		for _, s := range ss {
+			metas[s.priority] = append(metas[s.priority], s.meta)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if metas == nil {
+		return nil
+	}
+	return cb(metas)
+}
+
+// createMetaFileSet creates a temporary fileset with the datums written by
+// cb. The datums can be read back in datum ID order with a fileset iterator.
+func createMetaFileSet(pachClient *client.APIClient, cb func(func(*Meta) error) error) (string, error) {
+	resp, err := pachClient.WithCreateFilesetClient(func(ctfsc *client.CreateFilesetClient) error {
+		return cb(func(meta *Meta) error {
+			return writeMeta(ctfsc, path.Join("/", MetaPrefix, common.DatumID(meta.Inputs), MetaFileName), meta)
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.FilesetId, nil
+}
+
+func writeMeta(ctfsc *client.CreateFilesetClient, p string, meta *Meta) error {
+	marshaler := &jsonpb.Marshaler{}
+	buf := &bytes.Buffer{}
+	if err := marshaler.Marshal(buf, meta); err != nil {
+		return err
+	}
+	return ctfsc.AppendFile(p, false, buf)
+}
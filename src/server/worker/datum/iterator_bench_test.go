@@ -7,6 +7,8 @@ import (
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
 	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
 )
 
@@ -112,3 +114,46 @@ func BenchmarkDI4(b *testing.B)  { benchmarkIterators(4, b) }
 func BenchmarkDI8(b *testing.B)  { benchmarkIterators(8, b) }
 func BenchmarkDI16(b *testing.B) { benchmarkIterators(16, b) }
 func BenchmarkDI32(b *testing.B) { benchmarkIterators(32, b) }
+
+// benchmarkCross benchmarks iterating through the cross product of an input
+// with n datums and itself, with the inner input either held in memory or
+// spilled to a temporary fileset.
+func benchmarkCross(n int, spill bool, b *testing.B) {
+	if spill {
+		defer func(threshold int) { crossMemoryThreshold = threshold }(crossMemoryThreshold)
+		crossMemoryThreshold = 0
+	}
+	db := dbutil.NewTestDB(b)
+	require.NoError(b, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		dataRepo := tu.UniqueString("BenchmarkCross_data")
+		require.NoError(b, c.CreateRepo(dataRepo))
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(b, err)
+		for i := 0; i < n; i++ {
+			require.NoError(b, c.PutFile(dataRepo, commit.ID, fmt.Sprintf("/foo%v", i), strings.NewReader("input")))
+		}
+		require.NoError(b, c.FinishCommit(dataRepo, commit.ID))
+		in := client.NewPFSInput(dataRepo, "/*")
+		in.Pfs.Commit = commit.ID
+		cross, err := NewIterator(c, client.NewCrossInput(in, in))
+		require.NoError(b, err)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var count int
+			require.NoError(b, cross.Iterate(func(_ *Meta) error {
+				count++
+				return nil
+			}))
+			require.Equal(b, n*n, count)
+		}
+		return nil
+	}))
+}
+
+func BenchmarkCross10(b *testing.B)        { benchmarkCross(10, false, b) }
+func BenchmarkCross100(b *testing.B)       { benchmarkCross(100, false, b) }
+func BenchmarkCross1000(b *testing.B)      { benchmarkCross(1000, false, b) }
+func BenchmarkCrossSpill10(b *testing.B)   { benchmarkCross(10, true, b) }
+func BenchmarkCrossSpill100(b *testing.B)  { benchmarkCross(100, true, b) }
+func BenchmarkCrossSpill1000(b *testing.B) { benchmarkCross(1000, true, b) }
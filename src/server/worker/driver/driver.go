@@ -16,6 +16,7 @@ import (
 	"time"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/jmoiron/sqlx"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -51,7 +52,7 @@ type Driver interface {
 	Jobs() col.Collection
 	Pipelines() col.Collection
 
-	NewTaskWorker() *work.Worker
+	NewTaskWorker(opts ...work.WorkerOption) *work.Worker
 	NewTaskQueue() (*work.TaskQueue, error)
 
 	// Returns the PipelineInfo for the pipeline that this worker belongs to
@@ -98,10 +99,13 @@ type Driver interface {
 }
 
 type driver struct {
-	pipelineInfo    *pps.PipelineInfo
-	pachClient      *client.APIClient
-	etcdClient      *etcd.Client
-	etcdPrefix      string
+	pipelineInfo *pps.PipelineInfo
+	pachClient   *client.APIClient
+	etcdClient   *etcd.Client
+	etcdPrefix   string
+	// db is the database that task queues are stored in, or nil if they are
+	// stored in etcd.
+	db              *sqlx.DB
 	activeDataMutex *sync.Mutex
 
 	jobs col.Collection
@@ -126,12 +130,14 @@ type driver struct {
 // NewDriver constructs a Driver object using the given clients and pipeline
 // settings.  It makes blocking calls to determine the user/group to use with
 // the user code on the current worker node, as well as determining if
-// enterprise features are activated (for exporting stats).
+// enterprise features are activated (for exporting stats). If db is not nil,
+// the driver's task queues are stored in postgres rather than etcd.
 func NewDriver(
 	pipelineInfo *pps.PipelineInfo,
 	pachClient *client.APIClient,
 	etcdClient *etcd.Client,
 	etcdPrefix string,
+	db *sqlx.DB,
 	rootPath string,
 	namespace string,
 ) (Driver, error) {
@@ -144,6 +150,7 @@ func NewDriver(
 		pachClient:      pachClient,
 		etcdClient:      etcdClient,
 		etcdPrefix:      etcdPrefix,
+		db:              db,
 		activeDataMutex: &sync.Mutex{},
 		jobs:            ppsdb.Jobs(etcdClient, etcdPrefix),
 		pipelines:       ppsdb.Pipelines(etcdClient, etcdPrefix),
@@ -267,12 +274,20 @@ func (d *driver) Pipelines() col.Collection {
 	return d.pipelines
 }
 
-func (d *driver) NewTaskWorker() *work.Worker {
-	return work.NewWorker(d.etcdClient, d.etcdPrefix, workNamespace(d.pipelineInfo))
+func (d *driver) NewTaskWorker(opts ...work.WorkerOption) *work.Worker {
+	if d.db != nil {
+		return work.NewPostgresWorker(d.db, workNamespace(d.pipelineInfo), opts...)
+	}
+	return work.NewWorker(d.etcdClient, d.etcdPrefix, workNamespace(d.pipelineInfo), opts...)
 }
 
 func (d *driver) NewTaskQueue() (*work.TaskQueue, error) {
-	return work.NewTaskQueue(d.PachClient().Ctx(), d.etcdClient, d.etcdPrefix, workNamespace(d.pipelineInfo))
+	prioritySpec := d.pipelineInfo.PrioritySpec
+	opt := work.WithPriority(prioritySpec.GetLevel(), prioritySpec.GetWeight())
+	if d.db != nil {
+		return work.NewPostgresTaskQueue(d.PachClient().Ctx(), d.db, workNamespace(d.pipelineInfo), opt)
+	}
+	return work.NewTaskQueue(d.PachClient().Ctx(), d.etcdClient, d.etcdPrefix, workNamespace(d.pipelineInfo), opt)
 }
 
 func (d *driver) ExpectedNumWorkers() (int64, error) {
@@ -13,11 +13,10 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/worker/common"
 )
 
-// TODO: Make spouts work with V2.
 // Mkfifo does not exist on Windows, so this is left unimplemented there, except for tests
-//func createSpoutFifo(path string) error {
-//	return syscall.Mkfifo(path, 0666)
-//}
+func createSpoutFifo(path string) error {
+	return syscall.Mkfifo(path, 0666)
+}
 
 func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 	return &syscall.SysProcAttr{
@@ -137,6 +136,13 @@ func (d *driver) linkData(inputs []*common.Input, dir string) error {
 	}
 
 	if !d.PipelineInfo().S3Out {
+		// Spouts write their output as a stream of tar files to a named pipe
+		// rather than to an output directory.
+		if d.PipelineInfo().Spout != nil {
+			if err := createSpoutFifo(filepath.Join(dir, "out")); err != nil {
+				return err
+			}
+		}
 		if err := os.Symlink(filepath.Join(dir, "out"), filepath.Join(d.InputDir(), "out")); err != nil {
 			return err
 		}
@@ -79,6 +79,12 @@ func (d *driver) moveData(inputs []*common.Input, dir string) error {
 		}
 	}
 
+	if d.PipelineInfo().Spout != nil {
+		if err := createSpoutFifo(filepath.Join(dir, "out")); err != nil {
+			return err
+		}
+	}
+
 	return os.Rename(filepath.Join(dir, "out"), filepath.Join(d.InputDir(), "out"))
 }
 
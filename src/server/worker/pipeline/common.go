@@ -1,267 +1,365 @@
 package pipeline
 
-// TODO: Make spouts work with V2.
-//func openAndWait() error {
-//	// at the end of file, we open the pipe again, since this blocks until something is written to the pipe
-//	openAndWait, err := os.Open("/pfs/out")
-//	if err != nil {
-//		return err
-//	}
-//	// and then we immediately close this reader of the pipe, so that the main reader can continue its standard behavior
-//	err = openAndWait.Close()
-//	if err != nil {
-//		return err
-//	}
-//	return nil
-//}
-//
-//// RunUserCode will run the pipeline's user code until canceled by the context
-//// - used for services and spouts. Unlike how the transform worker runs user
-//// code, this does not set environment variables or collect stats.
-//func RunUserCode(
-//	driver driver.Driver,
-//	logger logs.TaggedLogger,
-//	outputCommit *pfs.Commit,
-//	inputs []*common.Input,
-//) error {
-//	return backoff.RetryUntilCancel(driver.PachClient().Ctx(), func() error {
-//		// TODO: what about the user error handling code?
-//		env := driver.UserCodeEnv(logger.JobID(), outputCommit, inputs)
-//		return driver.RunUserCode(driver.PachClient().Ctx(), logger, env)
-//	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
-//		logger.Logf("error in RunUserCode: %+v, retrying in: %+v", err, d)
-//		return nil
-//	})
-//}
-//
-//
-//// ReceiveSpout is used by both services and spouts if a spout is defined on the
-//// pipeline. ctx is separate from pachClient because services may call this, and
-//// they use a cancel function that affects the context but not the pachClient
-//// (so metadata updates can still be made while unwinding).
-//func ReceiveSpout(
-//	ctx context.Context,
-//	pachClient *client.APIClient,
-//	pipelineInfo *pps.PipelineInfo,
-//	logger logs.TaggedLogger,
-//) (retErr error) {
-//	// Open a read connection to the /pfs/out named pipe.
-//	out, err := os.Open("/pfs/out")
-//	if err != nil {
-//		return err
-//	}
-//	defer func() {
-//		if err := out.Close(); retErr == nil {
-//			retErr = err
-//		}
-//	}()
-//	cancelCtx, cancel := context.WithCancel(ctx)
-//	repo := pipelineInfo.Pipeline.Name
-//	for {
-//		if err := withTmpFile("pachyderm_spout_commit", func(f *os.File) error {
-//			if err := getNextTarStream(f, out); err != nil {
-//				return err
-//			}
-//			return withSpoutCommit(cancelCtx, pachClient, pipelineInfo, logger, f, func(commit *pfs.Commit, tr *tar.Reader) error {
-//				for {
-//					hdr, err := tr.Next()
-//					if err != nil {
-//						if errors.Is(err, io.EOF) {
-//							return nil
-//						}
-//						return err
-//					}
-//					if err := withSpoutFile(cancelCtx, logger, tr, func(r io.Reader) error {
-//						if pipelineInfo.Spout.Marker != "" && strings.HasPrefix(path.Clean(hdr.Name), pipelineInfo.Spout.Marker) {
-//							// Check to see if this spout is the latest version of this spout by seeing if its spec commit has any children.
-//							// TODO: There is a race condition here where the spout could be updated after this check, but before the PutFileOverwrite.
-//							spec, err := pachClient.InspectCommit(ppsconsts.SpecRepo, pipelineInfo.SpecCommit.ID)
-//							if err != nil && !errutil.IsNotFoundError(err) {
-//								return err
-//							}
-//							if spec != nil && len(spec.ChildCommits) != 0 {
-//								cancel()
-//								return errors.New("outdated spout, now shutting down")
-//							}
-//							_, err = pachClient.PutFileOverwrite(repo, ppsconsts.SpoutMarkerBranch, hdr.Name, r, 0)
-//							if err != nil {
-//								return err
-//							}
-//						} else if pipelineInfo.Spout.Overwrite {
-//							_, err := pachClient.PutFileOverwrite(repo, commit.ID, hdr.Name, r, 0)
-//							if err != nil {
-//								return err
-//							}
-//						}
-//						_, err := pachClient.PutFile(repo, commit.ID, hdr.Name, r)
-//						return err
-//					}); err != nil {
-//						return err
-//					}
-//				}
-//			})
-//		}); err != nil {
-//			return err
-//		}
-//	}
-//}
-//
-//// TODO: Refactor into a file util package.
-//func withTmpFile(name string, cb func(*os.File) error) (retErr error) {
-//	if err := os.MkdirAll(os.TempDir(), 0700); err != nil {
-//		return err
-//	}
-//	f, err := ioutil.TempFile(os.TempDir(), name)
-//	if err != nil {
-//		return err
-//	}
-//	defer func() {
-//		if err := os.Remove(f.Name()); retErr == nil {
-//			retErr = err
-//		}
-//		if err := f.Close(); retErr == nil {
-//			retErr = err
-//		}
-//	}()
-//	return cb(f)
-//}
-//
-//func getNextTarStream(w io.Writer, r io.Reader) error {
-//	var hdr *tar.Header
-//	var err error
-//	tr := tar.NewReader(newSkipReader(r))
-//	for {
-//		hdr, err = tr.Next()
-//		if err != nil {
-//			if errors.Is(err, io.EOF) {
-//				err = openAndWait()
-//				if err != nil {
-//					return err
-//				}
-//				tr = tar.NewReader(newSkipReader(r))
-//				continue
-//			}
-//			return err
-//		}
-//		break
-//	}
-//	tw := tar.NewWriter(w)
-//	if err := tw.WriteHeader(hdr); err != nil {
-//		return err
-//	}
-//	if _, err := io.Copy(tw, tr); err != nil {
-//		return err
-//	}
-//	if err := copyTar(tw, tr); err != nil {
-//		return err
-//	}
-//	return tw.Close()
-//}
-//
-//type skipReader struct {
-//	buf *bytes.Buffer
-//	r   io.Reader
-//}
-//
-//func newSkipReader(r io.Reader) *skipReader {
-//	return &skipReader{r: r}
-//}
-//
-//func (sr *skipReader) Read(data []byte) (int, error) {
-//	if sr.buf == nil {
-//		if err := sr.skipZeroBlocks(); err != nil {
-//			return 0, err
-//		}
-//	}
-//	bufN, _ := sr.buf.Read(data)
-//	if bufN == len(data) {
-//		return bufN, nil
-//	}
-//	n, err := sr.r.Read(data[bufN:])
-//	return bufN + n, err
-//}
-//
-//func (sr *skipReader) skipZeroBlocks() error {
-//	sr.buf = &bytes.Buffer{}
-//	zeroBlock := make([]byte, 512)
-//	for {
-//		_, err := io.CopyN(sr.buf, sr.r, 512)
-//		if err != nil {
-//			return err
-//		}
-//		if !bytes.Equal(sr.buf.Bytes(), zeroBlock) {
-//			return nil
-//		}
-//		sr.buf.Reset()
-//	}
-//}
-//
-//// TODO: Refactor this into tarutil.
-//func copyTar(tw *tar.Writer, tr *tar.Reader) error {
-//	for {
-//		hdr, err := tr.Next()
-//		if err != nil {
-//			if errors.Is(err, io.EOF) {
-//				return nil
-//			}
-//			return err
-//		}
-//		if err := tw.WriteHeader(hdr); err != nil {
-//			return err
-//		}
-//		_, err = io.Copy(tw, tr)
-//		if err != nil {
-//			return err
-//		}
-//	}
-//}
-//
-//func withSpoutCommit(ctx context.Context, pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, logger logs.TaggedLogger, f *os.File, cb func(*pfs.Commit, *tar.Reader) error) error {
-//	repo := pipelineInfo.Pipeline.Name
-//	return backoff.RetryUntilCancel(ctx, func() (retErr error) {
-//		commit, err := pachClient.PfsAPIClient.StartCommit(ctx, &pfs.StartCommitRequest{
-//			Parent:     client.NewCommit(repo, ""),
-//			Branch:     pipelineInfo.OutputBranch,
-//			Provenance: []*pfs.CommitProvenance{client.NewCommitProvenance(ppsconsts.SpecRepo, repo, pipelineInfo.SpecCommit.ID)},
-//		})
-//		if err != nil {
-//			return err
-//		}
-//		defer func() {
-//			if retErr != nil {
-//				pachClient.DeleteCommit(repo, commit.ID)
-//				return
-//			}
-//			if err := pachClient.FinishCommit(repo, commit.ID); retErr == nil {
-//				retErr = err
-//			}
-//		}()
-//		_, err = f.Seek(0, 0)
-//		if err != nil {
-//			return err
-//		}
-//		return cb(commit, tar.NewReader(f))
-//	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
-//		logger.Logf("error in withSpoutCommit: %+v, retrying in: %+v", err, d)
-//		return nil
-//	})
-//}
-//
-//func withSpoutFile(ctx context.Context, logger logs.TaggedLogger, r io.Reader, cb func(io.Reader) error) error {
-//	return withTmpFile("pachyderm_spout_file", func(f *os.File) error {
-//		_, err := io.Copy(f, r)
-//		if err != nil {
-//			return err
-//		}
-//		return backoff.RetryUntilCancel(ctx, func() error {
-//			_, err = f.Seek(0, 0)
-//			if err != nil {
-//				return err
-//			}
-//			return cb(f)
-//		}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
-//			logger.Logf("error in withSpoutFile: %+v, retrying in: %+v", err, d)
-//			return nil
-//		})
-//	})
-//}
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/tarutil"
+	"github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/driver"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+)
+
+var errOutdatedSpout = errors.New("outdated spout, now shutting down")
+
+func openAndWait(outPath string) error {
+	// at the end of file, we open the pipe again, since this blocks until something is written to the pipe
+	openAndWait, err := os.Open(outPath)
+	if err != nil {
+		return err
+	}
+	// and then we immediately close this reader of the pipe, so that the main reader can continue its standard behavior
+	err = openAndWait.Close()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RunUserCode will run the pipeline's user code until canceled by the context
+// - used for services and spouts. Unlike how the transform worker runs user
+// code, this does not set environment variables or collect stats.
+func RunUserCode(
+	driver driver.Driver,
+	logger logs.TaggedLogger,
+	outputCommit *pfs.Commit,
+	inputs []*common.Input,
+) error {
+	return backoff.RetryUntilCancel(driver.PachClient().Ctx(), func() error {
+		env := driver.UserCodeEnv(logger.JobID(), outputCommit, inputs)
+		return driver.RunUserCode(driver.PachClient().Ctx(), logger, env)
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		logger.Logf("error in RunUserCode: %+v, retrying in: %+v", err, d)
+		return nil
+	})
+}
+
+// ReceiveSpout is used by both services and spouts if a spout is defined on the
+// pipeline. ctx is separate from the driver's pachClient because services may
+// call this, and they use a cancel function that affects the context but not
+// the pachClient (so metadata updates can still be made while unwinding).
+// Each tar stream written by the user code to the out pipe is written to the
+// output repo in its own commit.
+func ReceiveSpout(
+	ctx context.Context,
+	driver driver.Driver,
+	logger logs.TaggedLogger,
+) (retErr error) {
+	pachClient := driver.PachClient()
+	pipelineInfo := driver.PipelineInfo()
+	// Open a read connection to the out named pipe.
+	outPath := filepath.Join(driver.InputDir(), "out")
+	out, err := os.Open(outPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := out.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-done:
+			return
+		case <-cancelCtx.Done():
+		}
+		// Reads and opens of the out pipe block until the user code writes to
+		// it, so keep briefly opening the pipe for writing until the receiver
+		// notices that it has been canceled.
+		for {
+			unblockPipe(outPath)
+			select {
+			case <-done:
+				return
+			case <-time.After(100 * time.Millisecond):
+			}
+		}
+	}()
+	repo := pipelineInfo.Pipeline.Name
+	marker := pipelineInfo.Spout.Marker
+	for {
+		if err := withTmpFile("pachyderm_spout_commit", func(f *os.File) error {
+			if err := getNextTarStream(cancelCtx, f, out, outPath); err != nil {
+				return err
+			}
+			if err := withSpoutCommit(cancelCtx, pachClient, pipelineInfo, logger, func(commit *pfs.Commit) error {
+				if err := putSpoutFiles(pachClient, repo, commit.ID, pipelineInfo.Spout.Overwrite, f, func(name string) bool {
+					return !isSpoutMarker(name, marker)
+				}); err != nil {
+					return err
+				}
+				// Check that this spout is the latest version of the spout (its
+				// spec commit has no children) right before the output commit is
+				// finished, so an outdated spout deletes its commit rather than
+				// finishing it.
+				spec, err := pachClient.InspectCommit(ppsconsts.SpecRepo, pipelineInfo.SpecCommit.ID)
+				if err != nil && !errutil.IsNotFoundError(err) {
+					return err
+				}
+				if spec != nil && len(spec.ChildCommits) != 0 {
+					return errOutdatedSpout
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			isMarker := func(name string) bool {
+				return isSpoutMarker(name, marker)
+			}
+			if ok, err := containsSpoutFiles(f, isMarker); err != nil || !ok {
+				return err
+			}
+			// The marker is only updated once the output commit is finished, so
+			// a spout that is restarted will resume from a state that has
+			// already been committed.
+			return backoff.RetryUntilCancel(cancelCtx, func() error {
+				return putSpoutMarker(pachClient, repo, marker, f)
+			}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+				logger.Logf("error writing spout marker: %+v, retrying in: %+v", err, d)
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func withTmpFile(name string, cb func(*os.File) error) (retErr error) {
+	if err := os.MkdirAll(os.TempDir(), 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(os.TempDir(), name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(f.Name()); retErr == nil {
+			retErr = err
+		}
+		if err := f.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	return cb(f)
+}
+
+func unblockPipe(outPath string) {
+	f, err := os.OpenFile(outPath, os.O_WRONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return
+	}
+	f.Close()
+}
+
+func getNextTarStream(ctx context.Context, w io.Writer, r io.Reader, outPath string) error {
+	var hdr *tar.Header
+	var err error
+	tr := tar.NewReader(newSkipReader(r))
+	for {
+		hdr, err = tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				err = openAndWait(outPath)
+				if err != nil {
+					return err
+				}
+				tr = tar.NewReader(newSkipReader(r))
+				continue
+			}
+			return err
+		}
+		break
+	}
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tw, tr); err != nil {
+		return err
+	}
+	if err := tarutil.CopyTar(tw, tr); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+type skipReader struct {
+	buf *bytes.Buffer
+	r   io.Reader
+}
+
+func newSkipReader(r io.Reader) *skipReader {
+	return &skipReader{r: r}
+}
+
+func (sr *skipReader) Read(data []byte) (int, error) {
+	if sr.buf == nil {
+		if err := sr.skipZeroBlocks(); err != nil {
+			return 0, err
+		}
+	}
+	bufN, _ := sr.buf.Read(data)
+	if bufN == len(data) {
+		return bufN, nil
+	}
+	n, err := sr.r.Read(data[bufN:])
+	return bufN + n, err
+}
+
+func (sr *skipReader) skipZeroBlocks() error {
+	sr.buf = &bytes.Buffer{}
+	zeroBlock := make([]byte, 512)
+	for {
+		_, err := io.CopyN(sr.buf, sr.r, 512)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(sr.buf.Bytes(), zeroBlock) {
+			return nil
+		}
+		sr.buf.Reset()
+	}
+}
+
+func withSpoutCommit(ctx context.Context, pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, logger logs.TaggedLogger, cb func(*pfs.Commit) error) error {
+	repo := pipelineInfo.Pipeline.Name
+	return backoff.RetryUntilCancel(ctx, func() (retErr error) {
+		commit, err := pachClient.PfsAPIClient.StartCommit(ctx, &pfs.StartCommitRequest{
+			Parent:     client.NewCommit(repo, ""),
+			Branch:     pipelineInfo.OutputBranch,
+			Provenance: []*pfs.CommitProvenance{client.NewCommitProvenance(ppsconsts.SpecRepo, repo, pipelineInfo.SpecCommit.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if retErr != nil {
+				pachClient.DeleteCommit(repo, commit.ID)
+				return
+			}
+			if err := pachClient.FinishCommit(repo, commit.ID); retErr == nil {
+				retErr = err
+			}
+		}()
+		return cb(commit)
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		if errors.Is(err, errOutdatedSpout) {
+			return err
+		}
+		logger.Logf("error in withSpoutCommit: %+v, retrying in: %+v", err, d)
+		return nil
+	})
+}
+
+// putSpoutFiles writes the files in the tar stream stored in f that pass the
+// filter to the given commit (or branch).
+func putSpoutFiles(pachClient *client.APIClient, repo, commit string, overwrite bool, f *os.File, filter func(string) bool) error {
+	return pachClient.WithModifyFileClient(repo, commit, func(mfc *client.ModifyFileClient) error {
+		return appendSpoutFiles(mfc, overwrite, f, filter)
+	})
+}
+
+// appendSpoutFiles appends the files in the tar stream stored in f that pass
+// the filter with the passed in modify file client.
+func appendSpoutFiles(mfc *client.ModifyFileClient, overwrite bool, f *os.File, filter func(string) bool) error {
+	if _, err := f.Seek(0, 0); err != nil {
+		return err
+	}
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if hdr.Typeflag == tar.TypeDir || !filter(hdr.Name) {
+			continue
+		}
+		if err := mfc.AppendFile(hdr.Name, overwrite, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// putSpoutMarker replaces the spout marker on the spout marker branch with
+// the marker files in the tar stream stored in f. The previous marker is
+// deleted, so marker files that the user code no longer writes don't linger.
+// The delete and the new marker are written in the same commit, so the marker
+// branch always has a marker to resume from.
+func putSpoutMarker(pachClient *client.APIClient, repo, marker string, f *os.File) error {
+	return pachClient.WithModifyFileClient(repo, ppsconsts.SpoutMarkerBranch, func(mfc *client.ModifyFileClient) error {
+		if err := mfc.DeleteFile(marker); err != nil {
+			return err
+		}
+		return appendSpoutFiles(mfc, true, f, func(name string) bool {
+			return isSpoutMarker(name, marker)
+		})
+	})
+}
+
+// containsSpoutFiles returns true if any of the files in the tar stream stored
+// in f pass the filter.
+func containsSpoutFiles(f *os.File, filter func(string) bool) (bool, error) {
+	if _, err := f.Seek(0, 0); err != nil {
+		return false, err
+	}
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return false, nil
+			}
+			return false, err
+		}
+		if hdr.Typeflag != tar.TypeDir && filter(hdr.Name) {
+			return true, nil
+		}
+	}
+}
+
+// isSpoutMarker returns true if the file with the given name is the spout
+// marker or is contained in the spout marker directory.
+func isSpoutMarker(name, marker string) bool {
+	if marker == "" {
+		return false
+	}
+	p := strings.TrimPrefix(path.Clean("/"+name), "/")
+	return p == marker || strings.HasPrefix(p, marker+"/")
+}
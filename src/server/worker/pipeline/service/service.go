@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfssync"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/server/worker/datum"
+	"github.com/pachyderm/pachyderm/src/server/worker/driver"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+	"github.com/pachyderm/pachyderm/src/server/worker/pipeline"
+)
+
+var errNotSingleDatum = errors.New("services must have a single datum")
+
+// Run will run a service pipeline until the driver is canceled. The user code
+// is run against the latest output commit of the pipeline, and is restarted
+// with the new input data when a new output commit is ready.
+func Run(driver driver.Driver, logger logs.TaggedLogger) error {
+	logger.Logf("service spawner started")
+	return forLatestCommit(driver, logger, func(ctx context.Context, commitInfo *pfs.CommitInfo) error {
+		return runService(ctx, driver, logger, commitInfo)
+	})
+}
+
+// forLatestCommit repeatedly runs the given callback with the latest output
+// commit for the pipeline. The context passed to the callback will be canceled
+// if a newer commit is ready, then this will wait for the previous callback to
+// return before calling the callback again with the latest commit.
+func forLatestCommit(driver driver.Driver, logger logs.TaggedLogger, cb func(context.Context, *pfs.CommitInfo) error) (retErr error) {
+	pachClient := driver.PachClient()
+	pi := driver.PipelineInfo()
+	// These are used to cancel the existing service and wait for it to complete
+	var cancel context.CancelFunc
+	var eg *errgroup.Group
+	stop := func() error {
+		if cancel == nil {
+			return nil
+		}
+		cancel()
+		if err := eg.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+		return nil
+	}
+	defer func() {
+		if err := stop(); retErr == nil {
+			retErr = err
+		}
+	}()
+	return pachClient.SubscribeCommitF(
+		pi.Pipeline.Name,
+		pi.OutputBranch,
+		nil,
+		"",
+		pfs.CommitState_READY,
+		func(ci *pfs.CommitInfo) error {
+			// Finished commits were either served by a previous run of the
+			// service or superseded before they could be served.
+			if ci.Finished != nil {
+				return nil
+			}
+			if cancel != nil {
+				logger.Logf("canceling previous service, new commit ready")
+			}
+			if err := stop(); err != nil {
+				return err
+			}
+			logger.Logf("starting new service, commit: %s", ci.Commit.ID)
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(pachClient.Ctx())
+			eg, ctx = errgroup.WithContext(ctx)
+			eg.Go(func() error { return cb(ctx, ci) })
+			return nil
+		},
+	)
+}
+
+// runService runs the user code against the single datum of the job for the
+// given output commit until ctx is canceled. If ctx was canceled because a
+// newer commit is ready (rather than the worker shutting down), the job is
+// finished.
+func runService(ctx context.Context, driver driver.Driver, logger logs.TaggedLogger, commitInfo *pfs.CommitInfo) error {
+	pachClient := driver.PachClient()
+	var jobInfo *pps.JobInfo
+	if err := backoff.RetryUntilCancel(ctx, func() error {
+		var err error
+		jobInfo, err = ensureJob(pachClient, driver.PipelineInfo(), logger, commitInfo)
+		return err
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		logger.Logf("error creating service job: %+v, retrying in: %+v", err, d)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if ppsutil.IsTerminal(jobInfo.State) {
+		return nil
+	}
+	logger = logger.WithJob(jobInfo.Job.ID)
+	if err := backoff.RetryUntilCancel(ctx, func() error {
+		meta, err := getDatum(pachClient, jobInfo.Input)
+		if err != nil {
+			if errors.Is(err, errNotSingleDatum) {
+				logger.Logf("failing job: %v", err)
+				return finishJob(pachClient, jobInfo, pps.JobState_JOB_FAILURE, err.Error())
+			}
+			return err
+		}
+		return withData(driver, meta, func(dir string) error {
+			jobInfo.State = pps.JobState_JOB_RUNNING
+			if err := writeJobInfo(pachClient, jobInfo); err != nil {
+				return err
+			}
+			return driver.WithActiveData(meta.Inputs, dir, func() error {
+				return pipeline.RunUserCode(driver.WithContext(ctx), logger, commitInfo.Commit, meta.Inputs)
+			})
+		})
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		logger.Logf("error running service: %+v, retrying in: %+v", err, d)
+		return nil
+	}); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	// The worker is shutting down, so leave the job running for the next
+	// worker master to pick up.
+	if pachClient.Ctx().Err() != nil {
+		return nil
+	}
+	if jobInfo.State != pps.JobState_JOB_RUNNING {
+		return nil
+	}
+	logger.Logf("service superseded by a newer commit, finishing job")
+	return finishJob(pachClient, jobInfo, pps.JobState_JOB_SUCCESS, "")
+}
+
+// ensureJob loads an existing job for the given commit in the pipeline, or
+// creates it if there is none.
+func ensureJob(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, logger logs.TaggedLogger, commitInfo *pfs.CommitInfo) (*pps.JobInfo, error) {
+	jobInfos, err := pachClient.ListJob("", nil, commitInfo.Commit, -1, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobInfos) > 1 {
+		return nil, errors.Errorf("multiple jobs found for commit: %s/%s", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
+	} else if len(jobInfos) < 1 {
+		job, err := pachClient.CreateJob(pipelineInfo.Pipeline.Name, commitInfo.Commit, nil)
+		if err != nil {
+			return nil, err
+		}
+		logger.Logf("created new job %q for output commit %q", job.ID, commitInfo.Commit.ID)
+		return pachClient.InspectJob(job.ID, false)
+	}
+	logger.Logf("found existing job %q for output commit %q", jobInfos[0].Job.ID, commitInfo.Commit.ID)
+	return pachClient.InspectJob(jobInfos[0].Job.ID, false)
+}
+
+// getDatum returns the single datum of a service's input.
+func getDatum(pachClient *client.APIClient, input *pps.Input) (*datum.Meta, error) {
+	dit, err := datum.NewIterator(pachClient, input)
+	if err != nil {
+		return nil, err
+	}
+	var meta *datum.Meta
+	if err := dit.Iterate(func(m *datum.Meta) error {
+		if meta != nil {
+			return errNotSingleDatum
+		}
+		meta = m
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if meta == nil {
+		return nil, errNotSingleDatum
+	}
+	return meta, nil
+}
+
+// withData materializes the inputs of the datum in a scratch directory for the
+// duration of the callback.
+func withData(driver driver.Driver, meta *datum.Meta, cb func(string) error) (retErr error) {
+	dir := filepath.Join(driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
+	if err := os.MkdirAll(filepath.Join(dir, "out"), 0700); err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); retErr == nil {
+			retErr = err
+		}
+	}()
+	for _, input := range meta.Inputs {
+		if err := pfssync.Pull(driver.PachClient(), input.FileInfo.File, filepath.Join(dir, input.Name)); err != nil {
+			return err
+		}
+	}
+	return cb(dir)
+}
+
+func writeJobInfo(pachClient *client.APIClient, jobInfo *pps.JobInfo) error {
+	_, err := pachClient.PpsAPIClient.UpdateJobState(pachClient.Ctx(), &pps.UpdateJobStateRequest{
+		Job:    jobInfo.Job,
+		State:  jobInfo.State,
+		Reason: jobInfo.Reason,
+	})
+	return err
+}
+
+// finishJob finishes the job's output commit and moves the job to the given
+// terminal state.
+func finishJob(pachClient *client.APIClient, jobInfo *pps.JobInfo, state pps.JobState, reason string) error {
+	jobInfo.State = state
+	jobInfo.Reason = reason
+	_, err := pachClient.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+		if _, err := builder.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
+			Commit: jobInfo.OutputCommit,
+			Empty:  state != pps.JobState_JOB_SUCCESS,
+		}); err != nil {
+			return err
+		}
+		return writeJobInfo(&builder.APIClient, jobInfo)
+	})
+	return err
+}
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/server/worker/driver"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+)
+
+// servicePipelineInfo returns a service whose user code copies its input file
+// to serveDir, then keeps running until it is killed.
+func servicePipelineInfo(serveDir string) *pps.PipelineInfo {
+	name := "testService"
+	return &pps.PipelineInfo{
+		Pipeline:     client.NewPipeline(name),
+		OutputBranch: "master",
+		Transform: &pps.Transform{
+			Cmd: []string{"bash"},
+			Stdin: []string{
+				fmt.Sprintf("cp inputRepo/file %s/tmp && mv %s/tmp %s/served", serveDir, serveDir, serveDir),
+				"exec sleep 1000",
+			},
+			WorkingDir: client.PPSInputPrefix,
+		},
+		ParallelismSpec: &pps.ParallelismSpec{
+			Constant: 1,
+		},
+		Input: &pps.Input{
+			Pfs: &pps.PFSInput{
+				Name:   "inputRepo",
+				Repo:   "inputRepo",
+				Branch: "master",
+				Glob:   "/",
+			},
+		},
+		Service: &pps.Service{
+			InternalPort: 8000,
+		},
+		SpecCommit: client.NewCommit(ppsconsts.SpecRepo, name),
+	}
+}
+
+func withServiceEnv(db *sqlx.DB, pipelineInfo *pps.PipelineInfo, cb func(*testpachd.RealEnv, driver.Driver) error) error {
+	return testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		input := pipelineInfo.Input.Pfs
+		if err := c.CreateRepo(input.Repo); err != nil {
+			return err
+		}
+		if err := c.CreateBranch(input.Repo, input.Branch, "", nil); err != nil {
+			return err
+		}
+		if err := c.CreateBranch(ppsconsts.SpecRepo, pipelineInfo.Pipeline.Name, "", nil); err != nil {
+			return err
+		}
+		commit, err := c.StartCommit(ppsconsts.SpecRepo, pipelineInfo.Pipeline.Name)
+		if err != nil {
+			return err
+		}
+		if err := c.FinishCommit(ppsconsts.SpecRepo, commit.ID); err != nil {
+			return err
+		}
+		pipelineInfo.SpecCommit = commit
+		if err := c.CreateRepo(pipelineInfo.Pipeline.Name); err != nil {
+			return err
+		}
+		if err := c.CreateBranch(
+			pipelineInfo.Pipeline.Name,
+			pipelineInfo.OutputBranch,
+			"",
+			[]*pfs.Branch{
+				client.NewBranch(input.Repo, input.Branch),
+				client.NewBranch(ppsconsts.SpecRepo, pipelineInfo.Pipeline.Name),
+			},
+		); err != nil {
+			return err
+		}
+		d, err := driver.NewDriver(
+			pipelineInfo,
+			c,
+			env.EtcdClient,
+			"/pachyderm_test",
+			nil,
+			filepath.Join(env.Directory, "worker"),
+			"namespace",
+		)
+		if err != nil {
+			return err
+		}
+		return cb(env, d)
+	})
+}
+
+// mockJobs mocks out the PPS job calls made by the service, keeping one job
+// per output commit.
+func mockJobs(t *testing.T, env *testpachd.RealEnv, pi *pps.PipelineInfo) func(*pfs.Commit) *pps.JobInfo {
+	var mu sync.Mutex
+	jobs := make(map[string]*pps.JobInfo)
+	byCommit := func(commit *pfs.Commit) *pps.JobInfo {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, jobInfo := range jobs {
+			if jobInfo.OutputCommit.ID == commit.ID {
+				return copyJobInfo(jobInfo)
+			}
+		}
+		return nil
+	}
+	env.MockPachd.PPS.ListJob.Use(func(request *pps.ListJobRequest, server pps.API_ListJobServer) error {
+		if jobInfo := byCommit(request.OutputCommit); jobInfo != nil {
+			return server.Send(jobInfo)
+		}
+		return nil
+	})
+	env.MockPachd.PPS.CreateJob.Use(func(ctx context.Context, request *pps.CreateJobRequest) (*pps.Job, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		job := client.NewJob(uuid.NewWithoutDashes())
+		jobs[job.ID] = &pps.JobInfo{
+			Job:          job,
+			Pipeline:     request.Pipeline,
+			OutputRepo:   &pfs.Repo{Name: request.Pipeline.Name},
+			OutputCommit: request.OutputCommit,
+			State:        pps.JobState_JOB_STARTING,
+		}
+		return job, nil
+	})
+	env.MockPachd.PPS.InspectJob.Use(func(ctx context.Context, request *pps.InspectJobRequest) (*pps.JobInfo, error) {
+		mu.Lock()
+		jobInfo, ok := jobs[request.Job.ID]
+		mu.Unlock()
+		if !ok {
+			return nil, errors.Errorf("job %s not found", request.Job.ID)
+		}
+		outputCommitInfo, err := env.PachClient.InspectCommit(jobInfo.OutputCommit.Repo.Name, jobInfo.OutputCommit.ID)
+		require.NoError(t, err)
+		result := copyJobInfo(jobInfo)
+		result.Transform = pi.Transform
+		result.Service = pi.Service
+		result.OutputBranch = pi.OutputBranch
+		result.Input = ppsutil.JobInput(pi, outputCommitInfo)
+		return result, nil
+	})
+	updateJobState := func(request *pps.UpdateJobStateRequest) {
+		mu.Lock()
+		defer mu.Unlock()
+		jobInfo := jobs[request.Job.ID]
+		if ppsutil.IsTerminal(jobInfo.State) {
+			return
+		}
+		jobInfo.State = request.State
+		jobInfo.Reason = request.Reason
+	}
+	env.MockPPSTransactionServer.UpdateJobStateInTransaction.Use(func(txnctx *txnenv.TransactionContext, request *pps.UpdateJobStateRequest) error {
+		updateJobState(request)
+		return nil
+	})
+	env.MockPachd.PPS.UpdateJobState.Use(func(ctx context.Context, request *pps.UpdateJobStateRequest) (*types.Empty, error) {
+		updateJobState(request)
+		return &types.Empty{}, nil
+	})
+	return byCommit
+}
+
+func copyJobInfo(jobInfo *pps.JobInfo) *pps.JobInfo {
+	result := *jobInfo
+	return &result
+}
+
+func putInput(t *testing.T, c *client.APIClient, pi *pps.PipelineInfo, content string) *pfs.Commit {
+	input := pi.Input.Pfs
+	commit, err := c.StartCommit(input.Repo, input.Branch)
+	require.NoError(t, err)
+	require.NoError(t, c.AppendFile(input.Repo, commit.ID, "/file", false, strings.NewReader(content)))
+	require.NoError(t, c.FinishCommit(input.Repo, commit.ID))
+	commitInfos, err := c.FlushCommitAll([]*pfs.Commit{commit}, []*pfs.Repo{client.NewRepo(pi.Pipeline.Name)})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(commitInfos))
+	return commitInfos[0].Commit
+}
+
+func TestServiceRestartsOnNewCommit(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	serveDir, err := ioutil.TempDir("", "pachyderm_service_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(serveDir)
+	pi := servicePipelineInfo(serveDir)
+	require.NoError(t, withServiceEnv(db, pi, func(env *testpachd.RealEnv, d driver.Driver) error {
+		c := env.PachClient
+		getJob := mockJobs(t, env, pi)
+		checkServed := func(expected string) func() error {
+			return func() error {
+				served, err := ioutil.ReadFile(filepath.Join(serveDir, "served"))
+				if err != nil {
+					return err
+				}
+				if string(served) != expected {
+					return errors.Errorf("expected %q to be served, got %q", expected, string(served))
+				}
+				return nil
+			}
+		}
+
+		ctx, cancel := context.WithCancel(c.Ctx())
+		defer cancel()
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- Run(d.WithContext(ctx), logs.NewMockLogger())
+		}()
+
+		first := putInput(t, c, pi, "foo")
+		require.NoErrorWithinTRetry(t, 30*time.Second, checkServed("foo"))
+		require.NoErrorWithinTRetry(t, 30*time.Second, func() error {
+			if jobInfo := getJob(first); jobInfo == nil || jobInfo.State != pps.JobState_JOB_RUNNING {
+				return errors.Errorf("job for commit %s is not running", first.ID)
+			}
+			return nil
+		})
+
+		// A new output commit supersedes the running service, finishing its job.
+		second := putInput(t, c, pi, "bar")
+		require.NoErrorWithinTRetry(t, 30*time.Second, checkServed("foobar"))
+		require.NoErrorWithinTRetry(t, 30*time.Second, func() error {
+			if jobInfo := getJob(first); jobInfo.State != pps.JobState_JOB_SUCCESS {
+				return errors.Errorf("expected job for commit %s to succeed, got %v", first.ID, jobInfo.State)
+			}
+			return nil
+		})
+		commitInfo, err := c.InspectCommit(pi.Pipeline.Name, first.ID)
+		require.NoError(t, err)
+		require.NotNil(t, commitInfo.Finished)
+		commitInfo, err = c.InspectCommit(pi.Pipeline.Name, second.ID)
+		require.NoError(t, err)
+		require.Nil(t, commitInfo.Finished)
+
+		// Shutting down the worker leaves the current job running.
+		cancel()
+		select {
+		case err := <-errCh:
+			if err != nil && !errors.Is(err, context.Canceled) {
+				require.NoError(t, err)
+			}
+		case <-time.After(30 * time.Second):
+			t.Fatal("service did not stop after being canceled")
+		}
+		require.Equal(t, pps.JobState_JOB_RUNNING, getJob(second).State)
+		return nil
+	}))
+}
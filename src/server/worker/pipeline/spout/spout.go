@@ -1,36 +1,87 @@
 package spout
 
-// TODO: Make spout work with V2.
-//// Run will run a spout pipeline until the driver is canceled.
-//func Run(driver driver.Driver, logger logs.TaggedLogger) error {
-//	pachClient := driver.PachClient()
-//	pipelineInfo := driver.PipelineInfo()
-//	logger = logger.WithJob("spout")
-//
-//	// Spouts typically have an open commit waiting for new data. So if the spout needs to be updated, and
-//	// thus spoutSpawner is called, it might hang if the commit never gets closed. So to avoid this, we
-//	// delete open commits that we see here.
-//	// We probably only need to check the first commit, but doing 10 to be safe
-//	pachClient.ListCommitF(pipelineInfo.Pipeline.Name, "", "", 10, false, func(c *pfs.CommitInfo) error {
-//		if c.Finished != nil {
-//			return nil
-//		}
-//		return pachClient.DeleteCommit(pipelineInfo.Pipeline.Name, c.Commit.ID)
-//	})
-//
-//	// TODO: do something with stats?
-//	_, err := driver.WithData(nil, nil, logger, func(dir string, stats *pps.ProcessStats) error {
-//		inputs := []*common.Input{} // Spouts take no inputs
-//		return driver.WithActiveData(inputs, dir, func() error {
-//			eg, serviceCtx := errgroup.WithContext(pachClient.Ctx())
-//
-//			// While spouts do write to output commits, the output commit changes
-//			// frequently and we do not restart the user code for each one. Therefore,
-//			// we leave the output commit out of the user code env.
-//			eg.Go(func() error { return pipeline.RunUserCode(driver.WithContext(serviceCtx), logger, nil, inputs) })
-//			eg.Go(func() error { return pipeline.ReceiveSpout(serviceCtx, pachClient, pipelineInfo, logger) })
-//			return eg.Wait()
-//		})
-//	})
-//	return err
-//}
+import (
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfssync"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/driver"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+	"github.com/pachyderm/pachyderm/src/server/worker/pipeline"
+)
+
+// Run will run a spout pipeline until the driver is canceled.
+func Run(driver driver.Driver, logger logs.TaggedLogger) (retErr error) {
+	pachClient := driver.PachClient()
+	pipelineInfo := driver.PipelineInfo()
+	logger = logger.WithJob("spout")
+
+	// Spouts typically have an open commit waiting for new data. So if the spout needs to be updated, and
+	// thus spoutSpawner is called, it might hang if the commit never gets closed. So to avoid this, we
+	// delete open commits that we see here.
+	// We probably only need to check the first commit, but doing 10 to be safe
+	if err := pachClient.ListCommitF(pipelineInfo.Pipeline.Name, "", "", 10, false, func(c *pfs.CommitInfo) error {
+		if c.Finished != nil {
+			return nil
+		}
+		return pachClient.DeleteCommit(pipelineInfo.Pipeline.Name, c.Commit.ID)
+	}); err != nil {
+		return err
+	}
+
+	// Spouts take no inputs, so the scratch space only holds the out pipe and
+	// the spout marker (if the spout has one).
+	dir := filepath.Join(driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); retErr == nil {
+			retErr = err
+		}
+	}()
+	if err := downloadMarker(pachClient, pipelineInfo.Pipeline.Name, pipelineInfo.Spout.Marker, dir); err != nil {
+		return err
+	}
+	inputs := []*common.Input{} // Spouts take no inputs
+	return driver.WithActiveData(inputs, dir, func() error {
+		eg, serviceCtx := errgroup.WithContext(pachClient.Ctx())
+
+		// While spouts do write to output commits, the output commit changes
+		// frequently and we do not restart the user code for each one. Therefore,
+		// we leave the output commit out of the user code env.
+		eg.Go(func() error { return pipeline.RunUserCode(driver.WithContext(serviceCtx), logger, nil, inputs) })
+		eg.Go(func() error { return pipeline.ReceiveSpout(serviceCtx, driver, logger) })
+		return eg.Wait()
+	})
+}
+
+// downloadMarker downloads the spout marker written by the previous run of the
+// spout (if any) into dir, so the user code can resume from it.
+func downloadMarker(pachClient *client.APIClient, repo, marker, dir string) error {
+	if marker == "" {
+		return nil
+	}
+	branchInfo, err := pachClient.InspectBranch(repo, ppsconsts.SpoutMarkerBranch)
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	if branchInfo.Head == nil {
+		return nil
+	}
+	if err := pfssync.Pull(pachClient, client.NewFile(repo, branchInfo.Head.ID, marker), dir); err != nil && !errutil.IsNotFoundError(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package spout
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+	"github.com/pachyderm/pachyderm/src/server/worker/driver"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+)
+
+// spoutPipelineInfo returns a spout whose user code writes three tar streams
+// to the out pipe, each with one file and the updated marker. The file numbers
+// continue from the marker left by the previous run.
+func spoutPipelineInfo(marker string) *pps.PipelineInfo {
+	name := "testSpout"
+	return &pps.PipelineInfo{
+		Pipeline:     client.NewPipeline(name),
+		OutputBranch: "master",
+		Transform: &pps.Transform{
+			Cmd: []string{"bash"},
+			Stdin: []string{
+				fmt.Sprintf("start=$(cat %s 2>/dev/null || echo 0)", marker),
+				"tmp=$(mktemp -d)",
+				"for i in $(seq $((start+1)) $((start+3))); do",
+				fmt.Sprintf("echo $i > $tmp/file$i; echo $i > $tmp/%s", marker),
+				fmt.Sprintf("tar -cf out -C $tmp file$i %s", marker),
+				"done",
+				"exec sleep 1000",
+			},
+			WorkingDir: client.PPSInputPrefix,
+		},
+		ParallelismSpec: &pps.ParallelismSpec{
+			Constant: 1,
+		},
+		Spout: &pps.Spout{
+			Marker: marker,
+		},
+		SpecCommit: client.NewCommit(ppsconsts.SpecRepo, name),
+	}
+}
+
+func withSpoutEnv(db *sqlx.DB, pipelineInfo *pps.PipelineInfo, cb func(*testpachd.RealEnv, driver.Driver) error) error {
+	return testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		if err := c.CreateBranch(ppsconsts.SpecRepo, pipelineInfo.Pipeline.Name, "", nil); err != nil {
+			return err
+		}
+		commit, err := c.StartCommit(ppsconsts.SpecRepo, pipelineInfo.Pipeline.Name)
+		if err != nil {
+			return err
+		}
+		if err := c.FinishCommit(ppsconsts.SpecRepo, commit.ID); err != nil {
+			return err
+		}
+		pipelineInfo.SpecCommit = commit
+		if err := c.CreateRepo(pipelineInfo.Pipeline.Name); err != nil {
+			return err
+		}
+		if err := c.CreateBranch(pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch, "", nil); err != nil {
+			return err
+		}
+		d, err := driver.NewDriver(
+			pipelineInfo,
+			c,
+			env.EtcdClient,
+			"/pachyderm_test",
+			nil,
+			filepath.Join(env.Directory, "worker"),
+			"namespace",
+		)
+		if err != nil {
+			return err
+		}
+		return cb(env, d)
+	})
+}
+
+// runSpout runs the spout until cond returns nil, then stops it.
+func runSpout(t *testing.T, d driver.Driver, cond func() error) {
+	ctx, cancel := context.WithCancel(d.PachClient().Ctx())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- Run(d.WithContext(ctx), logs.NewMockLogger())
+	}()
+	require.NoErrorWithinTRetry(t, 30*time.Second, cond)
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != nil && !errors.Is(err, context.Canceled) {
+			require.NoError(t, err)
+		}
+	case <-time.After(30 * time.Second):
+		t.Fatal("spout did not stop after being canceled")
+	}
+}
+
+func checkFile(c *client.APIClient, repo, commit, path, expected string) error {
+	buf := &bytes.Buffer{}
+	if err := c.GetFile(repo, commit, path, buf); err != nil {
+		return err
+	}
+	if buf.String() != expected {
+		return errors.Errorf("expected %q at %s, got %q", expected, path, buf.String())
+	}
+	return nil
+}
+
+func TestSpoutMarker(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	pi := spoutPipelineInfo("mymark")
+	require.NoError(t, withSpoutEnv(db, pi, func(env *testpachd.RealEnv, d driver.Driver) error {
+		c := env.PachClient
+		repo := pi.Pipeline.Name
+		checkRun := func(n int) func() error {
+			return func() error {
+				for i := 1; i <= n; i++ {
+					if err := checkFile(c, repo, pi.OutputBranch, fmt.Sprintf("/file%d", i), fmt.Sprintf("%d\n", i)); err != nil {
+						return err
+					}
+				}
+				return checkFile(c, repo, ppsconsts.SpoutMarkerBranch, "/mymark", fmt.Sprintf("%d\n", n))
+			}
+		}
+		runSpout(t, d, checkRun(3))
+		// Each tar stream is written in its own commit, and the marker is
+		// not written to the output branch.
+		commitInfos, err := c.ListCommit(repo, pi.OutputBranch, "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(commitInfos))
+		for _, ci := range commitInfos {
+			require.NotNil(t, ci.Finished)
+		}
+		_, err = c.InspectFile(repo, pi.OutputBranch, "/mymark")
+		require.YesError(t, err)
+		// A restarted spout resumes from the marker.
+		runSpout(t, d, checkRun(6))
+		return nil
+	}))
+}
@@ -67,8 +67,8 @@ func (td *testDriver) Jobs() col.Collection {
 func (td *testDriver) Pipelines() col.Collection {
 	return td.inner.Pipelines()
 }
-func (td *testDriver) NewTaskWorker() *work.Worker {
-	return td.inner.NewTaskWorker()
+func (td *testDriver) NewTaskWorker(opts ...work.WorkerOption) *work.Worker {
+	return td.inner.NewTaskWorker(opts...)
 }
 func (td *testDriver) NewTaskQueue() (*work.TaskQueue, error) {
 	return td.inner.NewTaskQueue()
@@ -124,6 +124,7 @@ func withTestEnv(db *sqlx.DB, pipelineInfo *pps.PipelineInfo, cb func(*testEnv)
 			realEnv.PachClient,
 			realEnv.EtcdClient,
 			"/pachyderm_test",
+			nil,
 			workerDir,
 			"namespace",
 		)
@@ -0,0 +1,79 @@
+package transform
+
+import (
+	"archive/tar"
+	"io"
+	"path"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+)
+
+// egressJob copies the output commit of a job in the EGRESSING state to the
+// job's egress URL, then moves the job to the SUCCESS state. Egress writes
+// every file in the output commit, so it is safe to retry or resume from the
+// start after a failure.
+func egressJob(pachClient *client.APIClient, logger logs.TaggedLogger, jobInfo *pps.JobInfo) error {
+	return logger.LogStep("egressing job output", func() error {
+		if err := backoff.RetryUntilCancel(pachClient.Ctx(), func() error {
+			return egress(pachClient, jobInfo)
+		}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+			logger.Logf("error egressing job output: %v, retrying in %v", err, d)
+			return nil
+		}); err != nil {
+			return err
+		}
+		jobInfo.State = pps.JobState_JOB_SUCCESS
+		jobInfo.Reason = ""
+		return writeJobInfo(pachClient, jobInfo)
+	})
+}
+
+func egress(pachClient *client.APIClient, jobInfo *pps.JobInfo) error {
+	url, err := obj.ParseURL(jobInfo.Egress.URL)
+	if err != nil {
+		return err
+	}
+	objClient, err := obj.NewClientFromURLAndSecret(url)
+	if err != nil {
+		return err
+	}
+	commit := jobInfo.OutputCommit
+	r, err := pachClient.GetTarFile(commit.Repo.Name, commit.ID, "/**")
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if hdr.Typeflag == tar.TypeDir {
+			continue
+		}
+		if err := func() (retErr error) {
+			w, err := objClient.Writer(pachClient.Ctx(), path.Join(url.Object, hdr.Name))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := w.Close(); retErr == nil {
+					retErr = err
+				}
+			}()
+			_, err = io.Copy(w, tr)
+			return err
+		}(); err != nil {
+			return err
+		}
+	}
+}
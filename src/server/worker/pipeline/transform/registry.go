@@ -123,7 +123,6 @@ type registry struct {
 
 // TODO:
 // s3 input / gateway stuff (need more information here).
-// egress.
 // Prometheus stats? (previously in the driver, which included testing we should reuse if possible)
 // capture logs (reuse driver tests and reintroduce tagged logger).
 func newRegistry(driver driver.Driver, logger logs.TaggedLogger) (*registry, error) {
@@ -248,6 +247,21 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo, metaCommit *pfs.Commit
 		return err
 	}
 	switch {
+	case commitInfo.Finished != nil && jobInfo.State == pps.JobState_JOB_EGRESSING:
+		// The output commit was finished, but the egress did not complete
+		// before the previous master stopped, so resume it.
+		logger := reg.logger.WithJob(jobInfo.Job.ID)
+		asyncEg = &errgroup.Group{}
+		asyncEg.Go(func() error {
+			return egressJob(reg.driver.PachClient(), logger, jobInfo)
+		})
+		go func() {
+			defer reg.limiter.Release()
+			if err := asyncEg.Wait(); err != nil {
+				logger.Logf("fatal job error: %v", err)
+			}
+		}()
+		return nil
 	case commitInfo.Finished != nil:
 		if !ppsutil.IsTerminal(jobInfo.State) {
 			jobInfo.State = pps.JobState_JOB_KILLED
@@ -291,7 +305,6 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo, metaCommit *pfs.Commit
 	}); err != nil {
 		return err
 	}
-	// TODO: we should NOT start the job this way if it is in EGRESSING
 	// TODO: This could probably be scoped to a callback, and we could move job specific features
 	// in the chain package (timeouts for example).
 	// TODO: I use the registry pachclient for the iterators, so I can reuse across jobs for skipping.
@@ -367,6 +380,10 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo, metaCommit *pfs.Commit
 				if err := pj.writeJobInfo(); err != nil {
 					pj.logger.Logf("error incrementing restart count for job (%s): %v", pj.ji.Job.ID, err)
 				}
+				// The output of an egressing job has already been committed.
+				if pj.ji.State == pps.JobState_JOB_EGRESSING {
+					return nil
+				}
 				// Reload the job's commitInfo(s) as they may have changed and clear the state of the commit(s).
 				pj.commitInfo, err = reg.driver.PachClient().InspectCommit(pj.commitInfo.Commit.Repo.Name, pj.commitInfo.Commit.ID)
 				if err != nil {
@@ -439,8 +456,16 @@ func (reg *registry) superviseJob(pj *pendingJob) error {
 	if strings.Contains(ci.Description, pfs.EmptyStr) {
 		return reg.killJob(pj, "output commit closed")
 	}
+	// The output commit of a job with egress is finished before the egress
+	// runs, so wait for the job to complete rather than canceling it.
+	jobInfo, err := pj.driver.PachClient().InspectJob(pj.ji.Job.ID, false)
+	if err != nil {
+		return err
+	}
+	if jobInfo.State == pps.JobState_JOB_EGRESSING {
+		<-pj.driver.PachClient().Ctx().Done()
+	}
 	return nil
-
 }
 
 func (reg *registry) processJob(pj *pendingJob) error {
@@ -455,6 +480,8 @@ func (reg *registry) processJob(pj *pendingJob) error {
 		return pj.logger.LogStep("processing job datums", func() error {
 			return reg.processJobRunning(pj)
 		})
+	case state == pps.JobState_JOB_EGRESSING:
+		return egressJob(pj.driver.PachClient(), pj.logger, pj.ji)
 	}
 	pj.cancel()
 	return errors.Errorf("unknown job state: %v", state)
@@ -486,26 +513,27 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 	// This may be resolved by either explicitly generating deletes first (somewhat similar to this hack) or
 	// relying on temporary fileset identifiers being associated with the commit after the datumsets have been
 	// generated (and therefore after the deletes).
+	var numDatums int64
 	if err := pj.withDeleter(pachClient, func() error {
-		return pj.jdit.Iterate(func(_ *datum.Meta) error { return nil })
+		return pj.jdit.Iterate(func(_ *datum.Meta) error {
+			numDatums++
+			return nil
+		})
 	}); err != nil {
 		return err
 	}
+	setSpec, err := datumSetSpec(pj, numDatums)
+	if err != nil {
+		return err
+	}
 
 	// Setup datum set subtask channel.
 	subtasks := make(chan *work.Task)
 	if err := pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
 		// Setup goroutine for creating datum set subtasks.
-		// TODO: When the datum set spec is not set, evenly distribute the datums.
 		eg.Go(func() error {
 			defer close(subtasks)
 			storageRoot := filepath.Join(pj.driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
-			var setSpec *datum.SetSpec
-			if pj.driver.PipelineInfo().ChunkSpec != nil {
-				setSpec = &datum.SetSpec{
-					Number: int(pj.driver.PipelineInfo().ChunkSpec.Number),
-				}
-			}
 			return datum.CreateSets(pj.jdit, storageRoot, setSpec, func(upload func(datum.AppendFileTarClient) error) error {
 				subtask, err := createDatumSetSubtask(pachClient, pj, upload, renewer)
 				if err != nil {
@@ -522,6 +550,10 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 		// Setup goroutine for running and collecting datum set subtasks.
 		eg.Go(func() error {
 			return pj.logger.LogStep("running and collecting datum set subtasks", func() error {
+				var opts []work.RunOption
+				if pj.driver.PipelineInfo().Speculative {
+					opts = append(opts, work.WithSpeculation())
+				}
 				return pj.taskMaster.RunSubtasksChan(
 					subtasks,
 					func(ctx context.Context, taskInfo *work.TaskInfo) error {
@@ -535,6 +567,7 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 						renewer.Remove(data.FileSet)
 						return datum.MergeStats(stats, data.Stats)
 					},
+					opts...,
 				)
 			})
 		})
@@ -556,6 +589,24 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 	return reg.succeedJob(pj)
 }
 
+// datumSetSpec returns the spec for the datum sets of a job with numDatums
+// datums. The pipeline's chunk spec is used if it is set, otherwise the datums
+// are distributed evenly over the expected workers.
+func datumSetSpec(pj *pendingJob, numDatums int64) (*datum.SetSpec, error) {
+	chunkSpec := pj.driver.PipelineInfo().ChunkSpec
+	if chunkSpec != nil && (chunkSpec.Number > 0 || chunkSpec.SizeBytes > 0) {
+		return &datum.SetSpec{
+			Number:    chunkSpec.Number,
+			SizeBytes: chunkSpec.SizeBytes,
+		}, nil
+	}
+	numWorkers, err := pj.driver.ExpectedNumWorkers()
+	if err != nil {
+		return nil, err
+	}
+	return datum.NewEvenSetSpec(numDatums, numWorkers), nil
+}
+
 func createDatumSetSubtask(pachClient *client.APIClient, pj *pendingJob, upload func(datum.AppendFileTarClient) error, renewer *renew.StringSet) (*work.Task, error) {
 	resp, err := pachClient.WithCreateFilesetClient(func(ctfsc *client.CreateFilesetClient) error {
 		return upload(ctfsc)
@@ -5,7 +5,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -316,6 +318,68 @@ func TestJobSuccess(t *testing.T) {
 	}))
 }
 
+func TestJobEgress(t *testing.T) {
+	pi := defaultPipelineInfo()
+	egressDir, err := ioutil.TempDir("", "pachyderm_egress_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(egressDir)
+	pi.Egress = &pps.Egress{URL: "local://" + egressDir}
+	pi.Transform.Stdin = []string{"cp -r inputRepo/* out"}
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, withWorkerSpawnerPair(db, pi, func(env *testEnv) error {
+		ctx, etcdJobInfo := mockBasicJob(t, env, pi)
+		tarFiles := []tarutil.File{
+			tarutil.NewMemFile("/file", []byte("foobar")),
+			tarutil.NewMemFile("/dir/file", []byte("buzzbar")),
+		}
+		triggerJob(t, env, pi, tarFiles)
+		ctx = withTimeout(ctx, 10*time.Second)
+		<-ctx.Done()
+		// The job only moves to SUCCESS once the output has been egressed.
+		require.Equal(t, pps.JobState_JOB_SUCCESS, etcdJobInfo.State)
+
+		// Ensure the output commit was finished before egressing.
+		outputCommitInfo, err := env.PachClient.InspectCommit(pi.Pipeline.Name, etcdJobInfo.OutputCommit.ID)
+		require.NoError(t, err)
+		require.NotNil(t, outputCommitInfo.Finished)
+
+		for _, f := range []struct{ path, content string }{{"file", "foobar"}, {"dir/file", "buzzbar"}} {
+			data, err := ioutil.ReadFile(filepath.Join(egressDir, f.path))
+			require.NoError(t, err)
+			require.Equal(t, f.content, string(data))
+		}
+		return nil
+	}))
+}
+
+func TestJobS3Out(t *testing.T) {
+	pi := defaultPipelineInfo()
+	// The output of s3_out pipelines is written through the sidecar's s3
+	// gateway, so the worker does not upload anything itself.
+	pi.S3Out = true
+	pi.Transform.Stdin = []string{"true"}
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, withWorkerSpawnerPair(db, pi, func(env *testEnv) error {
+		ctx, etcdJobInfo := mockBasicJob(t, env, pi)
+		tarFiles := []tarutil.File{
+			tarutil.NewMemFile("/file", []byte("foobar")),
+		}
+		triggerJob(t, env, pi, tarFiles)
+		ctx = withTimeout(ctx, 10*time.Second)
+		<-ctx.Done()
+		require.Equal(t, pps.JobState_JOB_SUCCESS, etcdJobInfo.State)
+
+		// The output commit is finished with the job.
+		outputCommitInfo, err := env.PachClient.InspectCommit(pi.Pipeline.Name, etcdJobInfo.OutputCommit.ID)
+		require.NoError(t, err)
+		require.NotNil(t, outputCommitInfo.Finished)
+		fileInfos, err := env.PachClient.ListFileAll(pi.Pipeline.Name, etcdJobInfo.OutputCommit.ID, "/")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(fileInfos))
+		return nil
+	}))
+}
+
 func TestJobFailedDatum(t *testing.T) {
 	pi := defaultPipelineInfo()
 	db := dbutil.NewTestDB(t)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/server/pkg/work"
@@ -17,7 +18,6 @@ import (
 // Worker handles a transform pipeline work subtask, then returns.
 // TODO:
 // datum queuing (probably should be handled by datum package).
-// s3 input / gateway stuff (need more information here).
 // spouts.
 // joins.
 // capture datum logs.
@@ -47,14 +47,18 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 	pachClient := driver.PachClient()
 	storageRoot := filepath.Join(driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
 	datumSet.Stats = &datum.Stats{ProcessStats: &pps.ProcessStats{}}
+	// Speculative datum sets may run more than once, so only one copy at a
+	// time can upload its output, and the copy commits once the output and
+	// meta commits have its output.
+	speculative := driver.PipelineInfo().Speculative
 	// Setup file operation client for output meta commit.
 	metaCommit := datumSet.MetaCommit
-	return pachClient.WithModifyFileClient(metaCommit.Repo.Name, metaCommit.ID, func(mfcMeta *client.ModifyFileClient) error {
+	if err := pachClient.WithModifyFileClient(metaCommit.Repo.Name, metaCommit.ID, func(mfcMeta *client.ModifyFileClient) error {
 		// Setup file operation client for output PFS commit.
 		outputCommit := datumSet.OutputCommit
-		return pachClient.WithModifyFileClient(outputCommit.Repo.Name, outputCommit.ID, func(mfcPFS *client.ModifyFileClient) error {
+		return withPFSOutput(driver, outputCommit, func(setOpts ...datum.SetOption) error {
 			// Setup datum set for processing.
-			return datum.WithSet(pachClient, storageRoot, func(s *datum.Set) error {
+			if err := datum.WithSet(pachClient, storageRoot, func(s *datum.Set) error {
 				di := datum.NewFileSetIterator(pachClient, client.TmpRepoName, datumSet.FileSet)
 				// Process each datum in the assigned datum set.
 				return di.Iterate(func(meta *datum.Meta) error {
@@ -88,7 +92,33 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 					}, opts...)
 
 				})
-			}, datum.WithMetaOutput(mfcMeta), datum.WithPFSOutput(mfcPFS), datum.WithStats(datumSet.Stats))
+			}, append(setOpts, datum.WithMetaOutput(mfcMeta), datum.WithStats(datumSet.Stats))...); err != nil {
+				return err
+			}
+			if speculative {
+				return work.PrepareCommit(pachClient.Ctx())
+			}
+			return nil
 		})
+	}); err != nil {
+		return err
+	}
+	if speculative {
+		return work.CommitSubtask(pachClient.Ctx())
+	}
+	return nil
+}
+
+// withPFSOutput calls cb with the datum set option for uploading the output
+// of each datum to the output commit. Pipelines with s3_out write their output
+// to the output commit through the sidecar's s3 gateway, so /pfs/out is not
+// uploaded for them.
+func withPFSOutput(driver driver.Driver, outputCommit *pfs.Commit, cb func(...datum.SetOption) error) error {
+	if driver.PipelineInfo().S3Out {
+		return cb()
+	}
+	pachClient := driver.PachClient()
+	return pachClient.WithModifyFileClient(outputCommit.Repo.Name, outputCommit.ID, func(mfcPFS *client.ModifyFileClient) error {
+		return cb(datum.WithPFSOutput(mfcPFS))
 	})
 }
@@ -180,6 +180,20 @@ var (
 			"job",
 		},
 	)
+
+	// TaskQueueDepth is a gauge tracking the number of subtasks waiting to be processed by a worker
+	TaskQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "pachyderm",
+			Subsystem: "worker",
+			Name:      "task_queue_depth",
+			Help:      "Number of subtasks waiting to be processed by priority",
+		},
+		[]string{
+			"pipeline",
+			"priority",
+		},
+	)
 )
 
 // InitPrometheus sets up the default datum stats collectors for use by worker
@@ -197,6 +211,7 @@ func InitPrometheus() {
 		DatumDownloadBytesCount,
 		DatumUploadSize,
 		DatumUploadBytesCount,
+		TaskQueueDepth,
 	}
 	for _, metric := range metrics {
 		if err := prometheus.Register(metric); err != nil {
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strconv"
 	"time"
 
 	etcd "github.com/coreos/etcd/clientv3"
 	docker "github.com/fsouza/go-dockerclient"
+	"github.com/jmoiron/sqlx"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/pachyderm/pachyderm/src/client"
@@ -21,6 +23,8 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/work"
 	"github.com/pachyderm/pachyderm/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+	"github.com/pachyderm/pachyderm/src/server/worker/pipeline/service"
+	"github.com/pachyderm/pachyderm/src/server/worker/pipeline/spout"
 	"github.com/pachyderm/pachyderm/src/server/worker/pipeline/transform"
 	"github.com/pachyderm/pachyderm/src/server/worker/server"
 	"github.com/pachyderm/pachyderm/src/server/worker/stats"
@@ -46,6 +50,7 @@ func NewWorker(
 	pachClient *client.APIClient,
 	etcdClient *etcd.Client,
 	etcdPrefix string,
+	db *sqlx.DB,
 	pipelineInfo *pps.PipelineInfo,
 	workerName string,
 	namespace string,
@@ -63,6 +68,7 @@ func NewWorker(
 		pachClient,
 		etcdClient,
 		etcdPrefix,
+		db,
 		rootPath,
 		namespace,
 	)
@@ -116,8 +122,13 @@ func (w *Worker) worker() {
 		driver := w.driver.WithContext(ctx)
 
 		// Run any worker tasks that the master creates
+		scheduler := work.NewScheduler(ctx)
 		eg.Go(func() error {
-			return driver.NewTaskWorker().Run(
+			reportQueueDepth(ctx, w.driver.PipelineInfo().Pipeline.Name, scheduler)
+			return nil
+		})
+		eg.Go(func() error {
+			return driver.NewTaskWorker(work.WithScheduler(scheduler)).Run(
 				ctx,
 				func(ctx context.Context, subtask *work.Task) error {
 					driver := w.driver.WithContext(ctx)
@@ -137,6 +148,32 @@ func (w *Worker) worker() {
 	})
 }
 
+// reportQueueDepth periodically reports the queue depth of the scheduler for
+// each priority until the context is canceled.
+func reportQueueDepth(ctx context.Context, pipelineName string, scheduler *work.Scheduler) {
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+	reported := make(map[int64]bool)
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		depth := scheduler.QueueDepth()
+		// Priorities that are no longer queued are reported as empty.
+		for priority := range reported {
+			if _, ok := depth[priority]; !ok {
+				depth[priority] = 0
+			}
+		}
+		for priority, n := range depth {
+			stats.TaskQueueDepth.WithLabelValues(pipelineName, strconv.FormatInt(priority, 10)).Set(float64(n))
+			reported[priority] = true
+		}
+	}
+}
+
 func (w *Worker) master(etcdClient *etcd.Client, etcdPrefix string) {
 	pipelineInfo := w.driver.PipelineInfo()
 	logger := logs.NewMasterLogger(pipelineInfo)
@@ -189,11 +226,10 @@ type spawnerFunc func(driver.Driver, logs.TaggedLogger) error
 func runSpawner(driver driver.Driver, logger logs.TaggedLogger) error {
 	pipelineType, runFn := func() (string, spawnerFunc) {
 		switch {
-		// TODO: Make work with V2.
-		//case driver.PipelineInfo().Service != nil:
-		//	return "service", service.Run
-		//case driver.PipelineInfo().Spout != nil:
-		//	return "spout", spout.Run
+		case driver.PipelineInfo().Service != nil:
+			return "service", service.Run
+		case driver.PipelineInfo().Spout != nil:
+			return "spout", spout.Run
 		default:
 			return "transform", transform.Run
 		}